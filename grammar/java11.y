@@ -0,0 +1,3347 @@
+%{
+
+/*------------------------------------------------------------------
+ * Massively hacked by Dave Glowacki <dave@glowacki.org> from the
+ * original source by:
+ *------------------------------------------------------------------
+ * Copyright (C)
+ *   1996, 1997, 1998 Dmitri Bronnikov, All rights reserved.
+ *
+ * THIS GRAMMAR IS PROVIDED "AS IS" WITHOUT  ANY  EXPRESS  OR
+ * IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+ * WARRANTIES  OF  MERCHANTABILITY  AND  FITNESS  FOR  A  PARTICULAR
+ * PURPOSE, OR NON-INFRINGMENT.
+ *
+ * Bronikov@inreach.com
+ *
+ *------------------------------------------------------------------
+ *
+ * VERSION 1.06 DATE 20 AUG 1998
+ *
+ *------------------------------------------------------------------
+ *
+ * UPDATES
+ *
+ * 1.06 Correction of Java 1.1 syntax
+ * 1.05 Yet more Java 1.1
+ *      <qualified name>.<allocation expression>
+ * 1.04 More Java 1.1 features:
+ *      <class name>.this
+ *      <type name>.class
+ * 1.03 Added Java 1.1 features:
+ *      inner classes,
+ *      anonymous classes,
+ *      non-static initializer blocks,
+ *      array initialization by new operator
+ * 1.02 Corrected cast expression syntax
+ * 1.01 All shift/reduce conflicts, except dangling else, resolved
+ *
+ *------------------------------------------------------------------
+ *
+ * PARSING CONFLICTS RESOLVED
+ *
+ * Some Shift/Reduce conflicts have been resolved at the expense of
+ * the grammar defines a superset of the language. The following
+ * actions have to be performed to complete program syntax checking:
+ *
+ * 1) Check that modifiers applied to a class, interface, field,
+ *    or constructor are allowed in respectively a class, inteface,
+ *    field or constructor declaration. For example, a class
+ *    declaration should not allow other modifiers than abstract,
+ *    final and public.
+ *
+ * 2) For an expression statement, check it is either increment, or
+ *    decrement, or assignment expression.
+ *
+ * 3) Check that type expression in a cast operator indicates a type.
+ *    Some of the compilers that I have tested will allow simultaneous
+ *    use of identically named type and variable in the same scope
+ *    depending on context.
+ *
+ * 4) Change lexical definition to change '[' optionally followed by
+ *    any number of white-space characters immediately followed by ']'
+ *    to OP_DIM token. I defined this token as [\[]{white_space}*[\]]
+ *    in the lexer.
+ *
+ *------------------------------------------------------------------
+ *
+ * UNRESOLVED SHIFT/REDUCE CONFLICTS
+ *
+ * Dangling else in if-then-else
+ *
+ *------------------------------------------------------------------
+ */
+
+package grammar
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+type tmpVariableId struct {
+	name string
+	dims int
+}
+
+func ReportCastError(expName string, obj interface{}) {
+	debug.PrintStack()
+	panic(fmt.Sprintf("Expected %s, got %T (%s)", expName, obj, obj))
+}
+
+func ReportError(msg string) {
+	debug.PrintStack()
+	panic(msg)
+}
+
+func makeFormalParamList(objlist []JObject) []*JFormalParameter {
+	if objlist == nil || len(objlist) == 0 {
+		return nil
+	}
+
+	list := make([]*JFormalParameter, len(objlist))
+	for i, obj := range objlist {
+		if elem, ok := obj.(*JFormalParameter); !ok {
+			ReportCastError("JFormalParameter", obj)
+		} else {
+			list[i] = elem
+		}
+	}
+
+	return list
+}
+
+func makeVarDeclList(objlist []JObject) []*JVariableDecl {
+	if objlist == nil || len(objlist) == 0 {
+		return nil
+	}
+
+	list := make([]*JVariableDecl, len(objlist))
+	for i, obj := range objlist {
+		if elem, ok := obj.(*JVariableDecl); !ok {
+			ReportCastError("JVariableDecl", obj)
+		} else {
+			list[i] = elem
+		}
+	}
+
+	return list
+}
+%}
+
+%union {
+	token    int
+	str      string
+	name     *JTypeName
+	namelist []*JTypeName
+	obj      JObject
+	objlist  []JObject
+	count    int
+	varlist  []*JVariableInit
+}
+
+%token <str> IDENTIFIER
+%token <str> LITERAL
+%token <token> BOOLLIT
+%token <str> OP_EQ
+%token <str> OP_NE
+%token <str> OP_LOR
+%token <str> OP_LAND
+%token <str> OP_INC
+%token <str> OP_DEC
+%token OP_SHL
+%token OP_SHRR
+%token <str> ASS_ADD
+%token <str> ASS_SUB
+%token <str> ASS_MUL
+%token <str> ASS_DIV
+%token <str> ASS_AND
+%token <str> ASS_OR
+%token <str> ASS_XOR
+%token <str> ASS_MOD
+%token OP_DIM
+%token <str> ABSTRACT
+%token ASSERT
+%token <str> BOOLEAN
+%token <token> BREAK
+%token <str> BYTE
+%token <str> CASE
+%token CATCH
+%token <str> CHAR
+%token <token> CLASS
+%token <token> CONTINUE
+%token <str> DEFAULT
+%token DO
+%token <str> DOUBLE
+%token ELSE
+%token ENUM
+%token EXTENDS
+%token <str> FINAL
+%token FINALLY
+%token <str> FLOAT
+%token FOR
+%token IF
+%token IMPLEMENTS
+%token IMPORT
+%token INSTANCEOF
+%token <str> INT
+%token INTERFACE
+%token <str> LONG
+%token <str> NATIVE
+%token NEW
+%token <token> JNULL
+%token PACKAGE
+%token <str> PRIVATE
+%token <str> PROTECTED
+%token <str> PUBLIC
+%token <token> RETURN
+%token <str> SHORT
+%token <str> STATIC
+%token <token> SUPER
+%token SWITCH
+%token <str> SYNCHRONIZED
+%token <token> THIS
+%token <token> THROW
+%token THROWS
+%token <str> TRANSIENT
+%token TRY
+%token <str> VOID
+%token <str> VOLATILE
+%token WHILE
+
+%type <obj> CompilationUnit PackageDeclaration ImportDeclaration
+%type <obj> TypeDeclaration ClassOrInterfaceDeclaration ClassDeclaration
+%type <obj> InterfaceDeclaration NormalClassDeclaration EnumDeclaration
+%type <obj> NormalInterfaceDeclaration AnnotationTypeDeclaration Super
+%type <obj> ClassOrInterfaceType Modifiers EnumBody AnnotationTypeBody
+%type <obj> Type TypeArgument TypeParameter Annotation ElementValue
+%type <obj> ElementValuePair ConditionalExpression
+%type <obj> ElementValueArrayInitializer ClassBodyDeclaration Block
+%type <obj> VoidMethodDeclaratorRest ConstructorDeclaratorRest
+%type <obj> GenericMethodOrConstructorDecl MethodDeclaratorRest
+%type <obj> VariableDeclarator MethodBody GenericMethodOrConstructorRest
+%type <obj> InterfaceGenericMethodDecl InterfaceMethodDeclaratorRest
+%type <obj> ConstantDeclaratorRest ConstantDeclarator
+%type <obj> VariableInitializer VoidInterfaceMethodDeclaratorRest
+%type <obj> FormalParameter VariableModifiers FormalParameterDecl
+%type <obj> VariableDeclaratorId Expression BlockStatement
+%type <obj> LocalVariableDeclarationStatement Statement ForControl
+%type <obj> Finally CatchClause Resource SwitchBlockStatementGroup
+%type <obj> SwitchLabel ForEachControl ForExprControl ForNoInitControl
+%type <obj> ForVarControl ForVarInit ForVarDecl LogicalOrExpression
+%type <obj> LogicalAndExpression BitwiseOrExpression
+%type <obj> BitwiseXorExpression BitwiseAndExpression EqualityExpression
+%type <obj> RelationalExpression AdditiveExpression
+%type <obj> MultiplicativeExpression CastExpression PrimaryExpression
+%type <obj> PlainNewAllocationExpression ComplexPrimaryNoParenthesis
+%type <obj> ArrayAllocationExpression ClassAllocationExpression DimExpr
+%type <obj> EnumConstant AnnotationTypeElementDeclaration
+%type <obj> AnnotationMethodRest InterfaceMemberModifiers
+%type <objlist> ImportDeclarations TypeDeclarations TypeParameters
+%type <objlist> ClassBody InterfaceBody TypeArguments TypeArgumentList
+%type <objlist> TypeParameterList TypeBound Annotations
+%type <objlist> AnnotationElement ElementValuePairs ElementValues
+%type <objlist> ClassBodyDeclarations MemberDecl MethodOrFieldDecl
+%type <objlist> MethodOrFieldRest VariableDeclarators FormalParameters
+%type <objlist> InterfaceBodyDeclarations InterfaceBodyDeclaration
+%type <objlist> InterfaceMemberDecl InterfaceMethodOrFieldDecl
+%type <objlist> InterfaceMethodOrFieldRest ConstantDeclaratorsRest
+%type <objlist> ConstantDeclarators FormalParameterList
+%type <objlist> LocalVariableDeclarators BlockStatements
+%type <objlist> SwitchBlockStatementGroups Catches ResourceSpecification
+%type <objlist> Resources SwitchLabels ForUpdate ForInit Arguments
+%type <objlist> ArgumentList DimExprs EnumConstants EnumBodyDeclarations
+%type <objlist> AnnotationTypeElementDeclarations
+%type <count> SemiColons Dims
+%type <name> QualifiedName TypeName
+%type <namelist> QualifiedNameList ExtendsInterfaces TypeList Interfaces
+%type <namelist> Throws CatchType
+%type <str> PrimitiveType Modifier AssignmentOperator LogicalOrOp
+%type <str> LogicalAndOp BitwiseOrOp BitwiseXorOp BitwiseAndOp
+%type <str> EqualityOp RelationalOp AdditiveOp MultiplicativeOp UnaryOp
+%type <str> PostfixOp
+%type <varlist> ArrayInitializer VariableInitializers ArrayInitializers
+
+%start Goal
+
+%%
+
+Goal
+	: CompilationUnit
+	{
+		var mylex *myLexer
+		if l, ok := Julylex.(*myLexer); !ok {
+			panic(fmt.Sprintf("bad lexer type %T (should be *myLexer)",
+				Julylex))
+		} else {
+			mylex = l
+		}
+
+		if prog, ok := $1.(*JProgramFile); !ok {
+			ReportCastError("JProgramFile", $1)
+		} else {
+
+			mylex.SetJavaProgram(prog)
+		}
+	}
+	;
+
+CompilationUnit
+	: PackageDeclaration ImportDeclarations TypeDeclarations
+	{
+		$$ = NewJProgramFile($1, $2, $3)
+	}
+	| PackageDeclaration ImportDeclarations
+	{
+		$$ = NewJProgramFile($1, $2, nil)
+	}
+	| PackageDeclaration TypeDeclarations
+	{
+		$$ = NewJProgramFile($1, nil, $2)
+	}
+	| PackageDeclaration
+	{
+		$$ = NewJProgramFile($1, nil, nil)
+	}
+	| ImportDeclarations TypeDeclarations
+	{
+		$$ = NewJProgramFile(nil, $1, $2)
+	}
+	| ImportDeclarations
+	{
+		$$ = NewJProgramFile(nil, $1, nil)
+	}
+	| TypeDeclarations
+	{
+		$$ = NewJProgramFile(nil, nil, $1)
+	}
+	;
+
+SemiColons
+	: ';'
+	{
+		$$ = 1
+	}
+	| SemiColons ';'
+	{
+		$$ += 1
+	}
+	;
+
+QualifiedName
+	: IDENTIFIER
+	{
+		$$ = NewJTypeName($1, false)
+	}
+	| QualifiedName '.' IDENTIFIER
+	{
+		$1.Add($3)
+		$$ = $1
+	}
+	;
+
+QualifiedNameList
+	: QualifiedName
+	{
+		$$ = make([]*JTypeName, 1)
+		$$[0] = $1
+	}
+	| QualifiedNameList ',' QualifiedName
+	{
+		$$ = append($1, $3)
+	}
+	;
+
+PackageDeclaration
+	: PACKAGE QualifiedName SemiColons
+	{
+		$$ = NewJPackageStmt($2)
+	}
+	;
+
+ImportDeclarations
+	: ImportDeclaration
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| ImportDeclarations ImportDeclaration
+	{
+		$$ = append($1, $2)
+	}
+	;
+
+ImportDeclaration
+	: IMPORT STATIC QualifiedName '.' '*' SemiColons
+	{
+		$$ = NewJImportStmt($3, true, true)
+	}
+	| IMPORT STATIC QualifiedName SemiColons
+	{
+		$$ = NewJImportStmt($3, false, true)
+	}
+	| IMPORT QualifiedName '.' '*' SemiColons
+	{
+		$$ = NewJImportStmt($2, true, false)
+	}
+	| IMPORT QualifiedName SemiColons
+	{
+		$$ = NewJImportStmt($2, false, false)
+	}
+	;
+
+TypeDeclarations
+	: TypeDeclaration
+	{
+		$$ = make([]JObject, 1)
+		if $1 != nil {
+			$$[0] = $1
+		}
+	}
+	| TypeDeclarations TypeDeclaration
+	{
+		if $2 == nil {
+			$$ = $1
+		} else {
+			$$ = append($1, $2)
+		}
+	}
+	;
+
+TypeDeclaration
+	: ClassOrInterfaceDeclaration
+	{
+		$$ = $1
+	}
+	| ';'
+	{
+		$$ = nil
+	}
+	;
+
+ClassOrInterfaceDeclaration
+	: ClassDeclaration
+	{
+		$$ = $1
+	}
+	| InterfaceDeclaration
+	{
+		$$ = $1
+	}
+	;
+
+ClassDeclaration
+	: NormalClassDeclaration
+	{
+		$$ = $1
+	}
+	| EnumDeclaration
+	{
+		$$ = $1
+	}
+	;
+
+InterfaceDeclaration
+	: NormalInterfaceDeclaration
+	{
+		$$ = $1
+	}
+	| AnnotationTypeDeclaration
+	{
+		$$ = $1
+	}
+	;
+
+Super
+	: EXTENDS ClassOrInterfaceType
+	{
+		if jtyp, ok := $2.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $2)
+		} else {
+			$$ = jtyp
+		}
+	}
+	;
+
+ExtendsInterfaces
+	: EXTENDS TypeList
+	{
+		$$ = $2
+	}
+	;
+
+Interfaces
+	: IMPLEMENTS TypeList
+	{
+		$$ = $2
+	}
+	;
+
+NormalClassDeclaration
+	: Modifiers CLASS IDENTIFIER TypeParameters Super Interfaces ClassBody
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else if jtyp, ok := $5.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $5)
+		} else {
+			$$ = NewJClassDecl(jmod, $3, $4, jtyp,
+				$6, $7)
+		}
+	}
+	| Modifiers CLASS IDENTIFIER TypeParameters Super ClassBody
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else if jtyp, ok := $5.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $5)
+		} else {
+			$$ = NewJClassDecl(jmod, $3, $4, jtyp, nil,
+				$6)
+		}
+	}
+	| Modifiers CLASS IDENTIFIER TypeParameters Interfaces ClassBody
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else {
+			$$ = NewJClassDecl(jmod, $3, $4, nil,
+				$5, $6)
+		}
+	}
+	| Modifiers CLASS IDENTIFIER TypeParameters ClassBody
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else {
+			$$ = NewJClassDecl(jmod, $3, $4, nil, nil,
+				$5)
+		}
+	}
+	| Modifiers CLASS IDENTIFIER Super Interfaces ClassBody
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else if jtyp, ok := $4.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $4)
+		} else {
+			$$ = NewJClassDecl(jmod, $3, nil, jtyp, $5,
+				$6)
+		}
+	}
+	| Modifiers CLASS IDENTIFIER Super ClassBody
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else if jtyp, ok := $4.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $4)
+		} else {
+			$$ = NewJClassDecl(jmod, $3, nil, jtyp, nil,
+				$5)
+		}
+	}
+	| Modifiers CLASS IDENTIFIER Interfaces ClassBody
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else {
+			$$ = NewJClassDecl(jmod, $3, nil, nil, $4,
+				$5)
+		}
+	}
+	| Modifiers CLASS IDENTIFIER ClassBody
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else {
+			$$ = NewJClassDecl(jmod, $3, nil, nil, nil, $4)
+		}
+	}
+	;
+
+EnumDeclaration
+	: Modifiers ENUM IDENTIFIER Interfaces EnumBody
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else if jbody, ok := $5.(*JEnumBody); !ok {
+			ReportCastError("JEnumBody", $5)
+		} else {
+			$$ = NewJEnumDecl(jmod, $3, $4, jbody)
+		}
+	}
+	| Modifiers ENUM IDENTIFIER EnumBody
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else if jbody, ok := $4.(*JEnumBody); !ok {
+			ReportCastError("JEnumBody", $4)
+		} else {
+			$$ = NewJEnumDecl(jmod, $3, nil, jbody)
+		}
+	}
+	;
+
+NormalInterfaceDeclaration
+	: Modifiers INTERFACE IDENTIFIER TypeParameters ExtendsInterfaces InterfaceBody
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else {
+			$$ = NewJInterfaceDecl(jmod, NewJTypeName($3, false),
+				$4, $5, $6)
+		}
+	}
+	| Modifiers INTERFACE IDENTIFIER TypeParameters InterfaceBody
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else {
+			$$ = NewJInterfaceDecl(jmod, NewJTypeName($3, false),
+				$4, nil, $5)
+		}
+	}
+	| Modifiers INTERFACE IDENTIFIER ExtendsInterfaces InterfaceBody
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else {
+			$$ = NewJInterfaceDecl(jmod, NewJTypeName($3, false),
+				nil, $4, $5)
+		}
+	}
+	| Modifiers INTERFACE IDENTIFIER InterfaceBody
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else {
+			$$ = NewJInterfaceDecl(jmod, NewJTypeName($3, false),
+				nil, nil, $4)
+		}
+	}
+	;
+
+AnnotationTypeDeclaration
+	: Modifiers '@' INTERFACE IDENTIFIER AnnotationTypeBody
+	{
+		$$ = NewJUnimplemented("AnnotationTypeDeclaration#0")
+	}
+	;
+
+Dims
+	: OP_DIM
+	{
+		$$ = 1
+	}
+	| Dims OP_DIM
+	{
+		$$ = $1 + 1
+	}
+	;
+
+Type
+	: PrimitiveType
+	{
+		$$ = NewJReferenceType(NewJTypeName($1, true), nil, 0)
+	}
+	| PrimitiveType Dims
+	{
+		$$ = NewJReferenceType(NewJTypeName($1, true), nil,
+			$2)
+	}
+	| ClassOrInterfaceType
+	{
+		$$ = $1
+	}
+	;
+
+ClassOrInterfaceType
+	: QualifiedName TypeArguments Dims
+	{
+		$$ = NewJReferenceType($1, $2, $3)
+	}
+	| QualifiedName TypeArguments
+	{
+		$$ = NewJReferenceType($1, $2, 0)
+	}
+	| QualifiedName Dims
+	{
+		$$ = NewJReferenceType($1, nil, $2)
+	}
+	| QualifiedName
+	{
+		$$ = NewJReferenceType($1, nil, 0)
+	}
+	;
+
+TypeName
+	: PrimitiveType
+	{
+		$$ = NewJTypeName($1, true)
+	}
+	| QualifiedName
+	{
+		$$ = $1
+	}
+	;
+
+PrimitiveType
+	: BYTE
+	{
+		$$ = $1
+	}
+	| SHORT
+	{
+		$$ = $1
+	}
+	| CHAR
+	{
+		$$ = $1
+	}
+	| INT
+	{
+		$$ = $1
+	}
+	| LONG
+	{
+		$$ = $1
+	}
+	| FLOAT
+	{
+		$$ = $1
+	}
+	| DOUBLE
+	{
+		$$ = $1
+	}
+	| BOOLEAN
+	{
+		$$ = $1
+	}
+	;
+
+TypeArguments
+	: '<' TypeArgumentList '>'
+	{
+		$$ = $2
+	}
+	;
+
+TypeArgumentList
+	: TypeArgument
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| TypeArgumentList ',' TypeArgument
+	{
+		$$ = append($1, $3)
+	}
+	;
+
+TypeArgument
+	: Type
+	{
+		if jtyp, ok := $1.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $1)
+		} else {
+			$$ = NewJTypeArgument(jtyp, TS_NONE)
+		}
+	}
+	| '?' EXTENDS Type
+	{
+		if jtyp, ok := $3.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $3)
+		} else {
+			$$ = NewJTypeArgument(jtyp, TS_EXTENDS)
+		}
+	}
+	| '?' SUPER Type
+	{
+		if jtyp, ok := $3.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $3)
+		} else {
+			$$ = NewJTypeArgument(jtyp, TS_SUPER)
+		}
+	}
+	| '?'
+	{
+		$$ = NewJTypeArgument(nil, TS_PLAIN)
+	}
+	;
+
+TypeParameters
+	: '<' TypeParameterList '>'
+	{
+		$$ = $2
+	}
+	;
+
+TypeParameterList
+	: TypeParameter
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| TypeParameters ',' TypeParameter
+	{
+		$$ = append($1, $<obj>2)
+	}
+	;
+
+TypeParameter
+	: IDENTIFIER EXTENDS TypeBound
+	{
+		$$ = NewJTypeParameter($1, $3)
+	}
+	| IDENTIFIER
+	{
+		$$ = NewJTypeParameter($1, nil)
+	}
+	;
+
+TypeBound
+	: ClassOrInterfaceType
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| TypeBound '&' ClassOrInterfaceType
+	{
+		$$ = append($1, $<obj>2)
+	}
+	;
+
+Modifier
+	: PUBLIC
+	{
+		$$ = $1
+	}
+	| PROTECTED
+	{
+		$$ = $1
+	}
+	| PRIVATE
+	{
+		$$ = $1
+	}
+	| STATIC
+	{
+		$$ = $1
+	}
+	| ABSTRACT
+	{
+		$$ = $1
+	}
+	| FINAL
+	{
+		$$ = $1
+	}
+	| NATIVE
+	{
+		$$ = $1
+	}
+	| SYNCHRONIZED
+	{
+		$$ = $1
+	}
+	| TRANSIENT
+	{
+		$$ = $1
+	}
+	| VOLATILE
+	{
+		$$ = $1
+	}
+	;
+
+Modifiers
+	: /* empty */
+	{
+		$$ = NewJModifiers("", nil)
+	}
+	| Annotation
+	{
+		if jann, ok := $1.(*JAnnotation); !ok {
+			ReportCastError("JAnnotation", $1)
+		} else {
+			jmod := NewJModifiers("", jann)
+			$$ = jmod
+		}
+	}
+	| Modifier
+	{
+		$$ = NewJModifiers($1, nil)
+	}
+	| Modifiers Modifier
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else {
+			jmod.AddModifier($2)
+			$$ = jmod
+		}
+	}
+	| Modifiers Annotation
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else {
+			if jann, ok := $2.(*JAnnotation); !ok {
+				ReportCastError("JAnnotation", $2)
+			} else {
+				jmod.AddAnnotation(jann)
+				$$ = jmod
+			}
+		}
+	}
+	;
+
+Annotations
+	: Annotation
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| Annotations Annotation
+	{
+		$$ = append($1, $2)
+	}
+	;
+
+Annotation
+	: '@' QualifiedName '(' AnnotationElement ')'
+	{
+		$$ = NewJAnnotation($2, $4, true)
+	}
+	| '@' QualifiedName '(' ')'
+	{
+		$$ = NewJAnnotation($2, nil, true)
+	}
+	| '@' QualifiedName
+	{
+		$$ = NewJAnnotation($2, nil, false)
+	}
+	;
+
+AnnotationElement
+	: ElementValuePairs
+	{
+		$$ = $1
+	}
+	| ElementValue
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	;
+
+ElementValuePairs
+	: ElementValuePair
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| ElementValuePairs ',' ElementValuePair
+	{
+		$$ = append($1, $<obj>2)
+	}
+	;
+
+ElementValuePair
+	: IDENTIFIER '=' ElementValue
+	{
+		$$ = NewJElementValuePair($1, $3)
+	}
+	;
+
+ElementValue
+	: Annotation
+	{
+		$$ = $1
+	}
+	| ConditionalExpression
+	{
+		$$ = $1
+	}
+	| ElementValueArrayInitializer
+	{
+		$$ = $1
+	}
+	;
+
+ElementValueArrayInitializer
+	: '{' ElementValues ',' '}'
+	{
+		$$ = NewJUnimplemented("ElementValueArrayInitializer#0")
+	}
+	| '{' ElementValues '}'
+	{
+		$$ = NewJUnimplemented("ElementValueArrayInitializer#1")
+	}
+	| '{' ',' '}'
+	{
+		$$ = NewJUnimplemented("ElementValueArrayInitializer#2")
+	}
+	| '{' '}'
+	{
+		$$ = NewJUnimplemented("ElementValueArrayInitializer#3")
+	}
+	;
+
+ElementValues
+	: ElementValue
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| ElementValues ',' ElementValue
+	{
+		$$ = append($1, $3)
+	}
+	;
+
+ClassBody
+	: '{' ClassBodyDeclarations '}'
+	{
+		$$ = $2
+	}
+	| '{' '}'
+	{
+		$$ = nil
+	}
+	;
+
+ClassBodyDeclaration
+	: ';'
+	{
+		$$ = NewJEmpty()
+	}
+	| MemberDecl
+	{
+		$$ = NewJClassBody($1)
+	}
+	| STATIC Block
+	{
+		if jblk, ok := $2.(*JBlock); !ok {
+			ReportCastError("JBlock", $2)
+		} else {
+			jblk.SetStatic()
+			$$ = jblk
+		}
+	}
+	| Block
+	{
+		if jblk, ok := $1.(*JBlock); !ok {
+			ReportCastError("JBlock", $1)
+		} else {
+			$$ = jblk
+		}
+	}
+	;
+
+MemberDecl
+	: MethodOrFieldDecl
+	{
+		if $1 == nil || len($1) == 0 {
+			panic("Got empty list from MethodOrFieldDecl")
+		}
+
+		$$ = $1
+	}
+	| Modifiers VOID IDENTIFIER VoidMethodDeclaratorRest
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else if jmth, ok := $4.(*JMethodDecl); !ok {
+			ReportCastError("JMethodDecl", $4)
+		} else {
+			jmth.SetModifiers(jmod)
+			jmth.SetName($3)
+
+			$$ = make([]JObject, 1)
+			$$[0] = jmth
+		}
+	}
+	| Modifiers IDENTIFIER ConstructorDeclaratorRest
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else if jmth, ok := $3.(*JMethodDecl); !ok {
+			ReportCastError("JMethodDecl", $3)
+		} else {
+			jmth.SetModifiers(jmod)
+			jmth.SetName($2)
+			jmth.SetType(NewJReferenceType(NewJTypeName($<str>3, false),
+				nil, 0))
+
+			$$ = make([]JObject, 1)
+			$$[0] = jmth
+		}
+	}
+	| GenericMethodOrConstructorDecl
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| ClassDeclaration
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| InterfaceDeclaration
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	;
+
+MethodOrFieldDecl
+	: Modifiers Type MethodOrFieldRest
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else if jtyp, ok := $2.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $2)
+		} else if $3 == nil || len($3) == 0 {
+			panic("MethodOrFieldRest list is nil/empty")
+		} else {
+			for _, obj := range $3 {
+				if jmth, ok := obj.(*JMethodDecl); ok {
+					jmth.SetModifiers(jmod)
+					jmth.SetType(jtyp)
+				} else if jvar, ok := obj.(*JVariableDecl); ok {
+					jvar.SetModifiers(jmod)
+					jvar.SetType(jtyp)
+				} else {
+					ReportCastError("MethodOrFieldDecl", obj)
+				}
+			}
+			$$ = $3
+		}
+	}
+	;
+
+MethodOrFieldRest
+	: VariableDeclarators ';'
+	{
+		$$ = $1
+	}
+	| IDENTIFIER ';'
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = NewJVariableDecl($1, 0, nil)
+	}
+	| IDENTIFIER MethodDeclaratorRest
+	{
+		if jmth, ok := $2.(*JMethodDecl); !ok {
+			ReportCastError("JMethodDecl", $2)
+		} else {
+			jmth.SetName($1)
+			$$ = make([]JObject, 1)
+			$$[0] = jmth
+		}
+	}
+	;
+
+VariableDeclarators
+	: VariableDeclarator
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| VariableDeclarators ',' VariableDeclarator
+	{
+		$$ = append($1, $3)
+	}
+	;
+
+MethodBody
+	: Block
+	{
+		if jblk, ok := $1.(*JBlock); !ok {
+			ReportCastError("JBlock", $1)
+		} else {
+			$$ = jblk
+		}
+	}
+	| ';'
+	{
+		$$ = NewJBlock(nil)
+	}
+	;
+
+Throws
+	: THROWS QualifiedNameList
+	{
+		$$ = $2
+	}
+	;
+
+MethodDeclaratorRest
+	: FormalParameters Dims Throws MethodBody
+	{
+		if jblk, ok := $4.(*JBlock); !ok {
+			ReportCastError("JBlock", $4)
+		} else {
+			$$ = NewJMethodDecl(makeFormalParamList($1),
+				$2, $3, jblk)
+		}
+	}
+	| FormalParameters Dims MethodBody
+	{
+		if jblk, ok := $3.(*JBlock); !ok {
+			ReportCastError("JBlock", $3)
+		} else {
+			$$ = NewJMethodDecl(makeFormalParamList($1),
+				$2, nil, jblk)
+		}
+	}
+	| FormalParameters Throws MethodBody
+	{
+		if jblk, ok := $3.(*JBlock); !ok {
+			ReportCastError("JBlock", $3)
+		} else {
+			$$ = NewJMethodDecl(makeFormalParamList($1),
+				0, $2, jblk)
+		}
+	}
+	| FormalParameters MethodBody
+	{
+		if jblk, ok := $2.(*JBlock); !ok {
+			ReportCastError("JBlock", $2)
+		} else {
+			$$ = NewJMethodDecl(makeFormalParamList($1),
+				0, nil, jblk)
+		}
+	}
+	;
+
+VoidMethodDeclaratorRest
+	: FormalParameters Throws MethodBody
+	{
+		if jblk, ok := $3.(*JBlock); !ok {
+			ReportCastError("JBlock", $3)
+		} else {
+			jmth := NewJMethodDecl(makeFormalParamList($1), 0,
+				$2, jblk)
+			jmth.SetType(NewJReferenceType(NewJTypeName("void", true),
+				nil, 0))
+			$$ = jmth
+		}
+	}
+	| FormalParameters MethodBody
+	{
+		if jblk, ok := $2.(*JBlock); !ok {
+			ReportCastError("JBlock", $2)
+		} else {
+			jmth := NewJMethodDecl(makeFormalParamList($1), 0,
+				nil, jblk)
+			jmth.SetType(NewJReferenceType(NewJTypeName("void", true),
+				nil, 0))
+			$$ = jmth
+		}
+	}
+	;
+
+ConstructorDeclaratorRest
+	: FormalParameters Throws Block
+	{
+		if jblk, ok := $3.(*JBlock); !ok {
+			ReportCastError("JBlock", $3)
+		} else {
+			$$ = NewJMethodDecl(makeFormalParamList($1), 0,
+				$2, jblk)
+		}
+	}
+	| FormalParameters Block
+	{
+		if jblk, ok := $2.(*JBlock); !ok {
+			ReportCastError("JBlock", $2)
+		} else {
+			$$ = NewJMethodDecl(makeFormalParamList($1), 0,
+				nil, jblk)
+		}
+	}
+	;
+
+GenericMethodOrConstructorDecl
+	: Modifiers TypeParameters GenericMethodOrConstructorRest
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else if jmth, ok := $3.(*JMethodDecl); !ok {
+			ReportCastError("JMethodDecl", $3)
+		} else {
+			jmth.SetModifiers(jmod)
+			jmth.SetTypeParameters($2)
+			$$ = jmth
+		}
+	}
+	;
+
+GenericMethodOrConstructorRest
+	: Type IDENTIFIER MethodDeclaratorRest
+	{
+		if jmth, ok := $3.(*JMethodDecl); !ok {
+			ReportCastError("JMethodDecl", $3)
+		} else {
+			if jtyp, ok := $1.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", $1)
+			} else {
+				jmth.SetType(jtyp)
+				jmth.SetName($2)
+				$$ = jmth
+			}
+		}
+	}
+	| VOID IDENTIFIER MethodDeclaratorRest
+	{
+		if jmth, ok := $3.(*JMethodDecl); !ok {
+			ReportCastError("JMethodDecl", $3)
+		} else {
+			jmth.SetType(NewJReferenceType(NewJTypeName($1, false),
+				nil, 0))
+			jmth.SetName($2)
+			$$ = jmth
+		}
+	}
+	| IDENTIFIER ConstructorDeclaratorRest
+	{
+		if jmth, ok := $2.(*JMethodDecl); !ok {
+			ReportCastError("JMethodDecl", $2)
+		} else {
+			jmth.SetName($1)
+			jmth.SetType(NewJReferenceType(NewJTypeName($1, false),
+				nil, 0))
+			$$ = jmth
+		}
+	}
+	;
+
+InterfaceBody
+	: '{' InterfaceBodyDeclarations '}'
+	{
+		$$ = $2
+	}
+	| '{' '}'
+	{
+		$$ = make([]JObject, 0)
+	}
+	;
+
+InterfaceBodyDeclarations
+	: InterfaceBodyDeclaration
+	{
+		$$ = $1
+	}
+	| InterfaceBodyDeclarations InterfaceBodyDeclaration
+	{
+		$$ = append($1, $2...)
+	}
+	;
+
+InterfaceBodyDeclaration
+	: ';'
+	{
+		$$ = make([]JObject, 0)
+	}
+	| InterfaceMemberDecl
+	{
+		$$ = $1
+	}
+	;
+
+InterfaceMemberDecl
+	: InterfaceMethodOrFieldDecl
+	{
+		$$ = $1
+	}
+	| InterfaceGenericMethodDecl
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| ClassDeclaration
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| InterfaceDeclaration
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	;
+
+InterfaceMethodOrFieldDecl
+	: InterfaceMemberModifiers Type IDENTIFIER InterfaceMethodOrFieldRest
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else if jtyp, ok := $2.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $2)
+		} else if $4 == nil || len($4) == 0 {
+			panic("InterfaceMethodOrFieldRest list is nil/empty")
+		} else {
+			for _, obj := range $4 {
+				if jimd, ok := obj.(*JInterfaceMethodDecl); ok {
+					jimd.SetModifiers(jmod)
+					jimd.SetType(jtyp)
+					jimd.SetName($3)
+				} else if jmth, ok := obj.(*JMethodDecl); ok {
+					jmth.SetModifiers(jmod)
+					jmth.SetType(jtyp)
+					jmth.SetName($3)
+				} else if jcd, ok := obj.(*JConstantDecl); ok {
+					jcd.SetModifiers(jmod)
+					jcd.SetType(jtyp)
+					if !jcd.HasName() {
+						jcd.SetName($3)
+					}
+				} else {
+					ReportCastError("InterfaceMethodOrFieldDecl", obj)
+				}
+			}
+			$$ = $4
+		}
+	}
+	;
+
+InterfaceMethodOrFieldRest
+	: ConstantDeclaratorsRest ';'
+	{
+		$$ = $1
+	}
+	| InterfaceMethodDeclaratorRest
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	;
+
+ConstantDeclaratorsRest
+	: ConstantDeclaratorRest
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| ConstantDeclaratorRest ',' ConstantDeclarators
+	{
+		$$ = append($3, $1)
+	}
+	;
+
+ConstantDeclarators
+	: ConstantDeclarator
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| ConstantDeclarators ',' ConstantDeclarator
+	{
+		$$ = append($1, $3)
+	}
+	;
+
+ConstantDeclarator
+	: IDENTIFIER Dims '=' VariableInitializer
+	{
+		if init, ok := $4.(*JVariableInit); !ok {
+			ReportCastError("JVariableInit", $4)
+		} else {
+			$$ = NewJConstantDecl($1, $2, init)
+		}
+	}
+	| IDENTIFIER '=' VariableInitializer
+	{
+		if init, ok := $3.(*JVariableInit); !ok {
+			ReportCastError("JVariableInit", $3)
+		} else {
+			$$ = NewJConstantDecl($1, 0, init)
+		}
+	}
+	;
+
+ConstantDeclaratorRest
+	: Dims '=' VariableInitializer
+	{
+		if init, ok := $3.(*JVariableInit); !ok {
+			ReportCastError("JVariableInit", $3)
+		} else {
+			$$ = NewJConstantDecl("", $1, init)
+		}
+	}
+	| '=' VariableInitializer
+	{
+		if init, ok := $2.(*JVariableInit); !ok {
+			ReportCastError("JVariableInit", $2)
+		} else {
+			$$ = NewJConstantDecl("", 0, init)
+		}
+	}
+	;
+
+InterfaceMethodDeclaratorRest
+	: FormalParameters Dims Throws ';'
+	{
+		$$ = NewJInterfaceMethodDecl(makeFormalParamList($1),
+			$2, $3)
+	}
+	| FormalParameters Dims ';'
+	{
+		$$ = NewJInterfaceMethodDecl(makeFormalParamList($1),
+			$2, nil)
+	}
+	| FormalParameters Throws ';'
+	{
+		$$ = NewJInterfaceMethodDecl(makeFormalParamList($1),
+			0, $<namelist>3)
+	}
+	| FormalParameters ';'
+	{
+		$$ = NewJInterfaceMethodDecl(makeFormalParamList($1),
+			0, nil)
+	}
+	| FormalParameters Dims Throws Block
+	{
+		if jblk, ok := $4.(*JBlock); !ok {
+			ReportCastError("JBlock", $4)
+		} else {
+			$$ = NewJMethodDecl(makeFormalParamList($1),
+				$2, $3, jblk)
+		}
+	}
+	| FormalParameters Dims Block
+	{
+		if jblk, ok := $3.(*JBlock); !ok {
+			ReportCastError("JBlock", $3)
+		} else {
+			$$ = NewJMethodDecl(makeFormalParamList($1),
+				$2, nil, jblk)
+		}
+	}
+	| FormalParameters Throws Block
+	{
+		if jblk, ok := $3.(*JBlock); !ok {
+			ReportCastError("JBlock", $3)
+		} else {
+			$$ = NewJMethodDecl(makeFormalParamList($1),
+				0, $2, jblk)
+		}
+	}
+	| FormalParameters Block
+	{
+		if jblk, ok := $2.(*JBlock); !ok {
+			ReportCastError("JBlock", $2)
+		} else {
+			$$ = NewJMethodDecl(makeFormalParamList($1),
+				0, nil, jblk)
+		}
+	}
+	;
+
+VoidInterfaceMethodDeclaratorRest
+	: FormalParameters Throws ';'
+	{
+		$$ = NewJInterfaceMethodDecl(makeFormalParamList($1),
+			0, $<namelist>3)
+	}
+	| FormalParameters ';'
+	{
+		$$ = NewJInterfaceMethodDecl(makeFormalParamList($1),
+			0, nil)
+	}
+	| FormalParameters Throws Block
+	{
+		if jblk, ok := $3.(*JBlock); !ok {
+			ReportCastError("JBlock", $3)
+		} else {
+			$$ = NewJMethodDecl(makeFormalParamList($1),
+				0, $2, jblk)
+		}
+	}
+	| FormalParameters Block
+	{
+		if jblk, ok := $2.(*JBlock); !ok {
+			ReportCastError("JBlock", $2)
+		} else {
+			$$ = NewJMethodDecl(makeFormalParamList($1),
+				0, nil, jblk)
+		}
+	}
+	;
+
+InterfaceGenericMethodDecl
+	: InterfaceMemberModifiers TypeParameters Type IDENTIFIER InterfaceMethodDeclaratorRest
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else if jtyp, ok := $3.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $3)
+		} else if jifc, ok := $5.(methodDecl); !ok {
+			ReportCastError("JInterfaceMethodDecl", $5)
+		} else {
+			jifc.SetModifiers(jmod)
+			jifc.SetTypeParameters($2)
+			jifc.SetType(jtyp)
+			jifc.SetName($4)
+
+			$$ = jifc
+		}
+	}
+	| InterfaceMemberModifiers TypeParameters VOID IDENTIFIER InterfaceMethodDeclaratorRest
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else if jifc, ok := $5.(methodDecl); !ok {
+			ReportCastError("JInterfaceMethodDecl", $5)
+		} else {
+			jifc.SetModifiers(jmod)
+			jifc.SetTypeParameters($2)
+			jifc.SetType(NewJReferenceType(NewJTypeName("void", true),
+				nil, 0))
+			jifc.SetName($4)
+
+			$$ = jifc
+		}
+	}
+	| InterfaceMemberModifiers VOID IDENTIFIER VoidInterfaceMethodDeclaratorRest
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else if jifc, ok := $4.(methodDecl); !ok {
+			ReportCastError("JInterfaceMethodDecl", $4)
+		} else {
+			jifc.SetModifiers(jmod)
+			jifc.SetType(NewJReferenceType(NewJTypeName("void", true),
+				nil, 0))
+			jifc.SetName($3)
+
+			$$ = jifc
+		}
+	}
+	;
+
+InterfaceMemberModifiers
+	: Modifiers
+	{
+		$$ = $1
+	}
+	| Modifiers DEFAULT
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else {
+			jmod.AddModifier($2)
+			$$ = jmod
+		}
+	}
+	;
+
+FormalParameters
+	: '(' FormalParameterList ')'
+	{
+		$$ = $2
+	}
+	| '(' ')'
+	{
+		$$ = nil
+	}
+	;
+
+FormalParameterList
+	: FormalParameter
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| FormalParameterList ',' FormalParameter
+	{
+		$$ = append($1, $3)
+	}
+	;
+
+FormalParameter
+	: VariableModifiers FormalParameterDecl
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else {
+			if fprm, ok := $2.(*JFormalParameter); !ok {
+				ReportCastError("JFormalParameter", $2)
+			} else {
+				fprm.SetModifiers(jmod)
+				$$ = fprm
+			}
+		}
+	}
+	| FormalParameterDecl
+	{
+		$$ = $1
+	}
+	;
+
+FormalParameterDecl
+	: Type '.' '.' '.' IDENTIFIER Dims
+	{
+		if jtyp, ok := $1.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $1)
+		} else {
+			$$ = NewJFormalParameter(jtyp, true, $5, $6)
+		}
+	}
+	| Type IDENTIFIER Dims
+	{
+		if jtyp, ok := $1.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $1)
+		} else {
+			$$ = NewJFormalParameter(jtyp, false, $2, $3)
+		}
+	}
+	| Type '.' '.' '.' IDENTIFIER
+	{
+		if jtyp, ok := $1.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $1)
+		} else {
+			$$ = NewJFormalParameter(jtyp, true, $5, 0)
+		}
+	}
+	| Type IDENTIFIER
+	{
+		if jtyp, ok := $1.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $1)
+		} else {
+			$$ = NewJFormalParameter(jtyp, false, $2, 0)
+		}
+	}
+	;
+
+VariableModifiers
+	: FINAL
+	{
+		$$ = NewJModifiers($1, nil)
+	}
+	| Annotation
+	{
+		if jann, ok := $1.(*JAnnotation); !ok {
+			ReportCastError("JAnnotation", $1)
+		} else {
+			jmod := NewJModifiers("", jann)
+			$$ = jmod
+		}
+	}
+	| VariableModifiers FINAL
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else {
+			jmod.AddModifier($2)
+			$$ = jmod
+		}
+	}
+	| VariableModifiers Annotation
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else {
+			if jann, ok := $2.(*JAnnotation); !ok {
+				ReportCastError("JAnnotation", $2)
+			} else {
+				jmod.AddAnnotation(jann)
+				$$ = jmod
+			}
+		}
+	}
+	;
+
+VariableDeclaratorId
+	: IDENTIFIER Dims
+	{
+		$$ = &tmpVariableId{name: $1, dims: $2}
+	}
+	| IDENTIFIER
+	{
+		$$ = &tmpVariableId{name: $1, dims: 0}
+	}
+	;
+
+LocalVariableDeclarators
+	: VariableDeclarator
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| LocalVariableDeclarators ',' VariableDeclarator
+	{
+		$$ = append($1, $3)
+	}
+	;
+
+VariableDeclarator
+	: IDENTIFIER Dims '=' VariableInitializer
+	{
+		if init, ok := $4.(*JVariableInit); !ok {
+			ReportCastError("JVariableInit", $4)
+		} else {
+			$$ = NewJVariableDecl($1, $2, init)
+		}
+	}
+	| IDENTIFIER Dims
+	{
+		$$ = NewJVariableDecl($1, $2, nil)
+	}
+	| IDENTIFIER '=' VariableInitializer
+	{
+		if init, ok := $3.(*JVariableInit); !ok {
+			ReportCastError("JVariableInit", $3)
+		} else {
+			$$ = NewJVariableDecl($1, 0, init)
+		}
+	}
+	| IDENTIFIER
+	{
+		$$ = NewJVariableDecl($1, 0, nil)
+	}
+	;
+
+VariableInitializer
+	: ArrayInitializer
+	{
+		$$ = NewJVariableInit(nil, $1)
+	}
+	| Expression
+	{
+		$$ = NewJVariableInit($1, nil)
+	}
+	;
+
+VariableInitializers
+	: VariableInitializer
+	{
+		if init, ok := $1.(*JVariableInit); !ok {
+			ReportCastError("JVariableInit", $1)
+		} else {
+			$$ = make([]*JVariableInit, 1)
+			$$[0] = init
+		}
+	}
+	| VariableInitializers ',' VariableInitializer
+	{
+		if init, ok := $3.(*JVariableInit); !ok {
+			ReportCastError("JVariableInit", $3)
+		} else {
+			$$ = append($1, init)
+		}
+	}
+	;
+
+ArrayInitializer
+	: '{' VariableInitializers ',' '}'
+	{
+		$$ = $2
+	}
+	| '{' VariableInitializers '}'
+	{
+		$$ = $2
+	}
+	| '{' '}'
+	{
+		$$ = make([]*JVariableInit, 0)
+	}
+	;
+
+Block
+	: '{' BlockStatements '}'
+	{
+		$$ = NewJBlock($2)
+	}
+	;
+
+BlockStatements
+	: /* empty */
+	{
+		$$ = nil
+	}
+	| BlockStatement
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| BlockStatements BlockStatement
+	{
+		$$ = append($1, $2)
+	}
+	;
+
+BlockStatement
+	: LocalVariableDeclarationStatement
+	{
+		$$ = $1
+	}
+	| ClassOrInterfaceDeclaration
+	{
+		$$ = $1
+	}
+	| Statement
+	{
+		if $1 == nil {
+			panic("Found nil block statement")
+		}
+
+		$$ = $1
+	}
+	;
+
+LocalVariableDeclarationStatement
+	: VariableModifiers Type LocalVariableDeclarators ';'
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else {
+			if jtyp, ok := $2.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", $2)
+			} else {
+				$$ = NewJLocalVariableDecl(jmod, jtyp,
+					makeVarDeclList($3))
+			}
+		}
+	}
+	| Type LocalVariableDeclarators ';'
+	{
+		if jtyp, ok := $1.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $1)
+		} else {
+			$$ = NewJLocalVariableDecl(nil, jtyp,
+				makeVarDeclList($2))
+		}
+	}
+	;
+
+Statement
+	: Block
+	{
+		if jblk, ok := $1.(*JBlock); !ok {
+			ReportCastError("JBlock", $1)
+		} else {
+			$$ = jblk
+		}
+	}
+	| ';'
+	{
+		$$ = NewJEmpty()
+	}
+	| IDENTIFIER ':' Statement
+	{
+		$$ = NewJLabeledStatement($1, $3)
+	}
+	| Expression ';'
+	{
+		$$ = NewJSimpleStatement(nil, $1)
+	}
+	| IF '(' Expression ')' Statement ELSE Statement
+	{
+		$$ = NewJIfElseStmt($3, $5, $7)
+	}
+	| IF '(' Expression ')' Statement
+	{
+		$$ = NewJIfElseStmt($3, $5, nil)
+	}
+	| ASSERT Expression ':' Expression ';'
+	{
+		$$ = NewJUnimplemented("Statement#6")
+	}
+	| ASSERT Expression ';'
+	{
+		$$ = NewJUnimplemented("Statement#7")
+	}
+	| SWITCH '(' Expression ')' '{' SwitchBlockStatementGroups '}'
+	{
+		$$ = NewJSwitch($3, $6)
+	}
+	| SWITCH '(' Expression ')' '{' '}'
+	{
+		$$ = NewJSwitch($3, nil)
+	}
+	| WHILE '(' Expression ')' Statement
+	{
+		$$ = NewJWhile($3, $5, false)
+	}
+	| DO Statement WHILE '(' Expression ')' ';'
+	{
+		$$ = NewJWhile($5, $2, true)
+	}
+	| FOR '(' ForControl ')' Statement
+	{
+		if jfor, ok := $3.(*JForColon); ok {
+			jfor.SetBody($5)
+			$$ = jfor
+		} else if jforexp, ok := $3.(*JForExpr); ok {
+			jforexp.SetBody($5)
+			$$ = jforexp
+		} else if jforvar, ok := $3.(*JForVar); ok {
+			jforvar.SetBody($5)
+			$$ = jforvar
+		} else {
+			ReportCastError("JForVar", $3)
+		}
+	}
+	| BREAK IDENTIFIER ';'
+	{
+		$$ = NewJJumpToLabel($1, $2)
+	}
+	| BREAK ';'
+	{
+		$$ = NewJSimpleStatement(NewJKeyword($1, $<str>1), nil)
+	}
+	| CONTINUE IDENTIFIER ';'
+	{
+		$$ = NewJJumpToLabel($1, $2)
+	}
+	| CONTINUE ';'
+	{
+		$$ = NewJSimpleStatement(NewJKeyword($1, $<str>1), nil)
+	}
+	| RETURN Expression ';'
+	{
+		$$ = NewJSimpleStatement(NewJKeyword($1, $<str>1), $2)
+	}
+	| RETURN ';'
+	{
+		$$ = NewJSimpleStatement(NewJKeyword($1, $<str>1), nil)
+	}
+	| THROW Expression ';'
+	{
+		$$ = NewJSimpleStatement(NewJKeyword($1, $<str>1), $2)
+	}
+	| SYNCHRONIZED '(' Expression ')' Block
+	{
+		if jblk, ok := $5.(*JBlock); !ok {
+			ReportCastError("JBlock", $5)
+		} else {
+			$$ = NewJSynchronized($3, jblk)
+		}
+	}
+	| TRY Block Catches
+	{
+		if jblk, ok := $2.(*JBlock); !ok {
+			ReportCastError("JBlock", $2)
+		} else {
+			$$ = NewJTry(jblk, $3, nil)
+		}
+	}
+	| TRY Block Catches Finally
+	{
+		if jblk, ok := $2.(*JBlock); !ok {
+			ReportCastError("JBlock", $2)
+		} else if jfin, ok := $4.(*JBlock); !ok {
+			ReportCastError("JBlock", $4)
+		} else {
+			$$ = NewJTry(jblk, $3, jfin)
+		}
+	}
+	| TRY Block Finally
+	{
+		if jblk, ok := $2.(*JBlock); !ok {
+			ReportCastError("JBlock", $2)
+		} else if jfin, ok := $3.(*JBlock); !ok {
+			ReportCastError("JBlock", $3)
+		} else {
+			$$ = NewJTry(jblk, nil, jfin)
+		}
+	}
+	| TRY ResourceSpecification Block Catches Finally
+	{
+		if jblk, ok := $3.(*JBlock); !ok {
+			ReportCastError("JBlock", $3)
+		} else if jfin, ok := $5.(*JBlock); !ok {
+			ReportCastError("JBlock", $5)
+		} else {
+			$$ = NewJTryWithResources($2, jblk,
+				$4, jfin)
+		}
+	}
+	| TRY ResourceSpecification Block Catches
+	{
+		if jblk, ok := $3.(*JBlock); !ok {
+			ReportCastError("JBlock", $3)
+		} else {
+			$$ = NewJTryWithResources($2, jblk,
+				$4, nil)
+		}
+	}
+	| TRY ResourceSpecification Block Finally
+	{
+		if jblk, ok := $3.(*JBlock); !ok {
+			ReportCastError("JBlock", $3)
+		} else if jfin, ok := $4.(*JBlock); !ok {
+			ReportCastError("JBlock", $4)
+		} else {
+			$$ = NewJTryWithResources($2, jblk,
+				nil, jfin)
+		}
+	}
+	| TRY ResourceSpecification Block
+	{
+		if jblk, ok := $3.(*JBlock); !ok {
+			ReportCastError("JBlock", $3)
+		} else {
+			$$ = NewJTryWithResources($2, jblk,
+				nil, nil)
+		}
+	}
+	;
+
+Catches
+	: CatchClause
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| Catches CatchClause
+	{
+		$$ = append($1, $2)
+	}
+	;
+
+CatchClause
+	: CATCH '(' VariableModifiers CatchType IDENTIFIER ')' Block
+	{
+		if jmod, ok := $3.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $3)
+		} else {
+			if jblk, ok := $7.(*JBlock); !ok {
+				ReportCastError("JBlock", $7)
+			} else {
+				$$ = NewJCatch(jmod, $4, $5, jblk)
+			}
+		}
+	}
+	| CATCH '(' CatchType IDENTIFIER ')' Block
+	{
+		if jblk, ok := $6.(*JBlock); !ok {
+			ReportCastError("JBlock", $6)
+		} else {
+			$$ = NewJCatch(nil, $3, $4, jblk)
+		}
+	}
+	;
+
+CatchType
+	: QualifiedName
+	{
+		$$ = make([]*JTypeName, 1)
+		$$[0] = $1
+	}
+	| CatchType '|' QualifiedName
+	{
+		$$ = append($1, $3)
+	}
+	;
+
+Finally
+	: FINALLY Block
+	{
+		if jblk, ok := $2.(*JBlock); !ok {
+			ReportCastError("JBlock", $2)
+		} else {
+			$$ = jblk
+		}
+	}
+	;
+
+ResourceSpecification
+	: '(' Resources ';' ')'
+	{
+		$$ = $2
+	}
+	| '(' Resources ')'
+	{
+		$$ = $2
+	}
+	;
+
+Resources
+	: Resource
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| Resources ';' Resource
+	{
+		$$ = append($1, $3)
+	}
+	;
+
+Resource
+	: VariableModifiers ClassOrInterfaceType VariableDeclaratorId '=' Expression
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else if jtyp, ok := $2.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $2)
+		} else if jvid, ok := $3.(*tmpVariableId); !ok {
+			ReportCastError("tmpVariableId", $3)
+		} else {
+			$$ = NewJLocalVariableDecl(jmod, jtyp,
+				[]*JVariableDecl{NewJVariableDecl(jvid.name, jvid.dims,
+					NewJVariableInit($5, nil))})
+		}
+	}
+	| ClassOrInterfaceType VariableDeclaratorId '=' Expression
+	{
+		if jtyp, ok := $1.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $1)
+		} else if jvid, ok := $2.(*tmpVariableId); !ok {
+			ReportCastError("tmpVariableId", $2)
+		} else {
+			$$ = NewJLocalVariableDecl(nil, jtyp,
+				[]*JVariableDecl{NewJVariableDecl(jvid.name, jvid.dims,
+					NewJVariableInit($4, nil))})
+		}
+	}
+	;
+
+SwitchBlockStatementGroups
+	: SwitchBlockStatementGroup
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| SwitchBlockStatementGroups SwitchBlockStatementGroup
+	{
+		$$ = append($1, $2)
+	}
+	;
+
+SwitchBlockStatementGroup
+	: SwitchLabels BlockStatements
+	{
+		$$ = NewJSwitchGroup($1, $2)
+	}
+	;
+
+SwitchLabels
+	: SwitchLabel
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| SwitchLabels SwitchLabel
+	{
+		$$ = append($1, $2)
+	}
+	;
+
+SwitchLabel
+	: CASE Expression ':'
+	{
+		$$ = NewJSwitchLabel("", $2, false)
+	}
+	| CASE IDENTIFIER ':'
+	{
+		$$ = NewJSwitchLabel($1, nil, false)
+	}
+	| DEFAULT ':'
+	{
+		$$ = NewJSwitchLabel("", nil, true)
+	}
+	;
+
+TypeList
+	: TypeName
+	{
+		$$ = make([]*JTypeName, 1)
+		$$[0] = $1
+	}
+	| TypeList ',' TypeName
+	{
+		$$ = append($1, $3)
+	}
+	;
+
+ForControl
+	: ForEachControl
+	{
+		$$ = $1
+	}
+	| ForExprControl
+	{
+		$$ = $1
+	}
+	| ForNoInitControl
+	{
+		$$ = $1
+	}
+	| ForVarControl
+	{
+		$$ = $1
+	}
+	;
+
+ForEachControl
+	: VariableModifiers Type VariableDeclaratorId ':' Expression
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else if jtyp, ok := $2.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $2)
+		} else if jvid, ok := $3.(*tmpVariableId); !ok {
+			ReportCastError("tmpVariableId", $3)
+		} else {
+			$$ = NewJForColon(jmod, jtyp, jvid.name, jvid.dims, $5)
+		}
+	}
+	| Type VariableDeclaratorId ':' Expression
+	{
+		if jtyp, ok := $1.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $1)
+		} else if jvid, ok := $2.(*tmpVariableId); !ok {
+			ReportCastError("tmpVariableId", $2)
+		} else {
+			$$ = NewJForColon(nil, jtyp, jvid.name, jvid.dims, $4)
+		}
+	}
+	;
+
+ForNoInitControl
+	: ';' Expression ';' ForUpdate
+	{
+		$$ = NewJForExpr(nil, $2, $4)
+	}
+	| ';' Expression ';'
+	{
+		$$ = NewJForExpr(nil, $2, nil)
+	}
+	| ';' ';' ForUpdate
+	{
+		$$ = NewJForExpr(nil, nil, $3)
+	}
+	| ';' ';'
+	{
+		$$ = NewJForExpr(nil, nil, nil)
+	}
+	;
+
+ForExprControl
+	: ForInit ';' Expression ';' ForUpdate
+	{
+		$$ = NewJForExpr($1, $3, $5)
+	}
+	| ForInit ';' Expression ';'
+	{
+		$$ = NewJForExpr($1, $3, nil)
+	}
+	| ForInit ';' ';' ForUpdate
+	{
+		$$ = NewJForExpr($1, nil, $4)
+	}
+	| ForInit ';' ';'
+	{
+		$$ = NewJForExpr($1, nil, nil)
+	}
+	;
+
+ForVarControl
+	: ForVarInit ';' Expression ';' ForUpdate
+	{
+		if jfor, ok := $1.(*JForVar); !ok {
+			ReportCastError("JForVar", $1)
+		} else {
+			if $3 != nil {
+				jfor.SetExpr($3)
+			}
+
+			if $5 != nil {
+				jfor.SetIncr($5)
+			}
+
+			$$ = jfor
+		}
+	}
+	| ForVarInit ';' Expression ';'
+	{
+		if jfor, ok := $1.(*JForVar); !ok {
+			ReportCastError("JForVar", $1)
+		} else {
+			if $3 != nil {
+				jfor.SetExpr($3)
+			}
+
+			$$ = jfor
+		}
+	}
+	| ForVarInit ';' ';' ForUpdate
+	{
+		if jfor, ok := $1.(*JForVar); !ok {
+			ReportCastError("JForVar", $1)
+		} else {
+			if $4 != nil {
+				jfor.SetIncr($4)
+			}
+
+			$$ = jfor
+		}
+	}
+	| ForVarInit ';' ';'
+	{
+		if jfor, ok := $1.(*JForVar); !ok {
+			ReportCastError("JForVar", $1)
+		} else {
+			$$ = jfor
+		}
+	}
+	;
+
+ForVarInit
+	: ForVarDecl '=' VariableInitializer ',' LocalVariableDeclarators
+	{
+		if jfor, ok := $1.(*JForVar); !ok {
+			ReportCastError("JForVar", $1)
+		} else if init, ok := $3.(*JVariableInit); !ok {
+			ReportCastError("JVariableInit", $<obj>2)
+		} else {
+			jfor.SetInit(init)
+			jfor.SetDecl($<obj>5)
+			$$ = jfor
+		}
+	}
+	| ForVarDecl '=' VariableInitializer
+	{
+		if jfor, ok := $1.(*JForVar); !ok {
+			ReportCastError("JForVar", $1)
+		} else if init, ok := $3.(*JVariableInit); !ok {
+			ReportCastError("JVariableInit", $<obj>2)
+		} else {
+			jfor.SetInit(init)
+			$$ = jfor
+		}
+	}
+	| ForVarDecl ',' LocalVariableDeclarators
+	{
+		if jfor, ok := $1.(*JForVar); !ok {
+			ReportCastError("JForVar", $1)
+		} else {
+			jfor.SetDecl($<obj>3)
+			$$ = jfor
+		}
+	}
+	| ForVarDecl
+	{
+		if jfor, ok := $1.(*JForVar); !ok {
+			ReportCastError("JForVar", $1)
+		} else {
+			$$ = jfor
+		}
+	}
+	;
+
+ForVarDecl
+	: VariableModifiers Type VariableDeclaratorId
+	{
+		if jmod, ok := $1.(*JModifiers); !ok {
+			ReportCastError("JModifiers", $1)
+		} else if jtyp, ok := $2.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $2)
+		} else if jvid, ok := $3.(*tmpVariableId); !ok {
+			ReportCastError("tmpVariableId", $3)
+		} else {
+			$$ = NewJForVar(jmod, jtyp, jvid.name, jvid.dims)
+		}
+	}
+	| Type VariableDeclaratorId
+	{
+		if jtyp, ok := $1.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $1)
+		} else if jvid, ok := $2.(*tmpVariableId); !ok {
+			ReportCastError("tmpVariableId", $2)
+		} else {
+			$$ = NewJForVar(nil, jtyp, jvid.name, jvid.dims)
+		}
+	}
+	;
+
+ForUpdate
+	: Expression
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| ForUpdate ',' Expression
+	{
+		$$ = append($1, $3)
+	}
+	;
+
+ForInit
+	: Expression
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| ForInit ',' Expression
+	{
+		$$ = append($1, $3)
+	}
+	;
+
+Expression
+	: ConditionalExpression
+	{
+		if $1 == nil {
+			ReportError("ConditionalExpression cannot be nil")
+		}
+
+		$$ = $1
+	}
+	| ConditionalExpression AssignmentOperator Expression
+	{
+		$$ = NewJAssignmentExpr($1, $2, $3)
+	}
+	;
+
+AssignmentOperator
+	: '='
+	{
+		$$ = $<str>1
+	}
+	| ASS_ADD
+	{
+		$$ = $1
+	}
+	| ASS_SUB
+	{
+		$$ = $1
+	}
+	| ASS_MUL
+	{
+		$$ = $1
+	}
+	| ASS_DIV
+	{
+		$$ = $1
+	}
+	| ASS_AND
+	{
+		$$ = $1
+	}
+	| ASS_OR
+	{
+		$$ = $1
+	}
+	| ASS_XOR
+	{
+		$$ = $1
+	}
+	| ASS_MOD
+	{
+		$$ = $1
+	}
+	| '<' '<' '='
+	{
+		$$ = "<<="
+	}
+	| '>' '>' '='
+	{
+		$$ = ">>="
+	}
+	| '>' '>' '>' '='
+	{
+		$$ = ">>>="
+	}
+	;
+
+ConditionalExpression
+	: LogicalOrExpression
+	{
+		if $1 == nil {
+			ReportError("LogicalOrExpression cannot be nil")
+		}
+
+		$$ = $1
+	}
+	| LogicalOrExpression '?' Expression ':' ConditionalExpression
+	{
+		$$ = NewJConditionalExpr($1, $3, $5)
+	}
+	;
+
+LogicalOrExpression
+	: LogicalAndExpression
+	{
+		if $1 == nil {
+			ReportError("LogicalAndExpression cannot be nil")
+		}
+
+		$$ = $1
+	}
+	| LogicalOrExpression LogicalOrOp LogicalAndExpression
+	{
+		$$ = NewJBinaryExpr($1, $2, $3)
+	}
+	;
+
+LogicalOrOp
+	: OP_LOR
+	{
+		$$ = $1
+	}
+	;
+
+LogicalAndExpression
+	: BitwiseOrExpression
+	{
+		if $1 == nil {
+			ReportError("BitwiseOrExpression cannot be nil")
+		}
+
+		$$ = $1
+	}
+	| LogicalAndExpression LogicalAndOp BitwiseOrExpression
+	{
+		$$ = NewJBinaryExpr($1, $2, $3)
+	}
+	;
+
+LogicalAndOp
+	: OP_LAND
+	{
+		$$ = $1
+	}
+	;
+
+BitwiseOrExpression
+	: BitwiseXorExpression
+	{
+		if $1 == nil {
+			ReportError("BitwiseXorExpression cannot be nil")
+		}
+
+		$$ = $1
+	}
+	| BitwiseOrExpression BitwiseOrOp BitwiseXorExpression
+	{
+		$$ = NewJBinaryExpr($1, $2, $3)
+	}
+	;
+
+BitwiseOrOp
+	: '|'
+	{
+		$$ = $<str>1
+	}
+	;
+
+BitwiseXorExpression
+	: BitwiseAndExpression
+	{
+		if $1 == nil {
+			ReportError("BitwiseAndExpression cannot be nil")
+		}
+
+		$$ = $1
+	}
+	| BitwiseXorExpression BitwiseXorOp BitwiseAndExpression
+	{
+		$$ = NewJBinaryExpr($1, $2, $3)
+	}
+	;
+
+BitwiseXorOp
+	: '^'
+	{
+		$$ = $<str>1
+	}
+	;
+
+BitwiseAndExpression
+	: EqualityExpression
+	{
+		if $1 == nil {
+			ReportError("EqualityExpression cannot be nil")
+		}
+
+		$$ = $1
+	}
+	| BitwiseAndExpression BitwiseAndOp EqualityExpression
+	{
+		$$ = NewJBinaryExpr($1, $2, $3)
+	}
+	;
+
+BitwiseAndOp
+	: '&'
+	{
+		$$ = $<str>1
+	}
+	;
+
+EqualityExpression
+	: RelationalExpression
+	{
+		if $1 == nil {
+			ReportError("RelationalExpression cannot be nil")
+		}
+
+		$$ = $1
+	}
+	| EqualityExpression EqualityOp RelationalExpression
+	{
+		$$ = NewJBinaryExpr($1, $2, $3)
+	}
+	;
+
+EqualityOp
+	: OP_EQ
+	{
+		$$ = $1
+	}
+	| OP_NE
+	{
+		$$ = $1
+	}
+	;
+
+RelationalExpression
+	: AdditiveExpression
+	{
+		if $1 == nil {
+			ReportError("AdditiveExpression cannot be nil")
+		}
+
+		$$ = $1
+	}
+	| RelationalExpression RelationalOp AdditiveExpression
+	{
+		$$ = NewJBinaryExpr($1, $2, $3)
+	}
+	| RelationalExpression INSTANCEOF Type
+	{
+		if jtyp, ok := $3.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $3)
+		} else {
+			$$ = NewJInstanceOf($1, jtyp)
+		}
+	}
+	| RelationalExpression INSTANCEOF Type IDENTIFIER
+	{
+		if jtyp, ok := $3.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $3)
+		} else {
+			jins := NewJInstanceOf($1, jtyp)
+			jins.Name = $4
+			$$ = jins
+		}
+	}
+	;
+
+RelationalOp
+	: '<'
+	{
+		$$ = $<str>1
+	}
+	| '>'
+	{
+		$$ = $<str>1
+	}
+	| '<' '='
+	{
+		$$ = "<="
+	}
+	| '>' '='
+	{
+		$$ = ">="
+	}
+	| '<' '<'
+	{
+		$$ = "<<"
+	}
+	| '>' '>'
+	{
+		$$ = ">>"
+	}
+	| '>' '>' '>'
+	{
+		$$ = ">>>"
+	}
+	;
+
+AdditiveExpression
+	: MultiplicativeExpression
+	{
+		if $1 == nil {
+			ReportError("MultiplicativeExpression cannot be nil")
+		}
+
+		$$ = $1
+	}
+	| AdditiveExpression AdditiveOp MultiplicativeExpression
+	{
+		$$ = NewJBinaryExpr($1, $2, $3)
+	}
+	;
+
+AdditiveOp
+	: '+'
+	{
+		$$ = $<str>1
+	}
+	| '-'
+	{
+		$$ = $<str>1
+	}
+	;
+
+MultiplicativeExpression
+	: CastExpression
+	{
+		if $1 == nil {
+			ReportError("CastExpression cannot be nil")
+		}
+
+		$$ = $1
+	}
+	| MultiplicativeExpression MultiplicativeOp CastExpression
+	{
+		$$ = NewJBinaryExpr($1, $2, $3)
+	}
+	;
+
+MultiplicativeOp
+	: '*'
+	{
+		$$ = $<str>1
+	}
+	| '/'
+	{
+		$$ = $<str>1
+	}
+	| '%'
+	{
+		$$ = $<str>1
+	}
+	;
+
+UnaryOp
+	: OP_INC
+	{
+		$$ = $1
+	}
+	| OP_DEC
+	{
+		$$ = $1
+	}
+	| '!'
+	{
+		$$ = $<str>1
+	}
+	| '~'
+	{
+		$$ = $<str>1
+	}
+	| '+'
+	{
+		$$ = $<str>1
+	}
+	| '-'
+	{
+		$$ = $<str>1
+	}
+	;
+
+CastExpression
+	: UnaryOp CastExpression
+	{
+		$$ = NewJUnaryExpr($1, $2, true)
+	}
+	| '(' Expression ')' PrimaryExpression
+	{
+		if ref, ok := $2.(*JReferenceType); !ok {
+			ReportCastError("JReferenceType", $2)
+		} else {
+			$$ = NewJCastExpr(ref, $4)
+		}
+	}
+	| '(' QualifiedName Dims ')' CastExpression
+	{
+		ref := NewJReferenceType($2, nil, $3)
+		$$ = NewJCastExpr(ref, $5)
+	}
+	| '(' PrimitiveType ')' CastExpression
+	{
+		ref := NewJReferenceType(NewJTypeName($2, true), nil, 0)
+		$$ = NewJCastExpr(ref, $4)
+	}
+	| '(' PrimitiveType Dims ')' CastExpression
+	{
+		ref := NewJReferenceType(NewJTypeName($2, true), nil, $3)
+		$$ = NewJCastExpr(ref, $5)
+	}
+	| PrimaryExpression PostfixOp
+	{
+		$$ = NewJUnaryExpr($2, $1, false)
+	}
+	| PrimaryExpression
+	{
+		if $1 == nil {
+			ReportError("PrimaryExpression cannot be nil")
+		}
+
+		$$ = $1
+	}
+	;
+
+PostfixOp
+	: OP_INC
+	{
+		$$ = $1
+	}
+	| OP_DEC
+	{
+		$$ = $1
+	}
+	;
+
+PrimaryExpression
+	: QualifiedName
+	{
+		$$ = NewJReferenceType($1, nil, 0)
+	}
+	| THIS
+	{
+		$$ = NewJKeyword($1, $<str>1)
+	}
+	| SUPER
+	{
+		$$ = NewJKeyword($1, $<str>1)
+	}
+	| JNULL
+	{
+		$$ = NewJKeyword($1, $<str>1)
+	}
+	| PlainNewAllocationExpression
+	{
+		if $1 == nil {
+			ReportError("PlainNewAllocationExpression cannot be nil")
+		}
+
+		$$ = $1
+	}
+	| QualifiedName '.' PlainNewAllocationExpression
+	{
+		if $3 == nil {
+			ReportError("PlainNewAllocationExpression cannot be nil")
+		}
+
+		$$ = NewJNameDotObject($1, $3)
+	}
+	| ComplexPrimaryNoParenthesis
+	{
+		if $1 == nil {
+			ReportError("ComplexPrimaryNoParenthesis cannot be nil")
+		}
+
+		$$ = $1
+	}
+	| '(' Expression ')'
+	{
+		if $2 == nil {
+			ReportError("Expression cannot be nil")
+		}
+
+		$$ = $2
+	}
+	;
+
+PlainNewAllocationExpression
+	: ArrayAllocationExpression
+	{
+		if $1 == nil {
+			ReportError("ArrayAllocationExpression cannot be nil")
+		} else if aae, ok := $1.(*JArrayAlloc); !ok {
+			ReportCastError("JArrayAlloc", $1)
+		} else {
+			$$ = aae
+		}
+	}
+	| ArrayAllocationExpression '{' '}'
+	{
+		if $1 == nil {
+			ReportError("ArrayAllocationExpression cannot be nil")
+		} else if aae, ok := $1.(*JArrayAlloc); !ok {
+			ReportCastError("JArrayAlloc", $1)
+		} else {
+			$$ = aae
+		}
+	}
+	| ArrayAllocationExpression '{' ArrayInitializers '}'
+	{
+		if $1 == nil {
+			ReportError("ArrayAllocationExpression cannot be nil")
+		} else if aae, ok := $1.(*JArrayAlloc); !ok {
+			ReportCastError("JArrayAlloc", $1)
+		} else {
+			aae.SetInitializers($3)
+			$$ = aae
+		}
+	}
+	| ClassAllocationExpression
+	{
+		if $1 == nil {
+			ReportError("ClassAllocationExpression cannot be nil")
+		} else if cae, ok := $1.(*JClassAllocationExpr); !ok {
+			ReportCastError("JClassAllocationExpr", $1)
+		} else {
+			$$ = cae
+		}
+	}
+	| ClassAllocationExpression ClassBody
+	{
+		if $1 == nil {
+			ReportError("ClassAllocationExpression cannot be nil")
+		} else if cae, ok := $1.(*JClassAllocationExpr); !ok {
+			ReportCastError("JClassAllocationExpr", $1)
+		} else {
+			cae.SetBody($2)
+			$$ = cae
+		}
+	}
+	;
+
+ComplexPrimaryNoParenthesis
+	: LITERAL
+	{
+		$$ = NewJLiteral($1)
+	}
+	| BOOLLIT
+	{
+		$$ = NewJKeyword($1, $<str>1)
+	}
+	| QualifiedName '[' Expression ']'
+	{
+		$$ = NewJArrayReference($1, nil, $3)
+	}
+	| '(' Expression ')' '[' Expression ']'
+	{
+		$$ = NewJArrayReference(nil, NewJParens($2), $5)
+	}
+	| ComplexPrimaryNoParenthesis '[' Expression ']'
+	{
+		$$ = NewJArrayReference(nil, $1, $3)
+	}
+	| PrimaryExpression '.' IDENTIFIER
+	{
+		$$ = NewJObjectDotName($1, NewJTypeName($3, false))
+	}
+	| PrimaryExpression PostfixOp '.' IDENTIFIER
+	{
+		$$ = NewJUnimplemented("ComplexPrimaryNoParenthesis#6")
+	}
+	| QualifiedName '.' THIS
+	{
+		$$ = NewJNameDotObject($1, NewJKeyword($3, $<str>3))
+	}
+	| QualifiedName '.' CLASS
+	{
+		$$ = NewJNameDotObject($1, NewJKeyword($3, $<str>3))
+	}
+	| PrimitiveType '.' CLASS
+	{
+		$$ = NewJNameDotObject(NewJTypeName($1, true),
+			NewJKeyword($3, $<str>3))
+	}
+	| VOID '.' CLASS
+	{
+		$$ = NewJUnimplemented("ComplexPrimaryNoParenthesis#10")
+	}
+	| PrimaryExpression '.' IDENTIFIER Arguments
+	{
+		$$ = NewJMethodAccessComplex($1, $3, $4)
+	}
+	| THIS Arguments
+	{
+		$$ = NewJMethodAccessKeyword($1, $<str>1, $2)
+	}
+	| SUPER Arguments
+	{
+		$$ = NewJMethodAccessKeyword($1, $<str>1, $2)
+	}
+	| JNULL Arguments
+	{
+		// is "null(arg1, arg2, ...)" really valid?
+		$$ = NewJMethodAccessKeyword($1, $<str>1, $2)
+	}
+	| QualifiedName Arguments
+	{
+		$$ = NewJMethodAccessName($1, $2)
+	}
+	;
+
+Arguments
+	: '(' ArgumentList ')'
+	{
+		$$ = $2
+	}
+	| '(' ')'
+	{
+		$$ = nil
+	}
+	;
+
+ArgumentList
+	: Expression
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| ArgumentList ',' Expression
+	{
+		$$ = append($1, $3)
+	}
+	;
+
+ArrayInitializers
+	: VariableInitializer
+	{
+		if vin, ok := $1.(*JVariableInit); !ok {
+			ReportCastError("JVariableInit", $1)
+		} else {
+			$$ = make([]*JVariableInit, 1)
+			$$[0] = vin
+		}
+	}
+	| ArrayInitializers ',' VariableInitializer
+	{
+		if vin, ok := $3.(*JVariableInit); !ok {
+			ReportCastError("JVariableInit", $3)
+		} else {
+			$$ = append($1, vin)
+		}
+	}
+	| ArrayInitializers ','
+	{
+		$$ = $1
+	}
+	;
+
+ClassAllocationExpression
+	: NEW TypeName Arguments
+	{
+		$$ = NewJClassAllocationExpr($2, nil, $3)
+	}
+	| NEW TypeName TypeArguments Arguments
+	{
+		$$ = NewJClassAllocationExpr($2, $3, $4)
+	}
+	;
+
+ArrayAllocationExpression
+	: NEW TypeName DimExprs Dims
+	{
+		$$ = NewJArrayAlloc($2, $3, $4)
+	}
+	| NEW TypeName DimExprs
+	{
+		$$ = NewJArrayAlloc($2, $3, 0)
+	}
+	| NEW TypeName Dims
+	{
+		$$ = NewJArrayAlloc($2, nil, $3)
+	}
+	;
+
+DimExprs
+	: DimExpr
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| DimExprs DimExpr
+	{
+		$$ = append($1, $2)
+	}
+	;
+
+DimExpr
+	: '[' Expression ']'
+	{
+		$$ = $2
+	}
+	;
+
+EnumBody
+	: '{' EnumConstants ',' EnumBodyDeclarations '}'
+	{
+		$$ = NewJEnumBody($2, $4)
+	}
+	| '{' EnumConstants ',' '}'
+	{
+		$$ = NewJEnumBody($2, nil)
+	}
+	| '{' EnumConstants EnumBodyDeclarations '}'
+	{
+		$$ = NewJEnumBody($2, $3)
+	}
+	| '{' EnumConstants '}'
+	{
+		$$ = NewJEnumBody($2, nil)
+	}
+	| '{' ',' EnumBodyDeclarations '}'
+	{
+		$$ = NewJEnumBody(nil, $3)
+	}
+	| '{' ',' '}'
+	{
+		$$ = NewJEnumBody(nil, nil)
+	}
+	| '{' EnumBodyDeclarations '}'
+	{
+		$$ = NewJEnumBody(nil, $2)
+	}
+	| '{' '}'
+	{
+		$$ = NewJEnumBody(nil, nil)
+	}
+	;
+
+EnumConstants
+	: EnumConstant
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| EnumConstants ',' EnumConstant
+	{
+		$$ = append($1, $3)
+	}
+	;
+
+EnumConstant
+	: Annotations IDENTIFIER Arguments ClassBody
+	{
+		$$ = NewJEnumConstant($1, $2, $3,
+			$4)
+	}
+	| Annotations IDENTIFIER Arguments
+	{
+		$$ = NewJEnumConstant($1, $2, $3, nil)
+	}
+	| Annotations IDENTIFIER ClassBody
+	{
+		$$ = NewJEnumConstant($1, $2, nil, $3)
+	}
+	| Annotations IDENTIFIER
+	{
+		$$ = NewJEnumConstant($1, $2, nil, nil)
+	}
+	| IDENTIFIER Arguments ClassBody
+	{
+		$$ = NewJEnumConstant(nil, $1, $2, $3)
+	}
+	| IDENTIFIER Arguments
+	{
+		$$ = NewJEnumConstant(nil, $1, $2, nil)
+	}
+	| IDENTIFIER ClassBody
+	{
+		$$ = NewJEnumConstant(nil, $1, nil, $2)
+	}
+	| IDENTIFIER
+	{
+		$$ = NewJEnumConstant(nil, $1, nil, nil)
+	}
+	;
+
+ClassBodyDeclarations
+	: ClassBodyDeclaration
+	{
+		if $1 == nil {
+			ReportError("Found empty class body entry")
+		}
+
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| ClassBodyDeclarations ClassBodyDeclaration
+	{
+		if $2 == nil {
+			ReportError("Found empty class body entry")
+		}
+
+		$$ = append($1, $2)
+	}
+	;
+
+EnumBodyDeclarations
+	: ';' ClassBodyDeclarations
+	{
+		$$ = $2
+	}
+	| ';'
+	{
+		$$ = nil
+	}
+	;
+
+AnnotationTypeBody
+	: '{' AnnotationTypeElementDeclarations '}'
+	{
+		$$ = NewJUnimplemented("AnnotationTypeBody#0")
+	}
+	| '{' '}'
+	{
+		$$ = NewJUnimplemented("AnnotationTypeBody#1")
+	}
+	;
+
+AnnotationTypeElementDeclarations
+	: AnnotationTypeElementDeclaration
+	{
+		$$ = make([]JObject, 1)
+		$$[0] = $1
+	}
+	| AnnotationTypeElementDeclarations AnnotationTypeElementDeclaration
+	{
+		$$ = append($1, $2)
+	}
+	;
+
+AnnotationTypeElementDeclaration
+	: Modifiers Type IDENTIFIER AnnotationMethodRest ';'
+	{
+		$$ = NewJUnimplemented("AnnotationTypeElementDeclaration#0")
+	}
+	| Modifiers Type IDENTIFIER ConstantDeclaratorsRest ';'
+	{
+		$$ = NewJUnimplemented("AnnotationTypeElementDeclaration#1")
+	}
+	| ClassDeclaration
+	{
+		$$ = NewJUnimplemented("AnnotationTypeElementDeclaration#2")
+	}
+	| InterfaceDeclaration
+	{
+		$$ = NewJUnimplemented("AnnotationTypeElementDeclaration#3")
+	}
+	;
+
+AnnotationMethodRest
+	: '(' ')' OP_DIM DEFAULT ElementValue
+	{
+		$$ = NewJUnimplemented("AnnotationMethodRest#0")
+	}
+	| '(' ')' OP_DIM
+	{
+		$$ = NewJUnimplemented("AnnotationMethodRest#1")
+	}
+	| '(' ')' DEFAULT ElementValue
+	{
+		$$ = NewJUnimplemented("AnnotationMethodRest#2")
+	}
+	| '(' ')'
+	{
+		$$ = NewJUnimplemented("AnnotationMethodRest#3")
+	}
+	;
+
+%%
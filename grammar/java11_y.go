@@ -1,3 +1,5 @@
+// Code generated by goyacc -p July -o grammar/java11_y.go grammar/java11.y. DO NOT EDIT.
+
 //line grammar/java11.y:2
 
 /*------------------------------------------------------------------
@@ -76,7 +78,8 @@ package grammar
 
 import __yyfmt__ "fmt"
 
-//line grammar/java11.y:74
+//line grammar/java11.y:75
+
 import (
 	"fmt"
 	"runtime/debug"
@@ -213,7 +216,10 @@ const VOID = 57411
 const VOLATILE = 57412
 const WHILE = 57413
 
-var JulyToknames = []string{
+var JulyToknames = [...]string{
+	"$end",
+	"error",
+	"$unk",
 	"IDENTIFIER",
 	"LITERAL",
 	"BOOLLIT",
@@ -282,17 +288,43 @@ var JulyToknames = []string{
 	"VOID",
 	"VOLATILE",
 	"WHILE",
+	"';'",
+	"'.'",
+	"','",
+	"'*'",
+	"'@'",
+	"'<'",
+	"'>'",
+	"'?'",
+	"'&'",
+	"'('",
+	"')'",
+	"'='",
+	"'{'",
+	"'}'",
+	"':'",
+	"'|'",
+	"'^'",
+	"'+'",
+	"'-'",
+	"'/'",
+	"'%'",
+	"'!'",
+	"'~'",
+	"'['",
+	"']'",
 }
-var JulyStatenames = []string{}
+
+var JulyStatenames = [...]string{}
 
 const JulyEofCode = 1
 const JulyErrCode = 2
-const JulyMaxDepth = 200
+const JulyInitialStackSize = 16
 
-//line grammar/java11.y:2636
+//line grammar/java11.y:3347
 
 //line yacctab:1
-var JulyExca = []int{
+var JulyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
@@ -318,470 +350,461 @@ var JulyExca = []int{
 	1, 2,
 	-2, 93,
 	-1, 162,
-	85, 210,
+	85, 218,
 	-2, 93,
 	-1, 169,
-	85, 431,
+	85, 440,
 	-2, 93,
-	-1, 288,
-	4, 190,
-	26, 190,
-	28, 190,
-	31, 190,
-	36, 190,
-	42, 190,
-	48, 190,
-	50, 190,
-	59, 190,
-	-2, 88,
 	-1, 289,
-	4, 191,
-	26, 191,
-	28, 191,
-	31, 191,
-	36, 191,
-	42, 191,
-	48, 191,
-	50, 191,
-	59, 191,
+	4, 198,
+	26, 198,
+	28, 198,
+	31, 198,
+	36, 198,
+	42, 198,
+	48, 198,
+	50, 198,
+	59, 198,
+	-2, 88,
+	-1, 290,
+	4, 199,
+	26, 199,
+	28, 199,
+	31, 199,
+	36, 199,
+	42, 199,
+	48, 199,
+	50, 199,
+	59, 199,
 	-2, 94,
-	-1, 291,
+	-1, 292,
 	4, 58,
-	-2, 366,
-	-1, 298,
-	85, 430,
+	-2, 375,
+	-1, 299,
+	85, 439,
 	-2, 93,
-	-1, 691,
-	85, 210,
+	-1, 699,
+	85, 218,
 	-2, 93,
-	-1, 720,
+	-1, 729,
 	32, 93,
 	38, 93,
 	49, 93,
-	-2, 261,
+	-2, 269,
 }
 
-const JulyNprod = 444
 const JulyPrivate = 57344
 
-var JulyTokenNames []string
-var JulyStates []string
-
-const JulyLast = 2631
+const JulyLast = 2577
 
-var JulyAct = []int{
-
-	366, 674, 364, 692, 265, 272, 85, 266, 225, 19,
-	264, 405, 666, 478, 533, 690, 10, 526, 539, 527,
-	226, 597, 483, 528, 658, 269, 406, 485, 46, 383,
-	388, 540, 104, 374, 492, 101, 495, 152, 115, 18,
-	311, 141, 569, 147, 20, 100, 13, 166, 12, 168,
-	98, 232, 251, 270, 94, 93, 99, 97, 96, 86,
-	65, 179, 95, 45, 631, 230, 78, 67, 88, 87,
-	563, 150, 50, 233, 254, 461, 458, 254, 156, 448,
-	237, 144, 254, 215, 216, 172, 204, 231, 202, 218,
-	171, 252, 742, 731, 706, 693, 381, 254, 741, 198,
-	694, 88, 87, 724, 222, 219, 220, 696, 652, 505,
-	171, 443, 158, 54, 408, 561, 571, 693, 76, 161,
-	186, 160, 694, 504, 73, 63, 562, 185, 162, 184,
-	142, 253, 230, 135, 137, 233, 253, 133, 221, 480,
-	233, 463, 320, 174, 175, 571, 375, 230, 536, 375,
-	432, 718, 462, 321, 231, 233, 156, 162, 169, 162,
-	165, 258, 21, 275, 234, 235, 236, 242, 46, 231,
-	79, 167, 289, 689, 169, 156, 707, 707, 169, 268,
-	299, 169, 21, 292, 254, 111, 254, 296, 257, 431,
-	158, 429, 37, 318, 294, 40, 46, 161, 317, 160,
-	88, 87, 244, 45, 480, 243, 322, 47, 69, 158,
-	571, 480, 293, 295, 297, 80, 161, 186, 160, 262,
-	647, 72, 162, 301, 185, 52, 184, 480, 312, 162,
-	47, 45, 357, 571, 360, 314, 38, 313, 361, 305,
-	303, 298, 70, 254, 630, 162, 544, 307, 71, 319,
-	315, 381, 254, 335, 323, 79, 145, 149, 327, 121,
-	334, 379, 325, 326, 324, 149, 275, 328, 233, 354,
-	80, 72, 486, 401, 427, 289, 238, 162, 411, 71,
-	403, 275, 268, 188, 71, 421, 423, 72, 394, 223,
-	70, 377, 491, 425, 370, 371, 71, 389, 75, 386,
-	80, 390, 172, 494, 156, 254, 414, 381, 21, 149,
-	390, 736, 544, 381, 669, 337, 339, 605, 72, 558,
-	254, 708, 46, 75, 557, 254, 604, 445, 69, 88,
-	87, 148, 333, 672, 373, 72, 257, 332, 158, 148,
-	428, 453, 430, 380, 145, 161, 633, 160, 291, 312,
-	441, 613, 433, 331, 576, 399, 314, 45, 313, 330,
-	556, 614, 575, 224, 191, 712, 390, 622, 381, 447,
-	466, 381, 145, 581, 451, 240, 470, 460, 743, 610,
-	494, 732, 399, 148, 338, 459, 695, 683, 632, 606,
-	595, 593, 592, 590, 336, 479, 189, 145, 594, 49,
-	487, 424, 233, 416, 413, 457, 469, 59, 412, 275,
-	410, 503, 471, 506, 507, 206, 499, 519, 263, 194,
-	146, 455, 290, 467, 477, 525, 487, 452, 211, 498,
-	450, 446, 531, 248, 502, 389, 145, 487, 535, 145,
-	454, 149, 254, 473, 484, 500, 263, 472, 145, 552,
-	553, 291, 550, 701, 38, 49, 145, 300, 212, 213,
-	143, 559, 710, 77, 468, 60, 560, 599, 551, 600,
-	514, 263, 60, 542, 704, 588, 548, 500, 637, 618,
-	38, 534, 564, 570, 572, 493, 554, 254, 555, 192,
-	402, 568, 49, 145, 403, 255, 253, 582, 145, 493,
-	176, 570, 501, 565, 500, 591, 579, 228, 229, 584,
-	381, 254, 577, 263, 249, 148, 486, 580, 601, 489,
-	190, 490, 263, 725, 403, 290, 21, 589, 543, 21,
-	263, 586, 587, 69, 74, 609, 132, 240, 585, 271,
-	173, 51, 62, 64, 403, 615, 607, 623, 685, 611,
-	608, 612, 21, 480, 480, 639, 620, 616, 352, 677,
-	621, 419, 626, 241, 634, 51, 53, 263, 629, 227,
-	51, 49, 263, 480, 570, 578, 145, 624, 625, 627,
-	713, 417, 542, 542, 641, 240, 487, 583, 702, 570,
-	570, 275, 698, 682, 275, 649, 275, 642, 681, 638,
-	654, 656, 291, 659, 660, 680, 678, 662, 163, 657,
-	646, 636, 664, 145, 617, 603, 645, 663, 651, 648,
-	487, 650, 524, 487, 535, 679, 643, 644, 670, 420,
-	484, 668, 523, 676, 619, 608, 522, 671, 521, 409,
-	61, 543, 51, 508, 66, 250, 474, 687, 570, 418,
-	263, 38, 530, 697, 714, 738, 659, 145, 659, 145,
-	145, 145, 659, 529, 665, 715, 574, 534, 475, 38,
-	145, 640, 7, 709, 369, 403, 290, 35, 705, 381,
-	699, 368, 700, 60, 254, 686, 703, 200, 275, 675,
-	653, 38, 275, 68, 722, 721, 720, 726, 261, 659,
-	145, 289, 728, 659, 719, 35, 407, 39, 268, 734,
-	134, 356, 735, 717, 58, 737, 730, 208, 209, 716,
-	145, 275, 739, 727, 88, 87, 306, 729, 401, 598,
-	289, 123, 549, 263, 263, 263, 5, 268, 744, 745,
-	33, 34, 546, 355, 263, 746, 545, 88, 87, 747,
-	497, 329, 496, 456, 439, 26, 436, 393, 387, 136,
-	138, 139, 145, 143, 353, 316, 81, 711, 60, 48,
-	57, 27, 56, 55, 263, 4, 628, 309, 164, 32,
-	372, 358, 28, 118, 363, 117, 24, 23, 22, 116,
-	102, 155, 217, 384, 29, 38, 667, 214, 30, 145,
-	8, 31, 396, 153, 210, 207, 36, 21, 740, 205,
-	404, 203, 239, 201, 199, 162, 256, 131, 193, 124,
-	91, 340, 126, 684, 520, 518, 516, 130, 245, 246,
-	513, 247, 512, 129, 36, 36, 263, 511, 510, 127,
-	691, 128, 532, 426, 509, 688, 267, 437, 464, 36,
-	125, 667, 442, 365, 481, 547, 673, 541, 538, 274,
-	119, 120, 183, 182, 181, 302, 105, 106, 177, 395,
-	385, 392, 391, 159, 476, 157, 154, 291, 195, 89,
-	277, 131, 282, 124, 84, 82, 126, 170, 283, 378,
-	280, 130, 140, 733, 382, 187, 17, 129, 281, 276,
-	16, 15, 14, 127, 573, 128, 291, 123, 114, 3,
-	2, 1, 0, 284, 125, 0, 113, 278, 415, 112,
-	285, 0, 0, 287, 122, 0, 279, 273, 0, 0,
-	488, 26, 0, 0, 0, 0, 103, 0, 376, 162,
-	0, 0, 0, 0, 109, 110, 0, 27, 107, 108,
-	0, 290, 0, 0, 0, 0, 515, 0, 28, 0,
-	0, 0, 24, 23, 22, 0, 0, 155, 0, 0,
-	29, 0, 0, 0, 30, 0, 0, 31, 0, 153,
-	290, 0, 0, 21, 0, 0, 0, 0, 0, 0,
-	0, 162, 151, 0, 434, 435, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 274,
-	119, 120, 0, 384, 566, 567, 105, 106, 0, 0,
-	0, 0, 0, 0, 488, 0, 0, 0, 0, 26,
-	277, 131, 282, 124, 693, 0, 126, 0, 283, 694,
-	280, 130, 0, 0, 0, 288, 0, 129, 281, 276,
-	0, 0, 0, 127, 596, 128, 28, 123, 114, 0,
-	24, 23, 22, 284, 125, 25, 113, 278, 286, 112,
-	285, 0, 30, 287, 122, 31, 279, 273, 0, 0,
-	0, 21, 0, 0, 0, 0, 103, 0, 0, 162,
-	0, 0, 0, 0, 109, 110, 0, 0, 107, 108,
-	0, 274, 119, 120, 0, 0, 0, 0, 105, 106,
-	0, 0, 0, 0, 0, 0, 488, 0, 0, 0,
-	0, 26, 277, 131, 282, 124, 0, 537, 126, 0,
-	283, 0, 280, 130, 0, 0, 0, 288, 0, 129,
-	281, 276, 0, 0, 0, 127, 0, 128, 28, 123,
-	114, 0, 24, 23, 22, 284, 125, 25, 113, 278,
-	286, 112, 285, 0, 30, 287, 122, 31, 279, 273,
-	0, 0, 0, 21, 0, 0, 0, 0, 103, 0,
-	0, 162, 400, 0, 0, 0, 109, 110, 0, 0,
-	107, 108, 274, 119, 120, 0, 0, 0, 0, 105,
-	106, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 26, 277, 131, 282, 124, 0, 0, 126,
-	0, 283, 0, 280, 130, 0, 0, 0, 288, 0,
-	129, 281, 276, 0, 0, 0, 127, 0, 128, 28,
-	123, 114, 0, 24, 23, 22, 284, 125, 25, 113,
-	278, 286, 112, 285, 0, 30, 287, 122, 31, 279,
-	273, 0, 0, 0, 21, 0, 38, 119, 120, 103,
-	0, 0, 162, 105, 106, 0, 0, 109, 110, 0,
+var JulyAct = [...]int16{
+	273, 368, 10, 679, 536, 366, 572, 267, 698, 663,
+	266, 85, 671, 700, 226, 530, 407, 486, 531, 265,
+	542, 385, 488, 529, 227, 390, 376, 600, 104, 101,
+	313, 498, 252, 543, 495, 408, 168, 100, 19, 152,
+	141, 20, 147, 115, 270, 18, 166, 99, 233, 98,
+	97, 95, 13, 86, 12, 96, 68, 46, 636, 65,
+	45, 94, 231, 481, 78, 179, 93, 150, 566, 464,
+	234, 271, 255, 156, 88, 50, 461, 451, 238, 67,
+	255, 216, 217, 219, 232, 255, 205, 508, 740, 714,
+	144, 203, 751, 750, 716, 733, 704, 255, 87, 220,
+	221, 507, 657, 445, 199, 223, 171, 88, 564, 466,
+	410, 539, 171, 434, 172, 79, 54, 76, 158, 565,
+	465, 253, 136, 138, 139, 161, 187, 160, 63, 142,
+	231, 87, 222, 185, 254, 184, 73, 322, 234, 254,
+	133, 174, 175, 234, 377, 135, 137, 231, 323, 433,
+	324, 156, 232, 69, 701, 234, 259, 377, 298, 702,
+	80, 235, 236, 237, 276, 269, 243, 483, 169, 232,
+	156, 715, 715, 631, 169, 240, 165, 701, 21, 162,
+	169, 297, 702, 652, 21, 162, 169, 167, 293, 72,
+	258, 246, 247, 431, 248, 320, 158, 46, 483, 295,
+	45, 290, 80, 161, 319, 160, 383, 88, 244, 300,
+	727, 294, 296, 721, 245, 158, 162, 239, 263, 189,
+	302, 383, 161, 187, 160, 162, 46, 574, 303, 45,
+	185, 87, 184, 697, 359, 314, 362, 299, 304, 162,
+	363, 70, 316, 306, 315, 317, 308, 71, 337, 483,
+	79, 687, 75, 336, 326, 682, 121, 328, 329, 330,
+	327, 321, 325, 162, 483, 684, 483, 162, 276, 269,
+	574, 381, 574, 561, 403, 356, 373, 162, 560, 69,
+	413, 26, 162, 276, 162, 752, 72, 423, 425, 427,
+	70, 379, 372, 391, 741, 80, 71, 27, 396, 388,
+	156, 234, 378, 429, 72, 290, 162, 677, 28, 608,
+	405, 383, 24, 23, 22, 333, 71, 155, 607, 638,
+	29, 332, 559, 579, 30, 255, 416, 31, 148, 153,
+	432, 578, 172, 21, 447, 72, 148, 88, 255, 258,
+	430, 162, 443, 71, 456, 158, 339, 341, 255, 383,
+	435, 255, 161, 46, 160, 72, 45, 314, 436, 437,
+	225, 87, 111, 335, 316, 375, 315, 450, 334, 37,
+	454, 745, 40, 192, 382, 469, 703, 255, 255, 473,
+	148, 483, 75, 635, 47, 547, 401, 624, 383, 38,
+	383, 483, 482, 616, 691, 637, 255, 574, 463, 162,
+	383, 472, 52, 617, 460, 609, 462, 47, 455, 162,
+	392, 720, 276, 401, 506, 264, 509, 510, 598, 291,
+	522, 480, 470, 502, 596, 489, 501, 494, 528, 534,
+	255, 490, 391, 145, 149, 392, 392, 547, 497, 595,
+	593, 338, 149, 264, 190, 613, 458, 597, 626, 538,
+	584, 426, 234, 555, 556, 505, 497, 490, 474, 453,
+	553, 21, 418, 49, 487, 562, 224, 674, 490, 264,
+	545, 59, 563, 551, 415, 554, 414, 412, 207, 146,
+	241, 573, 575, 557, 212, 558, 149, 255, 449, 340,
+	517, 195, 540, 476, 567, 249, 471, 475, 568, 573,
+	457, 537, 38, 585, 143, 588, 272, 38, 77, 594,
+	580, 264, 301, 148, 213, 214, 503, 496, 60, 709,
+	264, 145, 604, 291, 718, 292, 405, 712, 264, 583,
+	60, 496, 642, 612, 589, 590, 621, 49, 404, 592,
+	602, 254, 603, 489, 571, 610, 625, 256, 611, 145,
+	615, 250, 632, 627, 229, 230, 405, 618, 614, 176,
+	546, 193, 587, 191, 49, 264, 619, 591, 734, 503,
+	639, 264, 573, 634, 21, 145, 405, 69, 641, 21,
+	545, 545, 628, 629, 21, 173, 693, 573, 573, 132,
+	646, 582, 74, 648, 649, 276, 644, 643, 276, 654,
+	276, 64, 354, 255, 659, 661, 242, 664, 665, 623,
+	669, 51, 62, 667, 145, 630, 228, 145, 490, 149,
+	586, 675, 421, 683, 685, 668, 145, 656, 419, 292,
+	673, 688, 686, 611, 145, 504, 538, 503, 650, 241,
+	492, 653, 493, 655, 51, 53, 676, 710, 573, 264,
+	647, 487, 490, 241, 694, 490, 51, 49, 706, 705,
+	581, 690, 664, 689, 664, 262, 163, 622, 664, 662,
+	707, 145, 708, 651, 546, 291, 711, 145, 620, 717,
+	606, 527, 722, 713, 526, 670, 681, 525, 537, 524,
+	422, 411, 61, 307, 51, 477, 420, 276, 511, 66,
+	60, 276, 269, 731, 645, 728, 735, 38, 664, 405,
+	729, 737, 664, 730, 533, 38, 736, 478, 743, 331,
+	738, 251, 744, 658, 695, 532, 746, 747, 358, 739,
+	723, 276, 269, 264, 264, 264, 748, 403, 290, 88,
+	726, 724, 753, 383, 264, 371, 370, 255, 123, 201,
+	680, 754, 58, 756, 38, 145, 409, 26, 725, 755,
+	357, 386, 88, 87, 7, 134, 209, 210, 290, 35,
+	398, 39, 601, 27, 264, 5, 8, 552, 406, 33,
+	34, 292, 36, 549, 28, 548, 87, 500, 24, 23,
+	22, 499, 145, 155, 459, 448, 29, 35, 441, 438,
+	30, 395, 719, 31, 389, 153, 143, 355, 48, 21,
+	36, 36, 318, 81, 60, 439, 57, 162, 257, 56,
+	55, 444, 4, 1, 365, 36, 32, 467, 367, 102,
+	218, 215, 211, 208, 206, 204, 264, 145, 202, 145,
+	145, 145, 200, 194, 342, 576, 577, 749, 311, 164,
+	145, 344, 345, 346, 347, 348, 349, 350, 351, 374,
+	360, 519, 699, 275, 119, 120, 535, 428, 696, 484,
+	105, 106, 678, 541, 182, 181, 177, 394, 393, 157,
+	145, 154, 196, 84, 278, 131, 283, 124, 82, 170,
+	126, 380, 284, 140, 281, 130, 384, 186, 633, 491,
+	145, 129, 282, 277, 118, 117, 116, 127, 91, 128,
+	523, 123, 114, 352, 353, 521, 516, 285, 125, 343,
+	113, 279, 417, 112, 286, 518, 515, 288, 122, 514,
+	280, 274, 513, 512, 268, 26, 550, 544, 183, 397,
+	103, 159, 145, 162, 479, 89, 188, 17, 109, 110,
+	16, 27, 107, 108, 15, 14, 291, 3, 2, 0,
+	0, 0, 28, 0, 0, 0, 24, 23, 22, 0,
+	0, 25, 0, 0, 29, 0, 672, 0, 30, 145,
+	0, 31, 0, 386, 569, 570, 291, 21, 0, 0,
+	0, 275, 119, 120, 491, 0, 442, 0, 105, 106,
+	0, 0, 0, 0, 0, 692, 0, 0, 0, 0,
+	0, 26, 278, 131, 283, 124, 701, 0, 126, 0,
+	284, 702, 281, 130, 599, 0, 0, 289, 0, 129,
+	282, 277, 0, 672, 0, 127, 0, 128, 28, 123,
+	114, 0, 24, 23, 22, 285, 125, 25, 113, 279,
+	287, 112, 286, 0, 30, 288, 122, 31, 280, 274,
+	0, 0, 292, 21, 0, 0, 0, 0, 103, 0,
+	0, 162, 0, 0, 0, 0, 109, 110, 742, 0,
+	107, 108, 275, 119, 120, 0, 491, 0, 0, 105,
+	106, 0, 292, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 26, 278, 131, 283, 124, 0, 0, 126,
+	0, 284, 0, 281, 130, 0, 0, 0, 289, 0,
+	129, 282, 277, 0, 0, 0, 127, 0, 128, 28,
+	123, 114, 0, 24, 23, 22, 285, 125, 25, 113,
+	279, 287, 112, 286, 0, 30, 288, 122, 31, 280,
+	274, 0, 0, 0, 21, 0, 0, 0, 0, 103,
+	0, 0, 162, 402, 0, 0, 0, 109, 110, 0,
+	0, 107, 108, 275, 119, 120, 0, 0, 0, 0,
+	105, 106, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 26, 278, 131, 283, 124, 0, 0,
+	126, 0, 284, 0, 281, 130, 0, 0, 0, 289,
+	0, 129, 282, 277, 0, 0, 0, 127, 0, 128,
+	28, 123, 114, 0, 24, 23, 22, 285, 125, 25,
+	113, 279, 287, 112, 286, 0, 30, 288, 122, 31,
+	280, 274, 0, 0, 0, 21, 0, 38, 119, 120,
+	103, 0, 0, 162, 105, 106, 0, 0, 109, 110,
+	0, 0, 107, 108, 0, 38, 119, 120, 0, 131,
+	0, 124, 105, 106, 126, 0, 0, 0, 0, 130,
+	0, 0, 0, 0, 0, 129, 0, 131, 0, 124,
+	0, 127, 126, 128, 0, 123, 114, 130, 0, 0,
+	0, 0, 125, 129, 113, 0, 0, 112, 0, 127,
+	0, 128, 122, 123, 114, 0, 0, 197, 0, 21,
+	125, 0, 113, 0, 103, 112, 0, 92, 198, 0,
+	122, 0, 109, 110, 38, 0, 107, 108, 38, 119,
+	120, 0, 103, 361, 0, 105, 106, 0, 0, 0,
+	109, 110, 0, 0, 107, 108, 131, 0, 124, 0,
+	131, 126, 124, 0, 0, 126, 130, 0, 0, 0,
+	130, 0, 129, 0, 489, 0, 129, 0, 127, 0,
+	128, 0, 127, 0, 128, 0, 123, 114, 0, 125,
+	0, 0, 0, 125, 0, 113, 0, 0, 112, 0,
+	0, 0, 0, 122, 0, 0, 520, 0, 0, 387,
+	21, 38, 119, 120, 0, 103, 0, 0, 105, 106,
+	0, 0, 0, 109, 110, 0, 0, 107, 108, 38,
+	119, 120, 0, 131, 0, 124, 105, 106, 126, 0,
+	0, 0, 0, 130, 0, 0, 0, 0, 0, 129,
+	0, 131, 0, 124, 0, 127, 126, 128, 0, 123,
+	114, 130, 0, 0, 0, 0, 125, 129, 113, 0,
+	0, 112, 0, 127, 0, 128, 122, 123, 114, 0,
+	0, 0, 0, 21, 125, 0, 113, 0, 103, 112,
+	0, 92, 446, 0, 122, 0, 109, 110, 38, 0,
+	107, 108, 90, 119, 120, 0, 103, 0, 0, 105,
+	106, 0, 0, 0, 109, 110, 0, 0, 107, 108,
+	131, 0, 124, 0, 131, 126, 124, 0, 0, 126,
+	130, 0, 0, 0, 130, 0, 129, 0, 0, 0,
+	129, 0, 127, 0, 128, 0, 127, 0, 128, 0,
+	123, 114, 0, 125, 0, 0, 0, 125, 0, 113,
+	0, 0, 112, 309, 0, 0, 0, 122, 0, 0,
+	0, 69, 0, 0, 21, 0, 38, 119, 120, 103,
+	83, 0, 92, 105, 106, 0, 0, 109, 110, 0,
 	0, 107, 108, 0, 38, 119, 120, 0, 131, 0,
 	124, 105, 106, 126, 0, 0, 0, 0, 130, 0,
 	0, 0, 0, 0, 129, 0, 131, 0, 124, 0,
 	127, 126, 128, 0, 123, 114, 130, 0, 0, 0,
 	0, 125, 129, 113, 0, 0, 112, 0, 127, 0,
-	128, 122, 123, 114, 0, 0, 196, 0, 21, 125,
-	0, 113, 0, 103, 112, 0, 92, 197, 0, 122,
-	0, 109, 110, 0, 0, 107, 108, 38, 119, 120,
-	0, 103, 359, 0, 105, 106, 0, 0, 0, 109,
-	110, 0, 38, 107, 108, 0, 0, 0, 0, 131,
-	0, 124, 0, 0, 126, 0, 0, 0, 0, 130,
-	0, 0, 0, 486, 131, 129, 124, 0, 0, 126,
-	0, 127, 0, 128, 130, 123, 114, 0, 486, 0,
-	129, 0, 125, 0, 113, 0, 127, 112, 128, 0,
-	0, 0, 122, 0, 0, 517, 0, 125, 0, 21,
-	38, 119, 120, 0, 103, 0, 0, 105, 106, 0,
-	0, 0, 109, 110, 21, 0, 107, 108, 38, 119,
-	120, 0, 131, 0, 124, 105, 106, 126, 0, 0,
-	0, 0, 130, 0, 0, 0, 0, 0, 129, 0,
-	131, 0, 124, 0, 127, 126, 128, 0, 123, 114,
-	130, 0, 0, 0, 0, 125, 129, 113, 0, 0,
-	112, 0, 127, 0, 128, 122, 123, 114, 0, 0,
-	0, 0, 21, 125, 0, 113, 0, 103, 112, 0,
-	92, 444, 0, 122, 0, 109, 110, 0, 0, 107,
-	108, 90, 119, 120, 0, 103, 0, 0, 105, 106,
-	0, 0, 0, 109, 110, 0, 38, 107, 108, 0,
-	0, 0, 0, 131, 0, 124, 0, 0, 126, 0,
-	0, 0, 0, 130, 0, 0, 0, 0, 131, 129,
-	124, 0, 0, 126, 0, 127, 0, 128, 130, 123,
-	114, 0, 402, 0, 129, 0, 125, 0, 113, 0,
-	127, 112, 128, 0, 0, 0, 122, 0, 0, 0,
-	0, 125, 0, 21, 0, 38, 119, 120, 103, 83,
-	0, 92, 105, 106, 0, 0, 109, 110, 21, 0,
-	107, 108, 0, 38, 119, 120, 0, 131, 0, 124,
+	128, 122, 123, 114, 0, 0, 0, 0, 21, 125,
+	0, 113, 0, 103, 112, 0, 92, 0, 0, 122,
+	0, 109, 110, 0, 0, 107, 108, 0, 0, 0,
+	0, 103, 0, 0, 369, 640, 0, 0, 0, 109,
+	110, 0, 0, 107, 108, 38, 119, 120, 0, 0,
+	0, 0, 105, 106, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 38, 119, 120, 0, 131, 0, 124,
 	105, 106, 126, 0, 0, 0, 0, 130, 0, 0,
 	0, 0, 0, 129, 0, 131, 0, 124, 0, 127,
 	126, 128, 0, 123, 114, 130, 0, 0, 0, 0,
 	125, 129, 113, 0, 0, 112, 0, 127, 0, 128,
-	122, 123, 114, 0, 0, 0, 0, 21, 125, 0,
-	113, 0, 103, 112, 0, 92, 0, 0, 122, 0,
+	122, 123, 114, 0, 0, 0, 0, 0, 125, 0,
+	113, 0, 103, 112, 0, 369, 468, 0, 122, 0,
 	109, 110, 0, 0, 107, 108, 0, 0, 0, 0,
-	103, 0, 0, 367, 635, 0, 0, 0, 109, 110,
-	0, 0, 107, 108, 38, 119, 120, 0, 0, 0,
-	0, 105, 106, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 38, 119, 120, 0, 131, 0, 124, 105,
-	106, 126, 0, 0, 0, 0, 130, 0, 0, 0,
-	0, 0, 129, 0, 131, 0, 124, 0, 127, 126,
-	128, 0, 123, 114, 130, 0, 0, 0, 0, 125,
-	129, 113, 0, 0, 112, 0, 127, 0, 128, 122,
-	123, 114, 0, 0, 0, 0, 0, 125, 0, 113,
-	0, 103, 112, 0, 367, 465, 0, 122, 0, 109,
-	110, 0, 0, 107, 108, 0, 0, 0, 0, 103,
-	26, 0, 367, 362, 0, 0, 0, 109, 110, 0,
-	0, 107, 108, 38, 119, 120, 27, 0, 0, 0,
-	105, 106, 0, 0, 0, 0, 0, 28, 0, 0,
-	0, 24, 23, 22, 0, 131, 155, 124, 0, 29,
-	126, 0, 0, 30, 0, 130, 31, 0, 153, 0,
-	0, 129, 21, 0, 0, 0, 0, 127, 0, 128,
-	162, 123, 114, 0, 0, 0, 0, 0, 125, 0,
-	113, 0, 0, 112, 0, 0, 0, 0, 122, 0,
-	0, 0, 0, 0, 38, 119, 120, 0, 26, 0,
-	103, 105, 106, 367, 0, 0, 0, 0, 109, 110,
-	0, 0, 107, 108, 27, 0, 131, 0, 124, 0,
-	9, 126, 0, 0, 0, 28, 130, 0, 6, 24,
+	103, 0, 0, 369, 364, 0, 0, 0, 109, 110,
+	0, 0, 107, 108, 38, 119, 120, 0, 26, 0,
+	0, 105, 106, 0, 0, 0, 41, 0, 310, 0,
+	0, 0, 42, 0, 27, 0, 131, 0, 124, 0,
+	0, 126, 0, 43, 0, 28, 130, 0, 0, 24,
 	23, 22, 129, 0, 25, 0, 0, 29, 127, 0,
-	128, 30, 123, 114, 31, 0, 11, 0, 0, 125,
-	21, 113, 0, 0, 112, 0, 0, 0, 0, 122,
-	0, 0, 661, 0, 0, 38, 119, 120, 0, 26,
-	0, 103, 105, 106, 0, 0, 0, 0, 0, 109,
+	128, 30, 123, 114, 31, 0, 0, 0, 0, 125,
+	44, 113, 0, 0, 112, 0, 0, 0, 0, 122,
+	0, 0, 0, 0, 0, 38, 119, 120, 0, 26,
+	0, 103, 105, 106, 369, 0, 0, 0, 0, 109,
 	110, 0, 0, 107, 108, 27, 0, 131, 0, 124,
-	0, 9, 126, 0, 0, 0, 28, 130, 0, 0,
+	0, 9, 126, 0, 0, 0, 28, 130, 0, 6,
 	24, 23, 22, 129, 0, 25, 0, 0, 29, 127,
 	0, 128, 30, 123, 114, 31, 0, 11, 0, 0,
 	125, 21, 113, 0, 0, 112, 0, 0, 0, 0,
-	122, 0, 0, 655, 0, 0, 38, 119, 120, 0,
+	122, 0, 0, 666, 0, 0, 38, 119, 120, 0,
 	26, 0, 103, 105, 106, 0, 0, 0, 0, 0,
 	109, 110, 0, 0, 107, 108, 27, 0, 131, 0,
-	124, 0, 0, 126, 0, 0, 0, 28, 130, 0,
+	124, 0, 9, 126, 0, 0, 0, 28, 130, 0,
 	0, 24, 23, 22, 129, 0, 25, 0, 0, 29,
 	127, 0, 128, 30, 123, 114, 31, 0, 11, 0,
 	0, 125, 21, 113, 0, 0, 112, 0, 0, 0,
-	0, 122, 0, 0, 602, 0, 38, 38, 119, 120,
-	0, 0, 0, 103, 105, 106, 0, 0, 0, 0,
-	0, 109, 110, 0, 0, 107, 108, 0, 131, 131,
-	124, 124, 0, 126, 126, 0, 0, 0, 130, 130,
-	0, 0, 0, 0, 129, 129, 0, 0, 0, 0,
-	127, 127, 128, 128, 0, 123, 114, 0, 0, 0,
-	0, 125, 125, 0, 113, 0, 0, 112, 0, 0,
-	0, 438, 122, 0, 0, 422, 0, 398, 723, 119,
+	0, 122, 0, 0, 660, 0, 0, 38, 119, 120,
+	0, 26, 0, 103, 105, 106, 0, 0, 0, 0,
+	0, 109, 110, 0, 0, 107, 108, 27, 0, 131,
+	0, 124, 0, 0, 126, 0, 0, 0, 28, 130,
+	0, 0, 24, 23, 22, 129, 0, 25, 0, 0,
+	29, 127, 0, 128, 30, 123, 114, 31, 0, 11,
+	0, 0, 125, 21, 113, 0, 0, 112, 0, 0,
+	0, 0, 122, 0, 0, 605, 0, 38, 38, 119,
 	120, 0, 0, 0, 103, 105, 106, 0, 0, 0,
 	0, 0, 109, 110, 0, 0, 107, 108, 0, 131,
-	131, 124, 124, 0, 126, 126, 0, 38, 0, 130,
+	131, 124, 124, 0, 126, 126, 0, 0, 0, 130,
 	130, 0, 0, 0, 0, 129, 129, 0, 0, 0,
-	0, 127, 127, 128, 128, 0, 123, 114, 0, 131,
-	0, 124, 125, 125, 126, 113, 0, 0, 112, 130,
-	0, 0, 397, 122, 0, 129, 0, 38, 119, 120,
-	0, 127, 0, 128, 0, 103, 0, 0, 0, 0,
-	0, 0, 125, 109, 110, 0, 0, 107, 108, 131,
-	0, 124, 0, 0, 126, 0, 0, 0, 0, 130,
-	0, 38, 0, 0, 0, 129, 0, 0, 0, 0,
-	0, 127, 0, 128, 0, 123, 114, 0, 0, 0,
-	0, 26, 125, 131, 113, 124, 0, 112, 126, 41,
-	0, 0, 122, 130, 0, 42, 0, 27, 0, 129,
-	0, 0, 0, 0, 449, 127, 43, 128, 28, 0,
-	0, 0, 24, 23, 22, 260, 125, 25, 448, 0,
-	29, 0, 0, 0, 30, 0, 308, 31, 0, 0,
-	0, 0, 0, 44, 69, 26, 0, 131, 0, 124,
-	0, 0, 126, 41, 0, 0, 0, 130, 0, 42,
-	0, 27, 0, 129, 0, 0, 0, 0, 0, 127,
-	43, 128, 28, 0, 0, 0, 24, 23, 22, 38,
-	125, 25, 0, 0, 29, 0, 0, 0, 30, 0,
-	259, 31, 0, 0, 0, 0, 0, 44, 69, 26,
-	0, 131, 0, 124, 0, 0, 126, 41, 0, 0,
-	0, 130, 0, 42, 0, 27, 0, 129, 0, 0,
-	0, 26, 0, 127, 43, 128, 28, 0, 0, 0,
-	24, 23, 22, 0, 125, 25, 0, 27, 29, 0,
-	0, 0, 30, 0, 0, 31, 0, 26, 28, 0,
-	0, 44, 24, 23, 22, 0, 0, 25, 0, 0,
-	29, 0, 0, 27, 30, 0, 0, 31, 0, 180,
-	0, 26, 0, 21, 28, 0, 0, 0, 24, 23,
-	22, 0, 304, 25, 0, 0, 29, 27, 0, 0,
-	30, 0, 0, 31, 0, 180, 0, 26, 28, 21,
-	0, 0, 24, 23, 22, 0, 0, 25, 178, 0,
-	29, 0, 0, 27, 30, 38, 0, 31, 0, 0,
-	0, 0, 0, 21, 28, 0, 0, 0, 24, 23,
-	22, 0, 440, 25, 0, 0, 29, 131, 0, 124,
-	30, 0, 126, 31, 0, 0, 0, 130, 0, 21,
-	0, 486, 0, 129, 0, 0, 0, 0, 310, 127,
-	0, 128, 342, 343, 344, 345, 346, 347, 348, 349,
-	125, 0, 0, 0, 26, 0, 0, 0, 0, 0,
-	0, 0, 41, 0, 0, 0, 0, 21, 42, 0,
-	27, 0, 0, 482, 0, 0, 0, 0, 0, 43,
-	0, 28, 0, 0, 0, 24, 23, 22, 0, 0,
-	25, 0, 0, 29, 0, 0, 0, 30, 0, 0,
-	31, 0, 0, 0, 350, 351, 44, 0, 0, 0,
-	341,
+	0, 127, 127, 128, 128, 0, 123, 114, 0, 0,
+	0, 0, 125, 125, 0, 113, 0, 0, 112, 0,
+	0, 0, 440, 122, 0, 0, 424, 0, 400, 732,
+	119, 120, 0, 0, 0, 103, 105, 106, 0, 0,
+	0, 0, 0, 109, 110, 0, 0, 107, 108, 0,
+	131, 131, 124, 124, 0, 126, 126, 0, 0, 0,
+	130, 130, 0, 0, 0, 0, 129, 129, 0, 0,
+	0, 0, 127, 127, 128, 128, 0, 123, 114, 0,
+	0, 0, 0, 125, 125, 38, 113, 0, 0, 112,
+	0, 0, 0, 399, 122, 0, 0, 0, 38, 119,
+	120, 0, 0, 0, 0, 0, 103, 131, 0, 124,
+	0, 0, 126, 0, 109, 110, 0, 130, 107, 108,
+	131, 489, 124, 129, 0, 126, 0, 0, 0, 127,
+	130, 128, 261, 0, 0, 0, 129, 0, 0, 0,
+	125, 0, 127, 0, 128, 0, 123, 114, 0, 0,
+	0, 0, 26, 125, 131, 113, 124, 21, 112, 126,
+	41, 0, 0, 122, 130, 0, 42, 0, 27, 0,
+	129, 0, 0, 0, 0, 452, 127, 43, 128, 28,
+	0, 0, 0, 24, 23, 22, 38, 125, 25, 451,
+	0, 29, 0, 0, 0, 30, 0, 260, 31, 0,
+	0, 0, 0, 0, 44, 69, 26, 0, 131, 0,
+	124, 0, 0, 126, 41, 0, 0, 0, 130, 0,
+	42, 0, 27, 0, 129, 0, 0, 0, 26, 0,
+	127, 43, 128, 28, 0, 0, 0, 24, 23, 22,
+	0, 125, 25, 0, 27, 29, 0, 0, 0, 30,
+	0, 0, 31, 0, 26, 28, 0, 0, 44, 24,
+	23, 22, 0, 0, 155, 0, 0, 29, 0, 0,
+	27, 30, 0, 0, 31, 0, 153, 0, 26, 0,
+	21, 28, 0, 0, 0, 24, 23, 22, 162, 151,
+	25, 0, 0, 29, 27, 0, 0, 30, 0, 0,
+	31, 0, 180, 0, 26, 28, 21, 0, 0, 24,
+	23, 22, 0, 0, 25, 305, 0, 29, 0, 0,
+	27, 30, 38, 0, 31, 0, 180, 0, 0, 0,
+	21, 28, 0, 0, 0, 24, 23, 22, 0, 178,
+	25, 0, 0, 29, 131, 0, 124, 30, 0, 126,
+	31, 0, 0, 26, 130, 0, 21, 0, 489, 0,
+	129, 41, 0, 0, 0, 312, 127, 42, 128, 27,
+	0, 38, 0, 0, 0, 0, 0, 125, 43, 0,
+	28, 0, 0, 0, 24, 23, 22, 0, 0, 25,
+	0, 0, 29, 131, 21, 124, 30, 0, 126, 31,
+	485, 38, 0, 130, 0, 44, 0, 404, 0, 129,
+	0, 0, 0, 0, 0, 127, 0, 128, 0, 0,
+	0, 0, 0, 131, 0, 124, 125, 0, 126, 0,
+	0, 0, 0, 130, 0, 0, 0, 0, 0, 129,
+	0, 0, 0, 21, 0, 127, 0, 128, 0, 0,
+	0, 0, 0, 0, 0, 0, 125,
 }
-var JulyPact = []int{
 
-	1864, -1000, -1000, 1935, 1935, 2006, 687, -1000, -1000, 647,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2550, -1000,
-	-1000, 687, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1935, 2006, 2006, -1000, -1000, 498, -1000, 687,
-	493, 769, 768, 766, 665, -1000, -1000, 326, 2006, 764,
-	568, -1000, 469, 468, 568, 251, 239, 131, 762, 1517,
-	-1000, -1000, 461, 568, 570, 203, 234, 137, -1000, 456,
-	687, 2193, 907, 214, -1000, 86, 216, 186, -1000, 2193,
-	2423, 199, 314, -1000, 446, -1000, -1000, -1000, -1000, -1000,
-	281, 410, 1262, 677, 1, -2, 335, 710, 381, -6,
-	14, -1000, 1444, 1444, 496, -1000, -1000, -1000, -1000, -1000,
-	-1000, -8, 321, 321, 321, -1000, -15, 192, 137, -1000,
-	-1000, 512, 490, 2193, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 570, 568, 234, 137, -1000, 137, -1000, -1000,
-	355, -1000, 440, 606, -1000, 419, 421, -1000, -1000, 382,
-	731, -1000, -1000, -1000, -1000, 75, -1000, -1000, 2321, -1000,
-	-1000, -1000, 1188, -1000, 109, 102, 129, -1000, -1000, 1776,
-	453, 187, -1000, 186, -1000, -1000, 421, 2397, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 2267, -1000, 2473, -1000,
-	761, 1591, 1444, 1444, -1000, 68, 121, -1000, -1000, 1444,
-	-1000, 1444, -1000, 1444, -1000, 1444, -1000, 1444, -1000, -1000,
-	1444, 2193, 276, 254, 1444, -1000, -1000, 1444, -1000, -1000,
-	-1000, -1000, 312, 74, 302, 2547, 485, 760, -1000, -1000,
-	679, 1444, -1000, 1280, -1000, -1000, -1000, 1444, 1718, -1000,
-	649, 642, 54, 568, 137, -1000, -1000, -1000, -1000, 759,
-	687, 661, 656, 791, -1000, 2193, -1000, -1000, -1000, 754,
-	285, 753, 2163, 661, -1000, 1097, -1000, -1000, -1000, -1000,
-	1532, 702, -1000, -1000, 28, 567, 329, 1444, 327, 323,
-	855, 322, 577, 557, 2093, 1444, 320, 193, -1000, -1000,
-	464, 59, 106, 104, -1000, 65, -1000, -1000, 1776, -1000,
-	187, 137, -1000, -1000, -1000, -1000, 752, 2092, 750, 2447,
-	-1000, -1000, 2375, -1000, -1000, -1000, 281, -1000, 25, 677,
-	1426, -1000, -1000, 1, -2, 335, 710, 381, -6, -1000,
-	-1000, -1000, -1000, 353, 14, -1000, 2233, 348, 1444, 345,
-	1444, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	363, 343, 749, 321, -1000, -1000, -1000, -20, 303, -1000,
-	-1000, -21, -1000, 67, -1000, -1000, -1000, 1700, -1000, -1000,
-	-1000, 321, 51, 656, -1000, 1444, -1000, -1000, 332, -1000,
-	656, -1000, 369, -1000, -1000, 607, -1000, 285, -1000, 145,
-	2511, -1000, 447, 220, -1000, -1000, 748, 746, 285, 656,
-	-1000, -1000, -1000, -1000, 702, 430, -1000, 297, 855, -1000,
-	1444, 37, 1444, 1444, 572, 320, 1353, 566, -1000, 564,
-	-1000, 560, -1000, 550, 1444, 622, 75, 476, 63, -1000,
-	-1000, -1000, -1000, 137, -1000, -1000, 229, 742, 738, 285,
-	-1000, -1000, 728, 1444, -1000, -1000, -1000, 496, 1444, 1444,
-	1444, -1000, 1444, -1000, 277, 241, -1000, -1000, -1000, -1000,
-	1444, -1000, -1000, 1809, 41, -1000, -1000, -1000, 656, -1000,
-	-26, 687, -1000, 791, 2193, 2193, -1000, 138, 75, -1000,
-	687, 280, -1000, -1000, 1532, -1000, -1000, -1000, 502, -1000,
-	702, -1000, -1000, 290, 1809, 161, 285, 285, -1000, 403,
-	702, -1000, -1000, 311, 1444, -1000, 310, 309, 317, 308,
-	-1000, -1000, -1000, -1000, 1532, 725, 395, 2022, 543, -1000,
-	243, -1000, -1000, -1000, -1000, 307, 622, -1000, -1000, 75,
-	298, 622, 279, -1000, 450, 725, -1000, -1000, -1000, 542,
-	-1000, 405, 488, 284, 1809, 285, 285, -1000, 507, 163,
-	-1000, 485, -32, 306, -1000, -1000, -1000, -1000, 263, -1000,
-	-1000, 1609, -1000, -1000, -1000, -1000, -1000, -1000, 44, -1000,
-	-1000, -1000, -1000, 404, 382, -1000, 1368, -1000, 482, 661,
-	-1000, 1809, -1000, 73, 44, -1000, -1000, -1000, -1000, -1000,
-	855, 538, 136, 855, 1444, 855, 725, 22, 661, 1951,
-	1444, 537, 1444, 1880, 1809, 702, 75, -1000, -1000, -1000,
-	476, 622, -1000, 232, -1000, 725, 250, -1000, 685, 487,
-	534, -1000, 1809, -1000, -1000, -1000, 533, -1000, 526, 521,
-	305, -1000, -16, -1000, -1000, -1000, -1000, 687, -1000, 475,
-	656, -1000, 44, -1000, -1000, 610, -1000, 88, -1000, 304,
-	-1000, 21, 1444, 656, 520, 1444, -1000, 1444, 379, -1000,
-	516, 1444, 400, 371, -1000, 450, 90, 382, -1000, -1000,
-	-1000, 238, 1444, 388, -1000, 282, 508, -1000, -1000, -1000,
-	-1000, -1000, -1000, 631, 382, 715, -1000, 855, 66, -1000,
-	-1000, 1005, -1000, 2164, 17, 451, 1444, -1000, 1444, 379,
-	379, 1444, 1444, 379, 702, 89, 299, 687, 1444, -1000,
-	685, 228, 1809, -1000, 621, 1591, 661, -1000, -1000, -1000,
-	1188, -1000, 12, 6, -1000, -1000, -1000, 379, -1000, 379,
-	371, 296, 75, 382, -1000, -1000, 1809, -1000, 1591, -1000,
-	656, -1000, -1000, 75, -1000, -1000, -1000, -1000,
+var JulyPact = [...]int16{
+	1845, -32768, -32768, 1916, 1916, 1987, 750, -32768, -32768, 711,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 2449, -32768,
+	-32768, 750, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, 1916, 1987, 1987, -32768, -32768, 584, -32768, 750,
+	572, 816, 815, 812, 703, -32768, -32768, 390, 1987, 810,
+	620, -32768, 539, 526, 620, 202, 298, 76, 809, 1498,
+	-32768, -32768, 514, 620, 622, 251, 271, 105, -32768, 500,
+	750, 2517, 2324, 168, -32768, 102, 211, 118, -32768, 2517,
+	2374, 135, 362, -32768, 489, -32768, -32768, -32768, -32768, -32768,
+	290, 482, 1243, 739, 4, -2, 398, 759, 437, -8,
+	8, -32768, 1425, 1425, 543, -32768, -32768, -32768, -32768, -32768,
+	-32768, -11, 371, 371, 371, -32768, -17, 133, 105, -32768,
+	-32768, 566, 533, 2517, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, 622, 620, 271, 105, -32768, 105, -32768, -32768,
+	417, -32768, 477, 682, -32768, 464, 473, -32768, -32768, 491,
+	733, -32768, -32768, -32768, -32768, 95, -32768, -32768, 2248, -32768,
+	-32768, -32768, 1169, -32768, 114, 96, 73, -32768, -32768, 257,
+	508, 220, -32768, 118, -32768, -32768, 473, 2350, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, 1494, 1774, -32768, 2400,
+	-32768, 808, 1572, 1425, 1425, -32768, 63, 65, -32768, -32768,
+	1425, -32768, 1425, -32768, 1425, -32768, 1425, -32768, 1425, -32768,
+	-32768, 1425, 2517, 238, 285, 1425, -32768, -32768, 1425, -32768,
+	-32768, -32768, -32768, 359, 74, 407, 836, 529, 803, -32768,
+	-32768, 696, 1425, -32768, 1261, -32768, -32768, -32768, 1425, 1699,
+	-32768, 714, 713, 62, 620, 105, -32768, -32768, -32768, -32768,
+	802, 750, 724, 720, 1330, -32768, 2517, -32768, -32768, -32768,
+	800, 329, 797, 2144, 724, -32768, 1078, -32768, -32768, -32768,
+	-32768, 2487, 752, -32768, -32768, 24, 619, 396, 1425, 395,
+	393, 859, 381, 624, 618, 2074, 1425, 370, 222, -32768,
+	-32768, 580, 57, 108, 64, -32768, 28, -32768, -32768, 257,
+	-32768, 220, 105, -32768, -32768, -32768, -32768, 795, 2073, 794,
+	-32768, 911, -32768, -32768, 2302, -32768, -32768, -32768, 290, -32768,
+	17, 739, 1407, -32768, -32768, 4, -2, 398, 759, 437,
+	-8, 791, -32768, -32768, -32768, 410, 8, -32768, 2214, 377,
+	1425, 326, 1425, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, 423, 368, 790, 371, -32768, -32768, -32768, -20,
+	324, -32768, -32768, -27, -32768, 35, -32768, -32768, -32768, 1681,
+	-32768, -32768, -32768, 371, 49, 720, -32768, 1425, -32768, -32768,
+	378, -32768, 720, -32768, 419, -32768, -32768, 656, -32768, 329,
+	-32768, 132, 2438, -32768, 568, 355, -32768, -32768, 787, 783,
+	329, 720, -32768, -32768, -32768, -32768, 752, 563, -32768, 373,
+	859, -32768, 1425, 15, 1425, 1425, 627, 370, 1334, 617,
+	-32768, 615, -32768, 612, -32768, 609, 1425, 684, 95, 503,
+	26, -32768, -32768, -32768, -32768, 105, -32768, -32768, 354, 781,
+	779, 329, -32768, -32768, 773, 1425, -32768, -32768, -32768, -32768,
+	543, 1425, 1425, 1425, -32768, 1425, -32768, 239, 195, -32768,
+	-32768, -32768, -32768, 1425, -32768, -32768, 1790, 34, -32768, -32768,
+	-32768, 720, -32768, -28, 750, -32768, 1330, 2517, 2517, -32768,
+	200, 95, -32768, 750, 249, -32768, -32768, 2487, -32768, -32768,
+	-32768, 587, -32768, 752, -32768, -32768, 367, 1790, 325, 329,
+	329, -32768, 495, 752, -32768, -32768, 358, 1425, -32768, 357,
+	342, 366, 336, -32768, -32768, -32768, -32768, 2487, 768, 468,
+	2003, 608, -32768, 235, -32768, -32768, -32768, -32768, 323, 684,
+	-32768, -32768, 95, 364, 684, 321, -32768, 498, 768, -32768,
+	-32768, -32768, 606, -32768, 462, 315, 365, 1790, 329, 329,
+	-32768, 101, 302, -32768, 529, -38, 313, -32768, -32768, -32768,
+	-32768, 236, -32768, -32768, 1590, -32768, -32768, -32768, -32768, -32768,
+	-32768, 155, -32768, -32768, -32768, -32768, 458, 491, -32768, 2201,
+	-32768, 523, 724, -32768, 1790, -32768, 198, 155, -32768, -32768,
+	-32768, -32768, -32768, 859, 601, 99, 859, 1425, 859, 768,
+	16, 724, 1932, 1425, 597, 1425, 1861, 1790, 752, 95,
+	-32768, -32768, -32768, 503, 684, -32768, 385, -32768, 768, 224,
+	-32768, 746, 183, 193, -32768, -32768, 1790, -32768, -32768, -32768,
+	179, -32768, -32768, 591, 589, 312, -32768, -18, -32768, -32768,
+	-32768, -32768, 750, -32768, 513, 720, -32768, 155, -32768, -32768,
+	687, -32768, 148, -32768, 294, -32768, 10, 1425, 720, 586,
+	1425, -32768, 1425, 445, -32768, 575, 1425, 453, 442, -32768,
+	498, 85, 491, -32768, -32768, -32768, 11, 1425, 450, -32768,
+	328, 141, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, 707, 491, 754, -32768, 859, 125, -32768, -32768, 987,
+	-32768, 2145, 9, 496, 1425, -32768, 1425, 445, 445, 1425,
+	1425, 445, 752, 84, 212, 750, 1425, -32768, 746, 288,
+	1790, -32768, -32768, 693, 1572, 724, -32768, -32768, -32768, 1169,
+	-32768, 7, 6, -32768, -32768, -32768, 445, -32768, 445, 442,
+	203, 95, 491, -32768, -32768, 1790, -32768, 1572, -32768, 720,
+	-32768, -32768, 95, -32768, -32768, -32768, -32768,
 }
-var JulyPgo = []int{
 
-	0, 911, 910, 909, 775, 736, 72, 185, 904, 672,
-	800, 16, 48, 46, 902, 901, 900, 896, 644, 10,
-	463, 420, 67, 39, 60, 693, 534, 66, 895, 91,
-	539, 259, 52, 43, 894, 29, 892, 41, 889, 44,
-	9, 887, 885, 884, 6, 59, 8, 879, 878, 71,
-	37, 876, 5, 875, 874, 30, 873, 872, 871, 34,
-	26, 42, 13, 36, 869, 868, 61, 864, 863, 862,
-	858, 18, 31, 857, 856, 1, 2, 855, 854, 22,
-	53, 27, 21, 11, 853, 0, 848, 4, 7, 846,
-	25, 845, 844, 17, 19, 843, 23, 12, 842, 14,
-	15, 840, 3, 838, 837, 832, 830, 24, 826, 825,
-	824, 821, 820, 55, 818, 54, 814, 62, 813, 58,
-	811, 57, 809, 50, 805, 56, 804, 45, 797, 35,
-	792, 790, 32, 20, 38, 789, 785, 784, 783, 51,
-	781, 780, 33, 778, 47, 49, 777, 40, 776,
+var JulyPgo = [...]int16{
+	0, 958, 957, 764, 776, 2, 54, 52, 955, 954,
+	950, 947, 699, 19, 45, 592, 946, 506, 21, 40,
+	38, 11, 53, 14, 945, 39, 0, 944, 25, 941,
+	34, 35, 6, 939, 938, 33, 937, 3, 5, 936,
+	17, 71, 22, 27, 1, 7, 934, 44, 933, 15,
+	18, 4, 8, 13, 932, 929, 926, 916, 915, 910,
+	908, 66, 61, 51, 55, 50, 49, 47, 37, 29,
+	28, 43, 906, 905, 904, 26, 36, 30, 898, 897,
+	822, 775, 59, 56, 64, 32, 896, 893, 891, 889,
+	888, 883, 882, 67, 881, 879, 878, 877, 31, 876,
+	65, 875, 874, 873, 20, 872, 869, 16, 10, 868,
+	23, 867, 866, 862, 9, 861, 48, 860, 859, 849,
+	46, 848, 75, 121, 362, 42, 845, 508, 479, 79,
+	63, 12, 256, 41, 844, 843, 842, 838, 835, 834,
+	833, 832, 831, 830, 829, 24, 828, 827, 824, 823,
 }
-var JulyR1 = []int{
 
-	0, 1, 2, 2, 2, 2, 2, 2, 2, 6,
-	6, 7, 7, 8, 8, 3, 4, 4, 9, 9,
-	9, 9, 5, 5, 10, 10, 11, 11, 12, 12,
-	13, 13, 18, 20, 22, 14, 14, 14, 14, 14,
-	14, 14, 14, 15, 15, 16, 16, 16, 16, 17,
-	29, 29, 30, 30, 30, 19, 19, 19, 19, 33,
-	33, 31, 31, 31, 31, 31, 31, 31, 31, 32,
-	34, 34, 35, 35, 35, 35, 24, 36, 36, 37,
-	37, 38, 38, 39, 39, 39, 39, 39, 39, 39,
-	39, 39, 39, 23, 23, 23, 23, 23, 41, 41,
-	40, 40, 40, 42, 42, 43, 43, 45, 44, 44,
-	44, 47, 47, 47, 47, 48, 48, 25, 25, 50,
-	50, 50, 50, 51, 51, 51, 51, 51, 51, 53,
-	57, 57, 57, 58, 58, 61, 61, 62, 59, 59,
-	59, 59, 54, 54, 55, 55, 56, 64, 64, 64,
-	27, 27, 65, 65, 66, 66, 67, 67, 67, 67,
-	68, 70, 70, 71, 71, 74, 74, 75, 75, 73,
-	73, 72, 72, 72, 72, 77, 77, 69, 69, 69,
-	63, 63, 78, 78, 79, 79, 81, 81, 81, 81,
-	80, 80, 80, 80, 82, 82, 83, 83, 60, 60,
-	60, 60, 76, 76, 86, 86, 84, 84, 84, 52,
-	87, 87, 87, 88, 88, 88, 89, 89, 90, 90,
-	90, 90, 90, 90, 90, 90, 90, 90, 90, 90,
-	90, 90, 90, 90, 90, 90, 90, 90, 90, 90,
-	90, 90, 90, 90, 90, 90, 93, 93, 96, 96,
-	97, 97, 94, 95, 95, 98, 98, 99, 99, 91,
-	91, 100, 101, 101, 102, 102, 102, 21, 21, 92,
-	92, 92, 92, 103, 103, 105, 105, 105, 105, 104,
-	104, 104, 104, 106, 106, 106, 106, 109, 109, 109,
-	109, 110, 110, 107, 107, 108, 108, 85, 85, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	111, 46, 46, 112, 112, 114, 113, 113, 116, 115,
-	115, 118, 117, 117, 120, 119, 119, 122, 121, 121,
-	124, 124, 123, 123, 123, 126, 126, 126, 126, 126,
-	126, 126, 125, 125, 128, 128, 127, 127, 130, 130,
-	130, 131, 131, 131, 131, 131, 131, 129, 129, 129,
-	129, 129, 129, 129, 133, 133, 132, 132, 132, 132,
-	132, 132, 132, 132, 134, 134, 134, 134, 134, 135,
-	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
-	135, 135, 135, 135, 135, 139, 139, 140, 140, 137,
-	137, 137, 138, 138, 136, 136, 136, 141, 141, 142,
-	26, 26, 26, 26, 26, 26, 26, 26, 143, 143,
-	145, 145, 145, 145, 145, 145, 145, 145, 49, 49,
-	144, 144, 28, 28, 146, 146, 147, 147, 147, 147,
-	148, 148, 148, 148,
+var JulyR1 = [...]uint8{
+	0, 149, 1, 1, 1, 1, 1, 1, 1, 122,
+	122, 124, 124, 126, 126, 2, 80, 80, 3, 3,
+	3, 3, 81, 81, 4, 4, 5, 5, 6, 6,
+	7, 7, 12, 127, 129, 8, 8, 8, 8, 8,
+	8, 8, 8, 9, 9, 10, 10, 10, 10, 11,
+	123, 123, 17, 17, 17, 13, 13, 13, 13, 125,
+	125, 132, 132, 132, 132, 132, 132, 132, 132, 85,
+	86, 86, 18, 18, 18, 18, 82, 87, 87, 19,
+	19, 88, 88, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 14, 14, 14, 14, 14, 89, 89,
+	20, 20, 20, 90, 90, 91, 91, 22, 21, 21,
+	21, 24, 24, 24, 24, 92, 92, 83, 83, 25,
+	25, 25, 25, 94, 94, 94, 94, 94, 94, 95,
+	96, 96, 96, 97, 97, 32, 32, 130, 30, 30,
+	30, 30, 27, 27, 28, 28, 29, 33, 33, 33,
+	84, 84, 99, 99, 100, 100, 101, 101, 101, 101,
+	102, 103, 103, 104, 104, 105, 105, 37, 37, 36,
+	36, 35, 35, 35, 35, 35, 35, 35, 35, 39,
+	39, 39, 39, 34, 34, 34, 79, 79, 98, 98,
+	106, 106, 40, 40, 42, 42, 42, 42, 41, 41,
+	41, 41, 43, 43, 107, 107, 31, 31, 31, 31,
+	38, 38, 147, 147, 146, 146, 146, 26, 108, 108,
+	108, 45, 45, 45, 46, 46, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 110, 110, 50, 50, 131, 131,
+	49, 111, 111, 112, 112, 51, 51, 109, 109, 52,
+	113, 113, 53, 53, 53, 128, 128, 48, 48, 48,
+	48, 54, 54, 56, 56, 56, 56, 55, 55, 55,
+	55, 57, 57, 57, 57, 58, 58, 58, 58, 59,
+	59, 114, 114, 115, 115, 44, 44, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 23,
+	23, 60, 60, 135, 61, 61, 136, 62, 62, 137,
+	63, 63, 138, 64, 64, 139, 65, 65, 140, 140,
+	66, 66, 66, 66, 141, 141, 141, 141, 141, 141,
+	141, 67, 67, 142, 142, 68, 68, 143, 143, 143,
+	144, 144, 144, 144, 144, 144, 69, 69, 69, 69,
+	69, 69, 69, 145, 145, 70, 70, 70, 70, 70,
+	70, 70, 70, 71, 71, 71, 71, 71, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 116, 116, 117, 117, 148, 148,
+	148, 74, 74, 73, 73, 73, 118, 118, 75, 15,
+	15, 15, 15, 15, 15, 15, 15, 119, 119, 76,
+	76, 76, 76, 76, 76, 76, 76, 93, 93, 120,
+	120, 16, 16, 121, 121, 77, 77, 77, 77, 78,
+	78, 78, 78,
 }
-var JulyR2 = []int{
 
+var JulyR2 = [...]int8{
 	0, 1, 3, 2, 2, 1, 2, 1, 1, 1,
 	2, 1, 3, 1, 3, 3, 1, 2, 6, 4,
 	5, 3, 1, 2, 1, 1, 1, 1, 1, 1,
@@ -799,115 +822,117 @@ var JulyR2 = []int{
 	3, 2, 3, 2, 3, 2, 3, 3, 3, 2,
 	3, 2, 1, 2, 1, 1, 1, 1, 1, 1,
 	4, 2, 1, 1, 3, 1, 3, 4, 3, 3,
-	2, 4, 3, 3, 2, 3, 2, 5, 5, 4,
-	3, 2, 1, 3, 2, 1, 6, 3, 5, 2,
-	1, 1, 2, 2, 2, 1, 1, 3, 4, 2,
-	3, 1, 1, 1, 1, 3, 4, 3, 2, 3,
-	0, 1, 2, 1, 1, 1, 4, 3, 1, 1,
-	3, 2, 7, 5, 5, 3, 7, 6, 5, 7,
-	5, 3, 2, 3, 2, 3, 2, 3, 5, 3,
-	4, 3, 5, 4, 4, 3, 1, 2, 7, 6,
-	1, 3, 2, 4, 3, 1, 3, 5, 4, 1,
-	2, 2, 1, 2, 3, 3, 2, 1, 3, 1,
-	1, 1, 1, 5, 4, 4, 3, 3, 2, 5,
-	4, 4, 3, 5, 4, 4, 3, 5, 3, 3,
-	1, 3, 2, 1, 3, 1, 3, 1, 3, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 3, 3,
-	4, 1, 5, 1, 3, 1, 1, 3, 1, 1,
-	3, 1, 1, 3, 1, 1, 3, 1, 1, 3,
-	1, 1, 1, 3, 3, 1, 1, 2, 2, 2,
-	2, 3, 1, 3, 1, 1, 1, 3, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 2, 4, 5,
-	4, 5, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 1, 3, 1, 3, 4, 1, 2, 1,
-	1, 4, 6, 4, 3, 4, 3, 3, 3, 3,
-	4, 2, 2, 2, 2, 3, 2, 1, 3, 1,
-	3, 2, 3, 4, 4, 3, 3, 1, 2, 3,
-	5, 4, 4, 3, 4, 3, 3, 2, 1, 3,
-	4, 3, 3, 2, 3, 2, 2, 1, 1, 2,
-	2, 1, 3, 2, 1, 2, 5, 5, 1, 1,
-	5, 3, 4, 2,
+	2, 4, 3, 3, 2, 4, 3, 3, 2, 3,
+	2, 3, 2, 5, 5, 4, 1, 2, 3, 2,
+	1, 3, 2, 1, 6, 3, 5, 2, 1, 1,
+	2, 2, 2, 1, 1, 3, 4, 2, 3, 1,
+	1, 1, 1, 3, 4, 3, 2, 3, 0, 1,
+	2, 1, 1, 1, 4, 3, 1, 1, 3, 2,
+	7, 5, 5, 3, 7, 6, 5, 7, 5, 3,
+	2, 3, 2, 3, 2, 3, 5, 3, 4, 3,
+	5, 4, 4, 3, 1, 2, 7, 6, 1, 3,
+	2, 4, 3, 1, 3, 5, 4, 1, 2, 2,
+	1, 2, 3, 3, 2, 1, 3, 1, 1, 1,
+	1, 5, 4, 4, 3, 3, 2, 5, 4, 4,
+	3, 5, 4, 4, 3, 5, 3, 3, 1, 3,
+	2, 1, 3, 1, 3, 1, 3, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 3, 4, 1,
+	5, 1, 3, 1, 1, 3, 1, 1, 3, 1,
+	1, 3, 1, 1, 3, 1, 1, 3, 1, 1,
+	1, 3, 3, 4, 1, 1, 2, 2, 2, 2,
+	3, 1, 3, 1, 1, 1, 3, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 2, 4, 5, 4,
+	5, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 1, 3, 1, 3, 4, 1, 2, 1, 1,
+	4, 6, 4, 3, 4, 3, 3, 3, 3, 4,
+	2, 2, 2, 2, 3, 2, 1, 3, 1, 3,
+	2, 3, 4, 4, 3, 3, 1, 2, 3, 5,
+	4, 4, 3, 4, 3, 3, 2, 1, 3, 4,
+	3, 3, 2, 3, 2, 2, 1, 1, 2, 2,
+	1, 3, 2, 1, 2, 5, 5, 1, 1, 5,
+	3, 4, 2,
 }
-var JulyChk = []int{
 
-	-1000, -1, -2, -3, -4, -5, 54, -9, -10, 46,
-	-11, 72, -12, -13, -14, -15, -16, -17, -23, -40,
-	-39, 76, 57, 56, 55, 60, 24, 40, 51, 63,
-	67, 70, -4, -5, -5, -9, -10, -7, 4, 60,
-	-7, 32, 38, 49, 76, -39, -40, -7, -5, 73,
-	-6, 72, -7, 73, -6, 4, 4, 4, 49, 81,
-	4, 72, 73, -6, 75, -24, -18, -22, -25, 77,
-	39, 45, 84, -22, -26, 84, -24, -20, -27, 39,
-	84, 4, -42, 82, -43, -44, -45, -40, -46, -47,
-	4, -112, 84, -113, -115, -117, -119, -121, -123, -125,
-	-127, -129, -131, 81, -132, 11, 12, 93, 94, 89,
-	90, -7, 64, 61, 53, -134, -135, -136, -138, 5,
-	6, -31, 69, 52, 28, 59, 31, 48, 50, 42,
-	36, 26, 75, -6, -18, -22, -25, -22, -25, -25,
-	-36, -37, -24, 4, -19, -7, -21, -33, -31, -7,
-	-49, 85, -50, 72, -51, 60, -52, -53, -23, -56,
-	-12, -13, 84, -26, -143, 74, -144, 85, -145, 72,
-	-41, 4, -40, -20, -27, -27, -21, -65, 85, -66,
-	72, -67, -68, -69, -12, -13, -23, -28, 84, 82,
-	74, 83, 79, -114, 9, -48, 74, 85, -44, -116,
-	10, -118, 87, -120, 88, -122, 80, -124, 7, 8,
-	-126, 47, 77, 78, -128, 89, 90, -130, 75, 91,
-	92, -129, -85, -7, -31, -46, -133, 73, 11, 12,
-	73, 95, -139, 81, -139, -139, -139, 95, 84, -25,
-	73, 73, -33, -6, -22, -25, -25, -25, 78, 74,
-	39, -32, -29, 77, 23, 74, 85, -50, -52, 69,
-	4, -30, -24, -31, -19, -87, -88, -89, -11, -90,
-	-80, -30, -52, 72, 4, -85, 44, 25, 62, 71,
-	35, 43, 27, 33, 58, 65, 63, 68, 40, -40,
-	-31, -7, 74, -144, 85, -144, 85, 85, -49, -40,
-	4, -139, -25, -27, 85, -66, -30, -24, 69, -146,
-	85, -147, -23, -12, -13, -45, 4, -44, -85, -113,
-	74, 85, 85, -115, -117, -119, -121, -123, -125, -30,
-	83, 77, 83, 78, -127, -129, 82, -29, 82, -29,
-	-111, 83, 15, 16, 17, 18, 19, 20, 21, 22,
-	77, 78, 73, 4, -134, 64, 32, -85, -140, 82,
-	-85, -85, 85, -137, -76, -84, -85, 84, 32, 32,
-	-139, -32, -141, -29, -142, 95, -25, -37, -38, -19,
-	-29, 23, -34, -35, -30, 79, -33, 4, -55, -63,
-	81, -57, -58, 4, -60, -64, -30, 69, 4, -29,
-	85, -88, 40, -40, -30, -83, -60, 4, 86, 72,
-	81, -85, 81, 81, -90, 63, 81, 4, 72, 4,
-	72, -85, 72, -85, 81, -52, -95, 81, -144, 85,
-	-145, 85, 85, -139, -25, -25, 4, -30, 69, 4,
-	85, -147, -30, 86, 85, -44, 78, -132, 95, 81,
-	82, -129, 82, -85, 77, 78, 4, -139, 96, 82,
-	74, 96, 85, 74, -86, 85, -76, -139, -29, -142,
-	-85, 80, 78, 74, 39, 61, -54, -63, -62, -52,
-	66, -78, 82, -79, -80, -81, 40, -40, -30, 72,
-	74, 72, -59, -29, 83, -63, 4, 4, -55, -83,
-	74, 72, -90, -85, 86, 72, -85, -85, 71, -92,
-	-103, -104, -105, -106, -80, -30, -108, 72, -109, -85,
-	-110, 72, 72, 72, 72, -85, -93, -94, -96, 41,
-	30, -52, -98, -99, -80, -19, 85, -25, -70, -71,
-	-72, -73, -63, -29, 83, 4, 4, -77, -63, 4,
-	-46, -133, -85, -85, -129, -129, 83, 83, 78, -85,
-	-76, 74, 85, 96, -19, -35, -30, -30, -62, -61,
-	-52, 72, -52, -8, -7, 82, 74, -81, 73, 4,
-	-60, 83, -76, -29, -62, -61, -59, -59, 72, -60,
-	82, -85, 82, 82, 81, 82, -30, -82, 4, 72,
-	74, -85, 72, 72, 83, 74, 82, -94, -96, -52,
-	81, -93, -94, 72, 82, -19, -82, 72, 74, -29,
-	-62, 72, 83, -76, -72, -72, -62, 72, -148, -71,
-	81, 96, 82, 83, -76, 85, -61, 74, -79, 73,
-	-29, -76, -62, -61, -61, -90, 72, 84, -90, -85,
-	-90, -82, 86, -29, -85, 72, -85, 72, -107, -85,
-	-85, 72, -76, -83, -52, -80, -97, -7, -94, 82,
-	-99, -82, 83, -74, -75, 4, -62, 72, 72, -76,
-	72, 72, 72, 82, -7, 73, -61, 37, -91, 85,
-	-100, -101, -102, 29, 34, 82, 86, -85, 72, -107,
-	-107, 74, 72, -107, 74, -97, 4, 87, 83, -85,
-	74, -29, 83, 72, 23, 34, 4, -90, 85, -100,
-	-87, -102, -85, 4, 86, 72, -85, -107, -85, -107,
-	-83, 4, 82, -7, -85, -75, 83, -76, 34, -44,
-	-29, 86, 86, 82, -52, -76, -44, -52,
+var JulyChk = [...]int16{
+	-32768, -149, -1, -2, -80, -81, 54, -3, -4, 46,
+	-5, 72, -6, -7, -8, -9, -10, -11, -14, -20,
+	-133, 76, 57, 56, 55, 60, 24, 40, 51, 63,
+	67, 70, -80, -81, -81, -3, -4, -124, 4, 60,
+	-124, 32, 38, 49, 76, -133, -20, -124, -81, 73,
+	-122, 72, -124, 73, -122, 4, 4, 4, 49, 81,
+	4, 72, 73, -122, 75, -82, -12, -129, -83, 77,
+	39, 45, 84, -129, -15, 84, -82, -127, -84, 39,
+	84, 4, -90, 82, -91, -21, -22, -20, -23, -24,
+	4, -60, 84, -61, -62, -63, -64, -65, -66, -67,
+	-68, -69, -144, 81, -70, 11, 12, 93, 94, 89,
+	90, -124, 64, 61, 53, -71, -72, -73, -74, 5,
+	6, -132, 69, 52, 28, 59, 31, 48, 50, 42,
+	36, 26, 75, -122, -12, -129, -83, -129, -83, -83,
+	-87, -19, -82, 4, -13, -124, -128, -125, -132, -124,
+	-93, 85, -25, 72, -94, 60, -26, -95, -14, -29,
+	-6, -7, 84, -15, -119, 74, -120, 85, -76, 72,
+	-89, 4, -20, -127, -84, -84, -128, -99, 85, -100,
+	72, -101, -102, -34, -6, -7, -79, -14, -16, 84,
+	82, 74, 83, 79, -135, 9, -92, 74, 85, -21,
+	-136, 10, -137, 87, -138, 88, -139, 80, -140, 7,
+	8, -141, 47, 77, 78, -142, 89, 90, -143, 75,
+	91, 92, -69, -44, -124, -132, -23, -145, 73, 11,
+	12, 73, 95, -116, 81, -116, -116, -116, 95, 84,
+	-83, 73, 73, -125, -122, -129, -83, -83, -83, 78,
+	74, 39, -85, -123, 77, 23, 74, 85, -25, -26,
+	69, 4, -17, -82, -132, -13, -108, -45, -46, -5,
+	-47, -41, -17, -26, 72, 4, -44, 44, 25, 62,
+	71, 35, 43, 27, 33, 58, 65, 63, 68, 40,
+	-20, -132, -124, 74, -120, 85, -120, 85, 85, -93,
+	-20, 4, -116, -83, -84, 85, -100, -17, -82, 69,
+	34, -121, 85, -77, -14, -6, -7, -22, 4, -21,
+	-44, -61, 74, 85, 85, -62, -63, -64, -65, -66,
+	-67, -17, 83, 77, 83, 78, -68, -69, 82, -123,
+	82, -123, -134, 83, 15, 16, 17, 18, 19, 20,
+	21, 22, 77, 78, 73, 4, -71, 64, 32, -44,
+	-117, 82, -44, -44, 85, -148, -38, -146, -44, 84,
+	32, 32, -116, -85, -118, -123, -75, 95, -83, -19,
+	-88, -13, -123, 23, -86, -18, -17, 79, -125, 4,
+	-28, -98, 81, -96, -97, 4, -31, -33, -17, 69,
+	4, -123, 85, -45, 40, -20, -17, -107, -31, 4,
+	86, 72, 81, -44, 81, 81, -47, 63, 81, 4,
+	72, 4, 72, -44, 72, -44, 81, -26, -111, 81,
+	-120, 85, -76, 85, 85, -116, -83, -83, 4, -17,
+	69, 4, 85, -77, -17, 86, 85, -21, 4, 78,
+	-70, 95, 81, 82, -69, 82, -44, 77, 78, 4,
+	-116, 96, 82, 74, 96, 85, 74, -147, 85, -38,
+	-116, -123, -75, -44, 80, 78, 74, 39, 61, -27,
+	-98, -130, -26, 66, -106, 82, -40, -41, -42, 40,
+	-20, -17, 72, 74, 72, -30, -123, 83, -98, 4,
+	4, -28, -107, 74, 72, -47, -44, 86, 72, -44,
+	-44, 71, -48, -54, -55, -56, -57, -41, -17, -115,
+	72, -58, -44, -59, 72, 72, 72, 72, -44, -110,
+	-49, -50, 41, 30, -26, -112, -51, -41, -13, 85,
+	-83, -103, -104, -35, -36, -98, -123, 83, 4, 4,
+	-39, -98, 4, -23, -145, -44, -44, -69, -69, 83,
+	83, 78, -44, -38, 74, 85, 96, -13, -18, -17,
+	-17, -130, -32, -26, 72, -26, -126, -124, 82, 74,
+	-42, 73, 4, -31, 83, -38, -123, -130, -32, -30,
+	-30, 72, -31, 82, -44, 82, 82, 81, 82, -17,
+	-43, 4, 72, 74, -44, 72, 72, 83, 74, 82,
+	-49, -50, -26, 81, -110, -49, 72, 82, -13, -43,
+	72, 74, -123, -130, 72, -26, 83, -38, -35, -35,
+	-130, 72, -26, -78, -104, 81, 96, 82, 83, -38,
+	85, -32, 74, -40, 73, -123, -38, -130, -32, -32,
+	-47, 72, 84, -47, -44, -47, -43, 86, -123, -44,
+	72, -44, 72, -114, -44, -44, 72, -38, -107, -26,
+	-41, -131, -124, -49, 82, -51, -43, 83, -105, -37,
+	4, -130, 72, -26, 72, -26, -38, 72, -26, 72,
+	72, 82, -124, 73, -32, 37, -109, 85, -52, -113,
+	-53, 29, 34, 82, 86, -44, 72, -114, -114, 74,
+	72, -114, 74, -131, 4, 87, 83, -44, 74, -123,
+	83, 72, -26, 23, 34, 4, -47, 85, -52, -108,
+	-53, -44, 4, 86, 72, -44, -114, -44, -114, -107,
+	4, 82, -124, -44, -37, 83, -38, 34, -21, -123,
+	86, 86, 82, -26, -38, -21, -26,
 }
-var JulyDef = []int{
 
+var JulyDef = [...]int16{
 	93, -2, 1, -2, -2, -2, 0, 16, 22, 0,
 	24, 25, 26, 27, 28, 29, 30, 31, 0, 94,
 	95, 0, 83, 84, 85, 86, 87, 88, 89, 90,
@@ -917,75 +942,76 @@ var JulyDef = []int{
 	12, 10, 0, 19, 0, 0, 0, 0, 42, 0,
 	0, 0, 93, 0, 44, 0, 0, 0, 48, 0,
 	93, 0, 0, 101, 103, 104, 105, 108, 109, 110,
-	11, 311, 0, 313, 316, 319, 322, 325, 328, 332,
-	342, 346, 0, 0, 363, 351, 352, 353, 354, 355,
-	356, 366, 367, 368, 369, 370, 372, 374, 377, 379,
-	380, 0, 0, 0, 61, 62, 63, 64, 65, 66,
+	11, 319, 0, 321, 324, 327, 330, 333, 336, 340,
+	351, 355, 0, 0, 372, 360, 361, 362, 363, 364,
+	365, 375, 376, 377, 378, 379, 381, 383, 386, 388,
+	389, 0, 0, 0, 61, 62, 63, 64, 65, 66,
 	67, 68, 0, 20, 0, 0, 38, 0, 40, 41,
-	0, 77, 0, 80, 32, 58, 34, 267, 59, 60,
-	93, 118, 428, 119, 120, 86, 122, 123, 0, 126,
-	127, 128, -2, 43, 0, 0, 0, 417, 418, -2,
-	0, 427, 98, 0, 46, 47, 33, 93, 151, 152,
-	154, 155, 156, 157, 158, 159, 0, 49, 93, 100,
-	0, 0, 0, 0, 315, 0, 0, 114, 115, 0,
-	318, 0, 321, 0, 324, 0, 327, 0, 330, 331,
-	0, 0, 335, 336, 0, 344, 345, 0, 348, 349,
-	350, 357, 0, 366, 0, 297, 362, 0, 364, 365,
-	0, 0, 394, 0, 391, 392, 393, 0, 0, 378,
-	0, 0, 0, 18, 0, 36, 37, 39, 76, 0,
-	0, 56, 57, 0, 50, 0, 117, 429, 121, 0,
-	11, 0, 0, 52, 54, 93, 211, 213, 214, 215,
-	0, 0, 218, 219, 11, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 90, 0, -2, -2,
-	52, -2, 0, 0, 413, 0, 415, 416, -2, 99,
-	423, 425, 426, 45, 150, 153, 0, 0, 0, 93,
-	433, 434, 0, 438, 439, 106, 0, 107, 0, 314,
-	0, 112, 113, 317, 320, 323, 326, 329, 333, 334,
-	337, 339, 338, 340, 343, 347, 373, 0, 0, 0,
-	0, 299, 300, 301, 302, 303, 304, 305, 306, 307,
-	0, 0, 0, 384, 371, 386, 387, 0, 0, 396,
-	397, 0, 375, 0, 399, 202, 203, 0, 388, 389,
-	402, 0, 405, 406, 407, 0, 35, 78, 79, 81,
-	55, 51, 0, 70, 72, 75, 268, 0, 125, 0,
-	0, 129, 0, 201, 133, 146, 0, 0, 11, 53,
-	209, 212, 192, 193, 0, 0, 196, 201, 0, 221,
-	0, 0, 0, 0, 0, 0, 0, 0, 232, 0,
-	234, 0, 236, 0, 0, 0, 0, 0, 0, 411,
-	419, 412, 414, 421, 422, 424, 0, 0, 0, 0,
-	432, 435, 0, 0, 111, 116, 341, 358, 0, 0,
-	0, 360, 0, 298, 0, 0, 385, 390, 381, 395,
-	0, 383, 376, 401, 0, 208, 204, 403, 404, 408,
-	0, 0, 69, 0, 0, 0, 124, 0, 0, 145,
-	0, 0, 181, 182, 0, 185, 190, 191, 0, 130,
-	0, 131, 132, 199, 0, 0, 0, 0, 149, 0,
-	0, 217, 220, 0, 0, 225, 0, 0, 0, 0,
-	269, 270, 271, 272, 0, 0, 0, 0, 0, 295,
-	290, 231, 233, 235, 237, 0, 239, 241, 246, 0,
-	0, 245, 0, 255, 0, 0, 410, 420, 160, 0,
-	162, 163, 0, 0, 0, 0, 0, 179, 0, 0,
-	312, 0, 0, 0, 359, 361, 308, 309, 0, 398,
-	400, 0, 207, 409, 82, 71, 73, 74, 0, 143,
-	135, 136, 144, 137, 13, 180, 0, 184, 0, 189,
-	134, 0, 200, 0, 0, 141, 147, 148, 216, 197,
-	0, 0, 0, 0, 0, 0, 0, 292, 195, 0,
-	0, 0, 278, 0, 0, 0, 0, 240, 247, 252,
-	0, 243, 244, 0, 254, 0, 0, 161, 0, 0,
-	0, 174, 0, 170, 177, 178, 0, 176, 0, 0,
-	0, 382, 373, 310, 205, 206, 142, 0, 183, 0,
-	187, 198, 0, 139, 140, 223, 224, 0, 228, 0,
-	230, 291, 0, 194, 0, 282, 296, 276, 277, 293,
-	0, 286, 288, 289, 238, 0, 0, 250, 242, 253,
-	256, 0, 0, 164, 165, 0, 0, 172, 173, 169,
-	175, 436, 437, 443, 14, 0, 138, 0, 0, 227,
-	259, -2, 262, 0, 0, 0, 0, 274, 280, 281,
-	275, 0, 284, 285, 0, 0, 0, 0, 0, 258,
-	0, 0, 0, 171, 441, 0, 188, 222, 226, 260,
-	-2, 263, 0, 11, 266, 229, 273, 279, 294, 283,
-	287, 0, 0, 251, 257, 166, 0, 168, 0, 442,
-	186, 264, 265, 0, 249, 167, 440, 248,
+	0, 77, 0, 80, 32, 58, 34, 275, 59, 60,
+	93, 118, 437, 119, 120, 86, 122, 123, 0, 126,
+	127, 128, -2, 43, 0, 0, 0, 426, 427, -2,
+	0, 436, 98, 0, 46, 47, 33, 93, 151, 152,
+	154, 155, 156, 157, 158, 159, 0, 186, 49, 93,
+	100, 0, 0, 0, 0, 323, 0, 0, 114, 115,
+	0, 326, 0, 329, 0, 332, 0, 335, 0, 338,
+	339, 0, 0, 344, 345, 0, 353, 354, 0, 357,
+	358, 359, 366, 0, 375, 0, 305, 371, 0, 373,
+	374, 0, 0, 403, 0, 400, 401, 402, 0, 0,
+	387, 0, 0, 0, 18, 0, 36, 37, 39, 76,
+	0, 0, 56, 57, 0, 50, 0, 117, 438, 121,
+	0, 11, 0, 0, 52, 54, 93, 219, 221, 222,
+	223, 0, 0, 226, 227, 11, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 90, 0, -2,
+	-2, 52, -2, 0, 0, 422, 0, 424, 425, -2,
+	99, 432, 434, 435, 45, 150, 153, 0, 0, 0,
+	187, 93, 442, 443, 0, 447, 448, 106, 0, 107,
+	0, 322, 0, 112, 113, 325, 328, 331, 334, 337,
+	341, 342, 346, 348, 347, 349, 352, 356, 382, 0,
+	0, 0, 0, 307, 308, 309, 310, 311, 312, 313,
+	314, 315, 0, 0, 0, 393, 380, 395, 396, 0,
+	0, 405, 406, 0, 384, 0, 408, 210, 211, 0,
+	397, 398, 411, 0, 414, 415, 416, 0, 35, 78,
+	79, 81, 55, 51, 0, 70, 72, 75, 276, 0,
+	125, 0, 0, 129, 0, 209, 133, 146, 0, 0,
+	11, 53, 217, 220, 200, 201, 0, 0, 204, 209,
+	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 0, 242, 0, 244, 0, 0, 0, 0, 0,
+	0, 420, 428, 421, 423, 430, 431, 433, 0, 0,
+	0, 0, 441, 444, 0, 0, 111, 116, 343, 350,
+	367, 0, 0, 0, 369, 0, 306, 0, 0, 394,
+	399, 390, 404, 0, 392, 385, 410, 0, 216, 212,
+	412, 413, 417, 0, 0, 69, 0, 0, 0, 124,
+	0, 0, 145, 0, 0, 189, 190, 0, 193, 198,
+	199, 0, 130, 0, 131, 132, 207, 0, 0, 0,
+	0, 149, 0, 0, 225, 228, 0, 0, 233, 0,
+	0, 0, 0, 277, 278, 279, 280, 0, 0, 0,
+	0, 0, 303, 298, 239, 241, 243, 245, 0, 247,
+	249, 254, 0, 0, 253, 0, 263, 0, 0, 419,
+	429, 160, 0, 162, 163, 0, 0, 0, 0, 0,
+	185, 0, 0, 320, 0, 0, 0, 368, 370, 316,
+	317, 0, 407, 409, 0, 215, 418, 82, 71, 73,
+	74, 0, 143, 135, 136, 144, 137, 13, 188, 0,
+	192, 0, 197, 134, 0, 208, 0, 0, 141, 147,
+	148, 224, 205, 0, 0, 0, 0, 0, 0, 0,
+	300, 203, 0, 0, 0, 286, 0, 0, 0, 0,
+	248, 255, 260, 0, 251, 252, 0, 262, 0, 0,
+	161, 0, 0, 0, 174, 178, 0, 170, 183, 184,
+	0, 180, 182, 0, 0, 0, 391, 382, 318, 213,
+	214, 142, 0, 191, 0, 195, 206, 0, 139, 140,
+	231, 232, 0, 236, 0, 238, 299, 0, 202, 0,
+	290, 304, 284, 285, 301, 0, 294, 296, 297, 246,
+	0, 0, 258, 250, 261, 264, 0, 0, 164, 165,
+	0, 0, 172, 176, 173, 177, 169, 179, 181, 445,
+	446, 452, 14, 0, 138, 0, 0, 235, 267, -2,
+	270, 0, 0, 0, 0, 282, 288, 289, 283, 0,
+	292, 293, 0, 0, 0, 0, 0, 266, 0, 0,
+	0, 171, 175, 450, 0, 196, 230, 234, 268, -2,
+	271, 0, 11, 274, 237, 281, 287, 302, 291, 295,
+	0, 0, 259, 265, 166, 0, 168, 0, 451, 194,
+	272, 273, 0, 257, 167, 449, 256,
 }
-var JulyTok1 = []int{
 
+var JulyTok1 = [...]int8{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -1000,8 +1026,8 @@ var JulyTok1 = []int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 84, 87, 85, 94,
 }
-var JulyTok2 = []int{
 
+var JulyTok2 = [...]int8{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
@@ -1010,28 +1036,56 @@ var JulyTok2 = []int{
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
 	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
 }
-var JulyTok3 = []int{
+
+var JulyTok3 = [...]int8{
 	0,
 }
 
+var JulyErrorMessages = [...]struct {
+	state int
+	token int
+	msg   string
+}{}
+
 //line yaccpar:1
 
 /*	parser for yacc output	*/
 
-var JulyDebug = 0
+var (
+	JulyDebug        = 0
+	JulyErrorVerbose = false
+)
 
 type JulyLexer interface {
 	Lex(lval *JulySymType) int
 	Error(s string)
 }
 
-const JulyFlag = -1000
+type JulyParser interface {
+	Parse(JulyLexer) int
+	Lookahead() int
+}
+
+type JulyParserImpl struct {
+	lval  JulySymType
+	stack [JulyInitialStackSize]JulySymType
+	char  int
+}
+
+func (p *JulyParserImpl) Lookahead() int {
+	return p.char
+}
+
+func JulyNewParser() JulyParser {
+	return &JulyParserImpl{}
+}
+
+const JulyFlag = -32768
 
 func JulyTokname(c int) string {
-	// 4 is TOKSTART above
-	if c >= 4 && c-4 < len(JulyToknames) {
-		if JulyToknames[c-4] != "" {
-			return JulyToknames[c-4]
+	if c >= 1 && c-1 < len(JulyToknames) {
+		if JulyToknames[c-1] != "" {
+			return JulyToknames[c-1]
 		}
 	}
 	return __yyfmt__.Sprintf("tok-%v", c)
@@ -1046,51 +1100,127 @@ func JulyStatname(s int) string {
 	return __yyfmt__.Sprintf("state-%v", s)
 }
 
-func Julylex1(lex JulyLexer, lval *JulySymType) int {
-	c := 0
-	char := lex.Lex(lval)
+func JulyErrorMessage(state, lookAhead int) string {
+	const TOKSTART = 4
+
+	if !JulyErrorVerbose {
+		return "syntax error"
+	}
+
+	for _, e := range JulyErrorMessages {
+		if e.state == state && e.token == lookAhead {
+			return "syntax error: " + e.msg
+		}
+	}
+
+	res := "syntax error: unexpected " + JulyTokname(lookAhead)
+
+	// To match Bison, suggest at most four expected tokens.
+	expected := make([]int, 0, 4)
+
+	// Look for shiftable tokens.
+	base := int(JulyPact[state])
+	for tok := TOKSTART; tok-1 < len(JulyToknames); tok++ {
+		if n := base + tok; n >= 0 && n < JulyLast && int(JulyChk[int(JulyAct[n])]) == tok {
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+	}
+
+	if JulyDef[state] == -2 {
+		i := 0
+		for JulyExca[i] != -1 || int(JulyExca[i+1]) != state {
+			i += 2
+		}
+
+		// Look for tokens that we accept or reduce.
+		for i += 2; JulyExca[i] >= 0; i += 2 {
+			tok := int(JulyExca[i])
+			if tok < TOKSTART || JulyExca[i+1] == 0 {
+				continue
+			}
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+
+		// If the default action is to accept or reduce, give up.
+		if JulyExca[i+1] != 0 {
+			return res
+		}
+	}
+
+	for i, tok := range expected {
+		if i == 0 {
+			res += ", expecting "
+		} else {
+			res += " or "
+		}
+		res += JulyTokname(tok)
+	}
+	return res
+}
+
+func Julylex1(lex JulyLexer, lval *JulySymType) (char, token int) {
+	token = 0
+	char = lex.Lex(lval)
 	if char <= 0 {
-		c = JulyTok1[0]
+		token = int(JulyTok1[0])
 		goto out
 	}
 	if char < len(JulyTok1) {
-		c = JulyTok1[char]
+		token = int(JulyTok1[char])
 		goto out
 	}
 	if char >= JulyPrivate {
 		if char < JulyPrivate+len(JulyTok2) {
-			c = JulyTok2[char-JulyPrivate]
+			token = int(JulyTok2[char-JulyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(JulyTok3); i += 2 {
-		c = JulyTok3[i+0]
-		if c == char {
-			c = JulyTok3[i+1]
+		token = int(JulyTok3[i+0])
+		if token == char {
+			token = int(JulyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
-	if c == 0 {
-		c = JulyTok2[1] /* unknown char */
+	if token == 0 {
+		token = int(JulyTok2[1]) /* unknown char */
 	}
 	if JulyDebug >= 3 {
-		__yyfmt__.Printf("lex %s(%d)\n", JulyTokname(c), uint(char))
+		__yyfmt__.Printf("lex %s(%d)\n", JulyTokname(token), uint(char))
 	}
-	return c
+	return char, token
 }
 
 func JulyParse(Julylex JulyLexer) int {
+	return JulyNewParser().Parse(Julylex)
+}
+
+func (Julyrcvr *JulyParserImpl) Parse(Julylex JulyLexer) int {
 	var Julyn int
-	var Julylval JulySymType
 	var JulyVAL JulySymType
-	JulyS := make([]JulySymType, JulyMaxDepth)
+	var JulyDollar []JulySymType
+	_ = JulyDollar // silence set and not used
+	JulyS := Julyrcvr.stack[:]
 
 	Nerrs := 0   /* number of errors */
 	Errflag := 0 /* error recovery flag */
 	Julystate := 0
-	Julychar := -1
+	Julyrcvr.char = -1
+	Julytoken := -1 // Julyrcvr.char translated into internal numbering
+	defer func() {
+		// Make sure we report no lookahead when not parsing.
+		Julystate = -1
+		Julyrcvr.char = -1
+		Julytoken = -1
+	}()
 	Julyp := -1
 	goto Julystack
 
@@ -1103,7 +1233,7 @@ ret1:
 Julystack:
 	/* put a state and value onto the stack */
 	if JulyDebug >= 4 {
-		__yyfmt__.Printf("char %v in %v\n", JulyTokname(Julychar), JulyStatname(Julystate))
+		__yyfmt__.Printf("char %v in %v\n", JulyTokname(Julytoken), JulyStatname(Julystate))
 	}
 
 	Julyp++
@@ -1116,21 +1246,22 @@ Julystack:
 	JulyS[Julyp].yys = Julystate
 
 Julynewstate:
-	Julyn = JulyPact[Julystate]
+	Julyn = int(JulyPact[Julystate])
 	if Julyn <= JulyFlag {
 		goto Julydefault /* simple state */
 	}
-	if Julychar < 0 {
-		Julychar = Julylex1(Julylex, &Julylval)
+	if Julyrcvr.char < 0 {
+		Julyrcvr.char, Julytoken = Julylex1(Julylex, &Julyrcvr.lval)
 	}
-	Julyn += Julychar
+	Julyn += Julytoken
 	if Julyn < 0 || Julyn >= JulyLast {
 		goto Julydefault
 	}
-	Julyn = JulyAct[Julyn]
-	if JulyChk[Julyn] == Julychar { /* valid shift */
-		Julychar = -1
-		JulyVAL = Julylval
+	Julyn = int(JulyAct[Julyn])
+	if int(JulyChk[Julyn]) == Julytoken { /* valid shift */
+		Julyrcvr.char = -1
+		Julytoken = -1
+		JulyVAL = Julyrcvr.lval
 		Julystate = Julyn
 		if Errflag > 0 {
 			Errflag--
@@ -1140,27 +1271,27 @@ Julynewstate:
 
 Julydefault:
 	/* default state action */
-	Julyn = JulyDef[Julystate]
+	Julyn = int(JulyDef[Julystate])
 	if Julyn == -2 {
-		if Julychar < 0 {
-			Julychar = Julylex1(Julylex, &Julylval)
+		if Julyrcvr.char < 0 {
+			Julyrcvr.char, Julytoken = Julylex1(Julylex, &Julyrcvr.lval)
 		}
 
 		/* look through exception table */
 		xi := 0
 		for {
-			if JulyExca[xi+0] == -1 && JulyExca[xi+1] == Julystate {
+			if JulyExca[xi+0] == -1 && int(JulyExca[xi+1]) == Julystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			Julyn = JulyExca[xi+0]
-			if Julyn < 0 || Julyn == Julychar {
+			Julyn = int(JulyExca[xi+0])
+			if Julyn < 0 || Julyn == Julytoken {
 				break
 			}
 		}
-		Julyn = JulyExca[xi+1]
+		Julyn = int(JulyExca[xi+1])
 		if Julyn < 0 {
 			goto ret0
 		}
@@ -1169,11 +1300,11 @@ Julydefault:
 		/* error ... attempt to resume parsing */
 		switch Errflag {
 		case 0: /* brand new error */
-			Julylex.Error("syntax error")
+			Julylex.Error(JulyErrorMessage(Julystate, Julytoken))
 			Nerrs++
 			if JulyDebug >= 1 {
 				__yyfmt__.Printf("%s", JulyStatname(Julystate))
-				__yyfmt__.Printf(" saw %s\n", JulyTokname(Julychar))
+				__yyfmt__.Printf(" saw %s\n", JulyTokname(Julytoken))
 			}
 			fallthrough
 
@@ -1182,10 +1313,10 @@ Julydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for Julyp >= 0 {
-				Julyn = JulyPact[JulyS[Julyp].yys] + JulyErrCode
+				Julyn = int(JulyPact[JulyS[Julyp].yys]) + JulyErrCode
 				if Julyn >= 0 && Julyn < JulyLast {
-					Julystate = JulyAct[Julyn] /* simulate a shift of "error" */
-					if JulyChk[Julystate] == JulyErrCode {
+					Julystate = int(JulyAct[Julyn]) /* simulate a shift of "error" */
+					if int(JulyChk[Julystate]) == JulyErrCode {
 						goto Julystack
 					}
 				}
@@ -1201,12 +1332,13 @@ Julydefault:
 
 		case 3: /* no shift yet; clobber input char */
 			if JulyDebug >= 2 {
-				__yyfmt__.Printf("error recovery discards %s\n", JulyTokname(Julychar))
+				__yyfmt__.Printf("error recovery discards %s\n", JulyTokname(Julytoken))
 			}
-			if Julychar == JulyEofCode {
+			if Julytoken == JulyEofCode {
 				goto ret1
 			}
-			Julychar = -1
+			Julyrcvr.char = -1
+			Julytoken = -1
 			goto Julynewstate /* try again in the same state */
 		}
 	}
@@ -1220,27 +1352,35 @@ Julydefault:
 	Julypt := Julyp
 	_ = Julypt // guard against "declared and not used"
 
-	Julyp -= JulyR2[Julyn]
+	Julyp -= int(JulyR2[Julyn])
+	// Julyp is now the index of $0. Perform the default action. Iff the
+	// reduced production is ε, $1 is possibly out of range.
+	if Julyp+1 >= len(JulyS) {
+		nyys := make([]JulySymType, len(JulyS)*2)
+		copy(nyys, JulyS)
+		JulyS = nyys
+	}
 	JulyVAL = JulyS[Julyp+1]
 
 	/* consult goto table to find next state */
-	Julyn = JulyR1[Julyn]
-	Julyg := JulyPgo[Julyn]
+	Julyn = int(JulyR1[Julyn])
+	Julyg := int(JulyPgo[Julyn])
 	Julyj := Julyg + JulyS[Julyp].yys + 1
 
 	if Julyj >= JulyLast {
-		Julystate = JulyAct[Julyg]
+		Julystate = int(JulyAct[Julyg])
 	} else {
-		Julystate = JulyAct[Julyj]
-		if JulyChk[Julystate] != -Julyn {
-			Julystate = JulyAct[Julyg]
+		Julystate = int(JulyAct[Julyj])
+		if int(JulyChk[Julystate]) != -Julyn {
+			Julystate = int(JulyAct[Julyg])
 		}
 	}
 	// dummy call; replaced with literal code
 	switch Julynt {
 
 	case 1:
-		//line grammar/java11.y:173
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:271
 		{
 			var mylex *myLexer
 			if l, ok := Julylex.(*myLexer); !ok {
@@ -1250,615 +1390,711 @@ Julydefault:
 				mylex = l
 			}
 
-			if prog, ok := JulyS[Julypt-0].obj.(*JProgramFile); !ok {
-				ReportCastError("JProgramFile", JulyS[Julypt-0].obj)
+			if prog, ok := JulyDollar[1].obj.(*JProgramFile); !ok {
+				ReportCastError("JProgramFile", JulyDollar[1].obj)
 			} else {
 
 				mylex.SetJavaProgram(prog)
 			}
 		}
 	case 2:
-		//line grammar/java11.y:193
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:291
 		{
-			JulyVAL.obj = NewJProgramFile(JulyS[Julypt-2].obj, JulyS[Julypt-1].objlist, JulyS[Julypt-0].objlist)
+			JulyVAL.obj = NewJProgramFile(JulyDollar[1].obj, JulyDollar[2].objlist, JulyDollar[3].objlist)
 		}
 	case 3:
-		//line grammar/java11.y:195
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:295
 		{
-			JulyVAL.obj = NewJProgramFile(JulyS[Julypt-1].obj, JulyS[Julypt-0].objlist, nil)
+			JulyVAL.obj = NewJProgramFile(JulyDollar[1].obj, JulyDollar[2].objlist, nil)
 		}
 	case 4:
-		//line grammar/java11.y:197
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:299
 		{
-			JulyVAL.obj = NewJProgramFile(JulyS[Julypt-1].obj, nil, JulyS[Julypt-0].objlist)
+			JulyVAL.obj = NewJProgramFile(JulyDollar[1].obj, nil, JulyDollar[2].objlist)
 		}
 	case 5:
-		//line grammar/java11.y:199
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:303
 		{
-			JulyVAL.obj = NewJProgramFile(JulyS[Julypt-0].obj, nil, nil)
+			JulyVAL.obj = NewJProgramFile(JulyDollar[1].obj, nil, nil)
 		}
 	case 6:
-		//line grammar/java11.y:201
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:307
 		{
-			JulyVAL.obj = NewJProgramFile(nil, JulyS[Julypt-1].objlist, JulyS[Julypt-0].objlist)
+			JulyVAL.obj = NewJProgramFile(nil, JulyDollar[1].objlist, JulyDollar[2].objlist)
 		}
 	case 7:
-		//line grammar/java11.y:203
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:311
 		{
-			JulyVAL.obj = NewJProgramFile(nil, JulyS[Julypt-0].objlist, nil)
+			JulyVAL.obj = NewJProgramFile(nil, JulyDollar[1].objlist, nil)
 		}
 	case 8:
-		//line grammar/java11.y:205
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:315
 		{
-			JulyVAL.obj = NewJProgramFile(nil, nil, JulyS[Julypt-0].objlist)
+			JulyVAL.obj = NewJProgramFile(nil, nil, JulyDollar[1].objlist)
 		}
 	case 9:
-		//line grammar/java11.y:210
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:322
 		{
 			JulyVAL.count = 1
 		}
 	case 10:
-		//line grammar/java11.y:212
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:326
 		{
 			JulyVAL.count += 1
 		}
 	case 11:
-		//line grammar/java11.y:217
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:333
 		{
-			JulyVAL.name = NewJTypeName(JulyS[Julypt-0].str, false)
+			JulyVAL.name = NewJTypeName(JulyDollar[1].str, false)
 		}
 	case 12:
-		//line grammar/java11.y:219
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:337
 		{
-			JulyS[Julypt-2].name.Add(JulyS[Julypt-0].str)
-			JulyVAL.name = JulyS[Julypt-2].name
+			JulyDollar[1].name.Add(JulyDollar[3].str)
+			JulyVAL.name = JulyDollar[1].name
 		}
 	case 13:
-		//line grammar/java11.y:227
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:345
 		{
 			JulyVAL.namelist = make([]*JTypeName, 1)
-			JulyVAL.namelist[0] = JulyS[Julypt-0].name
+			JulyVAL.namelist[0] = JulyDollar[1].name
 		}
 	case 14:
-		//line grammar/java11.y:232
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:350
 		{
-			JulyVAL.namelist = append(JulyS[Julypt-2].namelist, JulyS[Julypt-0].name)
+			JulyVAL.namelist = append(JulyDollar[1].namelist, JulyDollar[3].name)
 		}
 	case 15:
-		//line grammar/java11.y:237
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:357
 		{
-			JulyVAL.obj = NewJPackageStmt(JulyS[Julypt-1].name)
+			JulyVAL.obj = NewJPackageStmt(JulyDollar[2].name)
 		}
 	case 16:
-		//line grammar/java11.y:242
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:364
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 17:
-		//line grammar/java11.y:247
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:369
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-1].objlist, JulyS[Julypt-0].obj)
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[2].obj)
 		}
 	case 18:
-		//line grammar/java11.y:252
+		JulyDollar = JulyS[Julypt-6 : Julypt+1]
+//line grammar/java11.y:376
 		{
-			JulyVAL.obj = NewJImportStmt(JulyS[Julypt-3].name, true, true)
+			JulyVAL.obj = NewJImportStmt(JulyDollar[3].name, true, true)
 		}
 	case 19:
-		//line grammar/java11.y:254
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:380
 		{
-			JulyVAL.obj = NewJImportStmt(JulyS[Julypt-1].name, false, true)
+			JulyVAL.obj = NewJImportStmt(JulyDollar[3].name, false, true)
 		}
 	case 20:
-		//line grammar/java11.y:256
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:384
 		{
-			JulyVAL.obj = NewJImportStmt(JulyS[Julypt-3].name, true, false)
+			JulyVAL.obj = NewJImportStmt(JulyDollar[2].name, true, false)
 		}
 	case 21:
-		//line grammar/java11.y:258
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:388
 		{
-			JulyVAL.obj = NewJImportStmt(JulyS[Julypt-1].name, false, false)
+			JulyVAL.obj = NewJImportStmt(JulyDollar[2].name, false, false)
 		}
 	case 22:
-		//line grammar/java11.y:263
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:395
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			if JulyS[Julypt-0].obj != nil {
-				JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			if JulyDollar[1].obj != nil {
+				JulyVAL.objlist[0] = JulyDollar[1].obj
 			}
 		}
 	case 23:
-		//line grammar/java11.y:270
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:402
 		{
-			if JulyS[Julypt-0].obj == nil {
-				JulyVAL.objlist = JulyS[Julypt-1].objlist
+			if JulyDollar[2].obj == nil {
+				JulyVAL.objlist = JulyDollar[1].objlist
 			} else {
-				JulyVAL.objlist = append(JulyS[Julypt-1].objlist, JulyS[Julypt-0].obj)
+				JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[2].obj)
 			}
 		}
 	case 24:
-		//line grammar/java11.y:281
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:413
 		{
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 25:
-		//line grammar/java11.y:283
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:417
 		{
 			JulyVAL.obj = nil
 		}
 	case 26:
-		//line grammar/java11.y:288
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:424
 		{
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 27:
-		//line grammar/java11.y:290
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:428
 		{
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 28:
-		//line grammar/java11.y:295
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:435
 		{
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 29:
-		//line grammar/java11.y:297
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:439
 		{
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 30:
-		//line grammar/java11.y:302
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:446
 		{
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 31:
-		//line grammar/java11.y:304
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:450
 		{
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 32:
-		//line grammar/java11.y:309
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:457
 		{
-			if jtyp, ok := JulyS[Julypt-0].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-0].obj)
+			if jtyp, ok := JulyDollar[2].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[2].obj)
 			} else {
 				JulyVAL.obj = jtyp
 			}
 		}
 	case 33:
-		//line grammar/java11.y:320
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:468
 		{
-			JulyVAL.namelist = JulyS[Julypt-0].namelist
+			JulyVAL.namelist = JulyDollar[2].namelist
 		}
 	case 34:
-		//line grammar/java11.y:325
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:475
 		{
-			JulyVAL.namelist = JulyS[Julypt-0].namelist
+			JulyVAL.namelist = JulyDollar[2].namelist
 		}
 	case 35:
-		//line grammar/java11.y:330
+		JulyDollar = JulyS[Julypt-7 : Julypt+1]
+//line grammar/java11.y:482
 		{
-			if jmod, ok := JulyS[Julypt-6].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-6].obj)
-			} else if jtyp, ok := JulyS[Julypt-2].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-2].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
+			} else if jtyp, ok := JulyDollar[5].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[5].obj)
 			} else {
-				JulyVAL.obj = NewJClassDecl(jmod, JulyS[Julypt-4].str, JulyS[Julypt-3].objlist, jtyp,
-					JulyS[Julypt-1].namelist, JulyS[Julypt-0].objlist)
+				JulyVAL.obj = NewJClassDecl(jmod, JulyDollar[3].str, JulyDollar[4].objlist, jtyp,
+					JulyDollar[6].namelist, JulyDollar[7].objlist)
 			}
 		}
 	case 36:
-		//line grammar/java11.y:341
+		JulyDollar = JulyS[Julypt-6 : Julypt+1]
+//line grammar/java11.y:493
 		{
-			if jmod, ok := JulyS[Julypt-5].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-5].obj)
-			} else if jtyp, ok := JulyS[Julypt-1].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-1].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
+			} else if jtyp, ok := JulyDollar[5].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[5].obj)
 			} else {
-				JulyVAL.obj = NewJClassDecl(jmod, JulyS[Julypt-3].str, JulyS[Julypt-2].objlist, jtyp, nil,
-					JulyS[Julypt-0].objlist)
+				JulyVAL.obj = NewJClassDecl(jmod, JulyDollar[3].str, JulyDollar[4].objlist, jtyp, nil,
+					JulyDollar[6].objlist)
 			}
 		}
 	case 37:
-		//line grammar/java11.y:352
+		JulyDollar = JulyS[Julypt-6 : Julypt+1]
+//line grammar/java11.y:504
 		{
-			if jmod, ok := JulyS[Julypt-5].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-5].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
 			} else {
-				JulyVAL.obj = NewJClassDecl(jmod, JulyS[Julypt-3].str, JulyS[Julypt-2].objlist, nil,
-					JulyS[Julypt-1].namelist, JulyS[Julypt-0].objlist)
+				JulyVAL.obj = NewJClassDecl(jmod, JulyDollar[3].str, JulyDollar[4].objlist, nil,
+					JulyDollar[5].namelist, JulyDollar[6].objlist)
 			}
 		}
 	case 38:
-		//line grammar/java11.y:361
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:513
 		{
-			if jmod, ok := JulyS[Julypt-4].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-4].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
 			} else {
-				JulyVAL.obj = NewJClassDecl(jmod, JulyS[Julypt-2].str, JulyS[Julypt-1].objlist, nil, nil,
-					JulyS[Julypt-0].objlist)
+				JulyVAL.obj = NewJClassDecl(jmod, JulyDollar[3].str, JulyDollar[4].objlist, nil, nil,
+					JulyDollar[5].objlist)
 			}
 		}
 	case 39:
-		//line grammar/java11.y:370
+		JulyDollar = JulyS[Julypt-6 : Julypt+1]
+//line grammar/java11.y:522
 		{
-			if jmod, ok := JulyS[Julypt-5].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-5].obj)
-			} else if jtyp, ok := JulyS[Julypt-2].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-2].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
+			} else if jtyp, ok := JulyDollar[4].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[4].obj)
 			} else {
-				JulyVAL.obj = NewJClassDecl(jmod, JulyS[Julypt-3].str, nil, jtyp, JulyS[Julypt-1].namelist,
-					JulyS[Julypt-0].objlist)
+				JulyVAL.obj = NewJClassDecl(jmod, JulyDollar[3].str, nil, jtyp, JulyDollar[5].namelist,
+					JulyDollar[6].objlist)
 			}
 		}
 	case 40:
-		//line grammar/java11.y:381
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:533
 		{
-			if jmod, ok := JulyS[Julypt-4].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-4].obj)
-			} else if jtyp, ok := JulyS[Julypt-1].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-1].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
+			} else if jtyp, ok := JulyDollar[4].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[4].obj)
 			} else {
-				JulyVAL.obj = NewJClassDecl(jmod, JulyS[Julypt-2].str, nil, jtyp, nil,
-					JulyS[Julypt-0].objlist)
+				JulyVAL.obj = NewJClassDecl(jmod, JulyDollar[3].str, nil, jtyp, nil,
+					JulyDollar[5].objlist)
 			}
 		}
 	case 41:
-		//line grammar/java11.y:392
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:544
 		{
-			if jmod, ok := JulyS[Julypt-4].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-4].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
 			} else {
-				JulyVAL.obj = NewJClassDecl(jmod, JulyS[Julypt-2].str, nil, nil, JulyS[Julypt-1].namelist,
-					JulyS[Julypt-0].objlist)
+				JulyVAL.obj = NewJClassDecl(jmod, JulyDollar[3].str, nil, nil, JulyDollar[4].namelist,
+					JulyDollar[5].objlist)
 			}
 		}
 	case 42:
-		//line grammar/java11.y:401
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:553
 		{
-			if jmod, ok := JulyS[Julypt-3].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-3].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
 			} else {
-				JulyVAL.obj = NewJClassDecl(jmod, JulyS[Julypt-1].str, nil, nil, nil, JulyS[Julypt-0].objlist)
+				JulyVAL.obj = NewJClassDecl(jmod, JulyDollar[3].str, nil, nil, nil, JulyDollar[4].objlist)
 			}
 		}
 	case 43:
-		//line grammar/java11.y:412
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:564
 		{
-			if jmod, ok := JulyS[Julypt-4].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-4].obj)
-			} else if jbody, ok := JulyS[Julypt-0].obj.(*JEnumBody); !ok {
-				ReportCastError("JEnumBody", JulyS[Julypt-0].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
+			} else if jbody, ok := JulyDollar[5].obj.(*JEnumBody); !ok {
+				ReportCastError("JEnumBody", JulyDollar[5].obj)
 			} else {
-				JulyVAL.obj = NewJEnumDecl(jmod, JulyS[Julypt-2].str, JulyS[Julypt-1].namelist, jbody)
+				JulyVAL.obj = NewJEnumDecl(jmod, JulyDollar[3].str, JulyDollar[4].namelist, jbody)
 			}
 		}
 	case 44:
-		//line grammar/java11.y:422
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:574
 		{
-			if jmod, ok := JulyS[Julypt-3].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-3].obj)
-			} else if jbody, ok := JulyS[Julypt-0].obj.(*JEnumBody); !ok {
-				ReportCastError("JEnumBody", JulyS[Julypt-0].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
+			} else if jbody, ok := JulyDollar[4].obj.(*JEnumBody); !ok {
+				ReportCastError("JEnumBody", JulyDollar[4].obj)
 			} else {
-				JulyVAL.obj = NewJEnumDecl(jmod, JulyS[Julypt-1].str, nil, jbody)
+				JulyVAL.obj = NewJEnumDecl(jmod, JulyDollar[3].str, nil, jbody)
 			}
 		}
 	case 45:
-		//line grammar/java11.y:435
+		JulyDollar = JulyS[Julypt-6 : Julypt+1]
+//line grammar/java11.y:587
 		{
-			if jmod, ok := JulyS[Julypt-5].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-5].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
 			} else {
-				JulyVAL.obj = NewJInterfaceDecl(jmod, NewJTypeName(JulyS[Julypt-3].str, false),
-					JulyS[Julypt-2].objlist, JulyS[Julypt-1].namelist, JulyS[Julypt-0].objlist)
+				JulyVAL.obj = NewJInterfaceDecl(jmod, NewJTypeName(JulyDollar[3].str, false),
+					JulyDollar[4].objlist, JulyDollar[5].namelist, JulyDollar[6].objlist)
 			}
 		}
 	case 46:
-		//line grammar/java11.y:444
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:596
 		{
-			if jmod, ok := JulyS[Julypt-4].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-4].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
 			} else {
-				JulyVAL.obj = NewJInterfaceDecl(jmod, NewJTypeName(JulyS[Julypt-2].str, false),
-					JulyS[Julypt-1].objlist, nil, JulyS[Julypt-0].objlist)
+				JulyVAL.obj = NewJInterfaceDecl(jmod, NewJTypeName(JulyDollar[3].str, false),
+					JulyDollar[4].objlist, nil, JulyDollar[5].objlist)
 			}
 		}
 	case 47:
-		//line grammar/java11.y:453
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:605
 		{
-			if jmod, ok := JulyS[Julypt-4].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-4].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
 			} else {
-				JulyVAL.obj = NewJInterfaceDecl(jmod, NewJTypeName(JulyS[Julypt-2].str, false),
-					nil, JulyS[Julypt-1].namelist, JulyS[Julypt-0].objlist)
+				JulyVAL.obj = NewJInterfaceDecl(jmod, NewJTypeName(JulyDollar[3].str, false),
+					nil, JulyDollar[4].namelist, JulyDollar[5].objlist)
 			}
 		}
 	case 48:
-		//line grammar/java11.y:462
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:614
 		{
-			if jmod, ok := JulyS[Julypt-3].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-3].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
 			} else {
-				JulyVAL.obj = NewJInterfaceDecl(jmod, NewJTypeName(JulyS[Julypt-1].str, false),
-					nil, nil, JulyS[Julypt-0].objlist)
+				JulyVAL.obj = NewJInterfaceDecl(jmod, NewJTypeName(JulyDollar[3].str, false),
+					nil, nil, JulyDollar[4].objlist)
 			}
 		}
 	case 49:
-		//line grammar/java11.y:474
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:626
 		{
 			JulyVAL.obj = NewJUnimplemented("AnnotationTypeDeclaration#0")
 		}
 	case 50:
-		//line grammar/java11.y:479
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:633
 		{
 			JulyVAL.count = 1
 		}
 	case 51:
-		//line grammar/java11.y:481
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:637
 		{
-			JulyVAL.count = JulyS[Julypt-1].count + 1
+			JulyVAL.count = JulyDollar[1].count + 1
 		}
 	case 52:
-		//line grammar/java11.y:486
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:644
 		{
-			JulyVAL.obj = NewJReferenceType(NewJTypeName(JulyS[Julypt-0].str, true), nil, 0)
+			JulyVAL.obj = NewJReferenceType(NewJTypeName(JulyDollar[1].str, true), nil, 0)
 		}
 	case 53:
-		//line grammar/java11.y:488
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:648
 		{
-			JulyVAL.obj = NewJReferenceType(NewJTypeName(JulyS[Julypt-1].str, true), nil,
-				JulyS[Julypt-0].count)
+			JulyVAL.obj = NewJReferenceType(NewJTypeName(JulyDollar[1].str, true), nil,
+				JulyDollar[2].count)
 		}
 	case 54:
-		//line grammar/java11.y:493
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:653
 		{
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 55:
-		//line grammar/java11.y:498
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:660
 		{
-			JulyVAL.obj = NewJReferenceType(JulyS[Julypt-2].name, JulyS[Julypt-1].objlist, JulyS[Julypt-0].count)
+			JulyVAL.obj = NewJReferenceType(JulyDollar[1].name, JulyDollar[2].objlist, JulyDollar[3].count)
 		}
 	case 56:
-		//line grammar/java11.y:500
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:664
 		{
-			JulyVAL.obj = NewJReferenceType(JulyS[Julypt-1].name, JulyS[Julypt-0].objlist, 0)
+			JulyVAL.obj = NewJReferenceType(JulyDollar[1].name, JulyDollar[2].objlist, 0)
 		}
 	case 57:
-		//line grammar/java11.y:502
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:668
 		{
-			JulyVAL.obj = NewJReferenceType(JulyS[Julypt-1].name, nil, JulyS[Julypt-0].count)
+			JulyVAL.obj = NewJReferenceType(JulyDollar[1].name, nil, JulyDollar[2].count)
 		}
 	case 58:
-		//line grammar/java11.y:504
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:672
 		{
-			JulyVAL.obj = NewJReferenceType(JulyS[Julypt-0].name, nil, 0)
+			JulyVAL.obj = NewJReferenceType(JulyDollar[1].name, nil, 0)
 		}
 	case 59:
-		//line grammar/java11.y:509
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:679
 		{
-			JulyVAL.name = NewJTypeName(JulyS[Julypt-0].str, true)
+			JulyVAL.name = NewJTypeName(JulyDollar[1].str, true)
 		}
 	case 60:
-		//line grammar/java11.y:511
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:683
 		{
-			JulyVAL.name = JulyS[Julypt-0].name
+			JulyVAL.name = JulyDollar[1].name
 		}
 	case 61:
-		//line grammar/java11.y:516
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:690
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 62:
-		//line grammar/java11.y:518
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:694
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 63:
-		//line grammar/java11.y:520
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:698
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 64:
-		//line grammar/java11.y:522
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:702
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 65:
-		//line grammar/java11.y:524
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:706
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 66:
-		//line grammar/java11.y:526
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:710
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 67:
-		//line grammar/java11.y:528
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:714
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 68:
-		//line grammar/java11.y:530
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:718
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 69:
-		//line grammar/java11.y:535
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:725
 		{
-			JulyVAL.objlist = JulyS[Julypt-1].objlist
+			JulyVAL.objlist = JulyDollar[2].objlist
 		}
 	case 70:
-		//line grammar/java11.y:540
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:732
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 71:
-		//line grammar/java11.y:545
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:737
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-2].objlist, JulyS[Julypt-0].obj)
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[3].obj)
 		}
 	case 72:
-		//line grammar/java11.y:550
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:744
 		{
-			if jtyp, ok := JulyS[Julypt-0].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-0].obj)
+			if jtyp, ok := JulyDollar[1].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[1].obj)
 			} else {
 				JulyVAL.obj = NewJTypeArgument(jtyp, TS_NONE)
 			}
 		}
 	case 73:
-		//line grammar/java11.y:558
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:752
 		{
-			if jtyp, ok := JulyS[Julypt-0].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-0].obj)
+			if jtyp, ok := JulyDollar[3].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[3].obj)
 			} else {
 				JulyVAL.obj = NewJTypeArgument(jtyp, TS_EXTENDS)
 			}
 		}
 	case 74:
-		//line grammar/java11.y:566
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:760
 		{
-			if jtyp, ok := JulyS[Julypt-0].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-0].obj)
+			if jtyp, ok := JulyDollar[3].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[3].obj)
 			} else {
 				JulyVAL.obj = NewJTypeArgument(jtyp, TS_SUPER)
 			}
 		}
 	case 75:
-		//line grammar/java11.y:574
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:768
 		{
 			JulyVAL.obj = NewJTypeArgument(nil, TS_PLAIN)
 		}
 	case 76:
-		//line grammar/java11.y:579
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:775
 		{
-			JulyVAL.objlist = JulyS[Julypt-1].objlist
+			JulyVAL.objlist = JulyDollar[2].objlist
 		}
 	case 77:
-		//line grammar/java11.y:584
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:782
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 78:
-		//line grammar/java11.y:589
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:787
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-2].objlist, JulyS[Julypt-1].obj)
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[2].obj)
 		}
 	case 79:
-		//line grammar/java11.y:594
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:794
 		{
-			JulyVAL.obj = NewJTypeParameter(JulyS[Julypt-2].str, JulyS[Julypt-0].objlist)
+			JulyVAL.obj = NewJTypeParameter(JulyDollar[1].str, JulyDollar[3].objlist)
 		}
 	case 80:
-		//line grammar/java11.y:596
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:798
 		{
-			JulyVAL.obj = NewJTypeParameter(JulyS[Julypt-0].str, nil)
+			JulyVAL.obj = NewJTypeParameter(JulyDollar[1].str, nil)
 		}
 	case 81:
-		//line grammar/java11.y:601
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:805
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 82:
-		//line grammar/java11.y:606
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:810
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-2].objlist, JulyS[Julypt-1].obj)
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[2].obj)
 		}
 	case 83:
-		//line grammar/java11.y:611
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:817
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 84:
-		//line grammar/java11.y:613
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:821
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 85:
-		//line grammar/java11.y:615
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:825
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 86:
-		//line grammar/java11.y:617
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:829
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 87:
-		//line grammar/java11.y:619
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:833
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 88:
-		//line grammar/java11.y:621
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:837
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 89:
-		//line grammar/java11.y:623
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:841
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 90:
-		//line grammar/java11.y:625
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:845
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 91:
-		//line grammar/java11.y:627
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:849
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 92:
-		//line grammar/java11.y:629
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:853
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 93:
-		//line grammar/java11.y:634
+		JulyDollar = JulyS[Julypt-0 : Julypt+1]
+//line grammar/java11.y:860
 		{
 			JulyVAL.obj = NewJModifiers("", nil)
 		}
 	case 94:
-		//line grammar/java11.y:638
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:864
 		{
-			if jann, ok := JulyS[Julypt-0].obj.(*JAnnotation); !ok {
-				ReportCastError("JAnnotation", JulyS[Julypt-0].obj)
+			if jann, ok := JulyDollar[1].obj.(*JAnnotation); !ok {
+				ReportCastError("JAnnotation", JulyDollar[1].obj)
 			} else {
 				jmod := NewJModifiers("", jann)
 				JulyVAL.obj = jmod
 			}
 		}
 	case 95:
-		//line grammar/java11.y:647
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:873
 		{
-			JulyVAL.obj = NewJModifiers(JulyS[Julypt-0].str, nil)
+			JulyVAL.obj = NewJModifiers(JulyDollar[1].str, nil)
 		}
 	case 96:
-		//line grammar/java11.y:649
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:877
 		{
-			if jmod, ok := JulyS[Julypt-1].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-1].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
 			} else {
-				jmod.AddModifier(JulyS[Julypt-0].str)
+				jmod.AddModifier(JulyDollar[2].str)
 				JulyVAL.obj = jmod
 			}
 		}
 	case 97:
-		//line grammar/java11.y:658
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:886
 		{
-			if jmod, ok := JulyS[Julypt-1].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-1].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
 			} else {
-				if jann, ok := JulyS[Julypt-0].obj.(*JAnnotation); !ok {
-					ReportCastError("JAnnotation", JulyS[Julypt-0].obj)
+				if jann, ok := JulyDollar[2].obj.(*JAnnotation); !ok {
+					ReportCastError("JAnnotation", JulyDollar[2].obj)
 				} else {
 					jmod.AddAnnotation(jann)
 					JulyVAL.obj = jmod
@@ -1866,178 +2102,206 @@ Julydefault:
 			}
 		}
 	case 98:
-		//line grammar/java11.y:674
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:902
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 99:
-		//line grammar/java11.y:679
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:907
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-1].objlist, JulyS[Julypt-0].obj)
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[2].obj)
 		}
 	case 100:
-		//line grammar/java11.y:684
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:914
 		{
-			JulyVAL.obj = NewJAnnotation(JulyS[Julypt-3].name, JulyS[Julypt-1].objlist, true)
+			JulyVAL.obj = NewJAnnotation(JulyDollar[2].name, JulyDollar[4].objlist, true)
 		}
 	case 101:
-		//line grammar/java11.y:686
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:918
 		{
-			JulyVAL.obj = NewJAnnotation(JulyS[Julypt-2].name, nil, true)
+			JulyVAL.obj = NewJAnnotation(JulyDollar[2].name, nil, true)
 		}
 	case 102:
-		//line grammar/java11.y:688
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:922
 		{
-			JulyVAL.obj = NewJAnnotation(JulyS[Julypt-0].name, nil, false)
+			JulyVAL.obj = NewJAnnotation(JulyDollar[2].name, nil, false)
 		}
 	case 103:
-		//line grammar/java11.y:693
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:929
 		{
-			JulyVAL.objlist = JulyS[Julypt-0].objlist
+			JulyVAL.objlist = JulyDollar[1].objlist
 		}
 	case 104:
-		//line grammar/java11.y:695
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:933
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 105:
-		//line grammar/java11.y:703
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:941
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 106:
-		//line grammar/java11.y:708
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:946
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-2].objlist, JulyS[Julypt-1].obj)
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[2].obj)
 		}
 	case 107:
-		//line grammar/java11.y:713
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:953
 		{
-			JulyVAL.obj = NewJElementValuePair(JulyS[Julypt-2].str, JulyS[Julypt-0].obj)
+			JulyVAL.obj = NewJElementValuePair(JulyDollar[1].str, JulyDollar[3].obj)
 		}
 	case 108:
-		//line grammar/java11.y:718
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:960
 		{
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 109:
-		//line grammar/java11.y:720
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:964
 		{
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 110:
-		//line grammar/java11.y:722
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:968
 		{
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 111:
-		//line grammar/java11.y:727
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:975
 		{
 			JulyVAL.obj = NewJUnimplemented("ElementValueArrayInitializer#0")
 		}
 	case 112:
-		//line grammar/java11.y:729
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:979
 		{
 			JulyVAL.obj = NewJUnimplemented("ElementValueArrayInitializer#1")
 		}
 	case 113:
-		//line grammar/java11.y:731
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:983
 		{
 			JulyVAL.obj = NewJUnimplemented("ElementValueArrayInitializer#2")
 		}
 	case 114:
-		//line grammar/java11.y:733
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:987
 		{
 			JulyVAL.obj = NewJUnimplemented("ElementValueArrayInitializer#3")
 		}
 	case 115:
-		//line grammar/java11.y:738
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:994
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 116:
-		//line grammar/java11.y:743
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:999
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-2].objlist, JulyS[Julypt-0].obj)
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[3].obj)
 		}
 	case 117:
-		//line grammar/java11.y:748
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1006
 		{
-			JulyVAL.objlist = JulyS[Julypt-1].objlist
+			JulyVAL.objlist = JulyDollar[2].objlist
 		}
 	case 118:
-		//line grammar/java11.y:750
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1010
 		{
 			JulyVAL.objlist = nil
 		}
 	case 119:
-		//line grammar/java11.y:755
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1017
 		{
 			JulyVAL.obj = NewJEmpty()
 		}
 	case 120:
-		//line grammar/java11.y:757
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1021
 		{
-			JulyVAL.obj = NewJClassBody(JulyS[Julypt-0].objlist)
+			JulyVAL.obj = NewJClassBody(JulyDollar[1].objlist)
 		}
 	case 121:
-		//line grammar/java11.y:761
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1025
 		{
-			if jblk, ok := JulyS[Julypt-0].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-0].obj)
+			if jblk, ok := JulyDollar[2].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[2].obj)
 			} else {
 				jblk.SetStatic()
 				JulyVAL.obj = jblk
 			}
 		}
 	case 122:
-		//line grammar/java11.y:770
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1034
 		{
-			if jblk, ok := JulyS[Julypt-0].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-0].obj)
+			if jblk, ok := JulyDollar[1].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[1].obj)
 			} else {
 				JulyVAL.obj = jblk
 			}
 		}
 	case 123:
-		//line grammar/java11.y:781
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1045
 		{
-			if JulyS[Julypt-0].objlist == nil || len(JulyS[Julypt-0].objlist) == 0 {
+			if JulyDollar[1].objlist == nil || len(JulyDollar[1].objlist) == 0 {
 				panic("Got empty list from MethodOrFieldDecl")
 			}
 
-			JulyVAL.objlist = JulyS[Julypt-0].objlist
+			JulyVAL.objlist = JulyDollar[1].objlist
 		}
 	case 124:
-		//line grammar/java11.y:789
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:1053
 		{
-			if jmod, ok := JulyS[Julypt-3].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-3].obj)
-			} else if jmth, ok := JulyS[Julypt-0].obj.(*JMethodDecl); !ok {
-				ReportCastError("JMethodDecl", JulyS[Julypt-0].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
+			} else if jmth, ok := JulyDollar[4].obj.(*JMethodDecl); !ok {
+				ReportCastError("JMethodDecl", JulyDollar[4].obj)
 			} else {
 				jmth.SetModifiers(jmod)
-				jmth.SetName(JulyS[Julypt-1].str)
+				jmth.SetName(JulyDollar[3].str)
 
 				JulyVAL.objlist = make([]JObject, 1)
 				JulyVAL.objlist[0] = jmth
 			}
 		}
 	case 125:
-		//line grammar/java11.y:803
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1067
 		{
-			if jmod, ok := JulyS[Julypt-2].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-2].obj)
-			} else if jmth, ok := JulyS[Julypt-0].obj.(*JMethodDecl); !ok {
-				ReportCastError("JMethodDecl", JulyS[Julypt-0].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
+			} else if jmth, ok := JulyDollar[3].obj.(*JMethodDecl); !ok {
+				ReportCastError("JMethodDecl", JulyDollar[3].obj)
 			} else {
 				jmth.SetModifiers(jmod)
-				jmth.SetName(JulyS[Julypt-1].str)
-				jmth.SetType(NewJReferenceType(NewJTypeName(JulyS[Julypt-0].str, false),
+				jmth.SetName(JulyDollar[2].str)
+				jmth.SetType(NewJReferenceType(NewJTypeName(JulyDollar[3].str, false),
 					nil, 0))
 
 				JulyVAL.objlist = make([]JObject, 1)
@@ -2045,34 +2309,38 @@ Julydefault:
 			}
 		}
 	case 126:
-		//line grammar/java11.y:819
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1083
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 127:
-		//line grammar/java11.y:824
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1088
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 128:
-		//line grammar/java11.y:829
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1093
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 129:
-		//line grammar/java11.y:837
-		{
-			if jmod, ok := JulyS[Julypt-2].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-2].obj)
-			} else if jtyp, ok := JulyS[Julypt-1].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-1].obj)
-			} else if JulyS[Julypt-0].objlist == nil || len(JulyS[Julypt-0].objlist) == 0 {
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1101
+		{
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
+			} else if jtyp, ok := JulyDollar[2].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[2].obj)
+			} else if JulyDollar[3].objlist == nil || len(JulyDollar[3].objlist) == 0 {
 				panic("MethodOrFieldRest list is nil/empty")
 			} else {
-				for _, obj := range JulyS[Julypt-0].objlist {
+				for _, obj := range JulyDollar[3].objlist {
 					if jmth, ok := obj.(*JMethodDecl); ok {
 						jmth.SetModifiers(jmod)
 						jmth.SetType(jtyp)
@@ -2083,121 +2351,135 @@ Julydefault:
 						ReportCastError("MethodOrFieldDecl", obj)
 					}
 				}
-				JulyVAL.objlist = JulyS[Julypt-0].objlist
+				JulyVAL.objlist = JulyDollar[3].objlist
 			}
 		}
 	case 130:
-		//line grammar/java11.y:863
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1127
 		{
-			JulyVAL.objlist = JulyS[Julypt-1].objlist
+			JulyVAL.objlist = JulyDollar[1].objlist
 		}
 	case 131:
-		//line grammar/java11.y:865
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1131
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = NewJVariableDecl(JulyS[Julypt-1].str, 0, nil)
+			JulyVAL.objlist[0] = NewJVariableDecl(JulyDollar[1].str, 0, nil)
 		}
 	case 132:
-		//line grammar/java11.y:870
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1136
 		{
-			if jmth, ok := JulyS[Julypt-0].obj.(*JMethodDecl); !ok {
-				ReportCastError("JMethodDecl", JulyS[Julypt-0].obj)
+			if jmth, ok := JulyDollar[2].obj.(*JMethodDecl); !ok {
+				ReportCastError("JMethodDecl", JulyDollar[2].obj)
 			} else {
-				jmth.SetName(JulyS[Julypt-1].str)
+				jmth.SetName(JulyDollar[1].str)
 				JulyVAL.objlist = make([]JObject, 1)
 				JulyVAL.objlist[0] = jmth
 			}
 		}
 	case 133:
-		//line grammar/java11.y:883
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1149
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 134:
-		//line grammar/java11.y:888
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1154
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-2].objlist, JulyS[Julypt-0].obj)
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[3].obj)
 		}
 	case 135:
-		//line grammar/java11.y:893
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1161
 		{
-			if jblk, ok := JulyS[Julypt-0].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-0].obj)
+			if jblk, ok := JulyDollar[1].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[1].obj)
 			} else {
 				JulyVAL.obj = jblk
 			}
 		}
 	case 136:
-		//line grammar/java11.y:901
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1169
 		{
 			JulyVAL.obj = NewJBlock(nil)
 		}
 	case 137:
-		//line grammar/java11.y:906
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1176
 		{
-			JulyVAL.namelist = JulyS[Julypt-0].namelist
+			JulyVAL.namelist = JulyDollar[2].namelist
 		}
 	case 138:
-		//line grammar/java11.y:911
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:1183
 		{
-			if jblk, ok := JulyS[Julypt-0].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-0].obj)
+			if jblk, ok := JulyDollar[4].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[4].obj)
 			} else {
-				JulyVAL.obj = NewJMethodDecl(makeFormalParamList(JulyS[Julypt-3].objlist),
-					JulyS[Julypt-2].count, JulyS[Julypt-1].namelist, jblk)
+				JulyVAL.obj = NewJMethodDecl(makeFormalParamList(JulyDollar[1].objlist),
+					JulyDollar[2].count, JulyDollar[3].namelist, jblk)
 			}
 		}
 	case 139:
-		//line grammar/java11.y:920
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1192
 		{
-			if jblk, ok := JulyS[Julypt-0].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-0].obj)
+			if jblk, ok := JulyDollar[3].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[3].obj)
 			} else {
-				JulyVAL.obj = NewJMethodDecl(makeFormalParamList(JulyS[Julypt-2].objlist),
-					JulyS[Julypt-1].count, nil, jblk)
+				JulyVAL.obj = NewJMethodDecl(makeFormalParamList(JulyDollar[1].objlist),
+					JulyDollar[2].count, nil, jblk)
 			}
 		}
 	case 140:
-		//line grammar/java11.y:929
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1201
 		{
-			if jblk, ok := JulyS[Julypt-0].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-0].obj)
+			if jblk, ok := JulyDollar[3].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[3].obj)
 			} else {
-				JulyVAL.obj = NewJMethodDecl(makeFormalParamList(JulyS[Julypt-2].objlist),
-					0, JulyS[Julypt-1].namelist, jblk)
+				JulyVAL.obj = NewJMethodDecl(makeFormalParamList(JulyDollar[1].objlist),
+					0, JulyDollar[2].namelist, jblk)
 			}
 		}
 	case 141:
-		//line grammar/java11.y:938
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1210
 		{
-			if jblk, ok := JulyS[Julypt-0].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-0].obj)
+			if jblk, ok := JulyDollar[2].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[2].obj)
 			} else {
-				JulyVAL.obj = NewJMethodDecl(makeFormalParamList(JulyS[Julypt-1].objlist),
+				JulyVAL.obj = NewJMethodDecl(makeFormalParamList(JulyDollar[1].objlist),
 					0, nil, jblk)
 			}
 		}
 	case 142:
-		//line grammar/java11.y:950
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1222
 		{
-			if jblk, ok := JulyS[Julypt-0].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-0].obj)
+			if jblk, ok := JulyDollar[3].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[3].obj)
 			} else {
-				jmth := NewJMethodDecl(makeFormalParamList(JulyS[Julypt-2].objlist), 0,
-					JulyS[Julypt-1].namelist, jblk)
+				jmth := NewJMethodDecl(makeFormalParamList(JulyDollar[1].objlist), 0,
+					JulyDollar[2].namelist, jblk)
 				jmth.SetType(NewJReferenceType(NewJTypeName("void", true),
 					nil, 0))
 				JulyVAL.obj = jmth
 			}
 		}
 	case 143:
-		//line grammar/java11.y:962
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1234
 		{
-			if jblk, ok := JulyS[Julypt-0].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-0].obj)
+			if jblk, ok := JulyDollar[2].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[2].obj)
 			} else {
-				jmth := NewJMethodDecl(makeFormalParamList(JulyS[Julypt-1].objlist), 0,
+				jmth := NewJMethodDecl(makeFormalParamList(JulyDollar[1].objlist), 0,
 					nil, jblk)
 				jmth.SetType(NewJReferenceType(NewJTypeName("void", true),
 					nil, 0))
@@ -2205,1987 +2487,2429 @@ Julydefault:
 			}
 		}
 	case 144:
-		//line grammar/java11.y:977
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1249
 		{
-			if jblk, ok := JulyS[Julypt-0].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-0].obj)
+			if jblk, ok := JulyDollar[3].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[3].obj)
 			} else {
-				JulyVAL.obj = NewJMethodDecl(makeFormalParamList(JulyS[Julypt-2].objlist), 0,
-					JulyS[Julypt-1].namelist, jblk)
+				JulyVAL.obj = NewJMethodDecl(makeFormalParamList(JulyDollar[1].objlist), 0,
+					JulyDollar[2].namelist, jblk)
 			}
 		}
 	case 145:
-		//line grammar/java11.y:986
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1258
 		{
-			if jblk, ok := JulyS[Julypt-0].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-0].obj)
+			if jblk, ok := JulyDollar[2].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[2].obj)
 			} else {
-				JulyVAL.obj = NewJMethodDecl(makeFormalParamList(JulyS[Julypt-1].objlist), 0,
+				JulyVAL.obj = NewJMethodDecl(makeFormalParamList(JulyDollar[1].objlist), 0,
 					nil, jblk)
 			}
 		}
 	case 146:
-		//line grammar/java11.y:998
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1270
 		{
-			if jmod, ok := JulyS[Julypt-2].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-2].obj)
-			} else if jmth, ok := JulyS[Julypt-0].obj.(*JMethodDecl); !ok {
-				ReportCastError("JMethodDecl", JulyS[Julypt-0].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
+			} else if jmth, ok := JulyDollar[3].obj.(*JMethodDecl); !ok {
+				ReportCastError("JMethodDecl", JulyDollar[3].obj)
 			} else {
 				jmth.SetModifiers(jmod)
-				jmth.SetTypeParameters(JulyS[Julypt-1].objlist)
+				jmth.SetTypeParameters(JulyDollar[2].objlist)
 				JulyVAL.obj = jmth
 			}
 		}
 	case 147:
-		//line grammar/java11.y:1013
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1285
 		{
-			if jmth, ok := JulyS[Julypt-0].obj.(*JMethodDecl); !ok {
-				ReportCastError("JMethodDecl", JulyS[Julypt-0].obj)
+			if jmth, ok := JulyDollar[3].obj.(*JMethodDecl); !ok {
+				ReportCastError("JMethodDecl", JulyDollar[3].obj)
 			} else {
-				if jtyp, ok := JulyS[Julypt-2].obj.(*JReferenceType); !ok {
-					ReportCastError("JReferenceType", JulyS[Julypt-2].obj)
+				if jtyp, ok := JulyDollar[1].obj.(*JReferenceType); !ok {
+					ReportCastError("JReferenceType", JulyDollar[1].obj)
 				} else {
 					jmth.SetType(jtyp)
-					jmth.SetName(JulyS[Julypt-1].str)
+					jmth.SetName(JulyDollar[2].str)
 					JulyVAL.obj = jmth
 				}
 			}
 		}
 	case 148:
-		//line grammar/java11.y:1027
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1299
 		{
-			if jmth, ok := JulyS[Julypt-0].obj.(*JMethodDecl); !ok {
-				ReportCastError("JMethodDecl", JulyS[Julypt-0].obj)
+			if jmth, ok := JulyDollar[3].obj.(*JMethodDecl); !ok {
+				ReportCastError("JMethodDecl", JulyDollar[3].obj)
 			} else {
-				jmth.SetType(NewJReferenceType(NewJTypeName(JulyS[Julypt-2].str, false),
+				jmth.SetType(NewJReferenceType(NewJTypeName(JulyDollar[1].str, false),
 					nil, 0))
-				jmth.SetName(JulyS[Julypt-1].str)
+				jmth.SetName(JulyDollar[2].str)
 				JulyVAL.obj = jmth
 			}
 		}
 	case 149:
-		//line grammar/java11.y:1038
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1310
 		{
-			if jmth, ok := JulyS[Julypt-0].obj.(*JMethodDecl); !ok {
-				ReportCastError("JMethodDecl", JulyS[Julypt-0].obj)
+			if jmth, ok := JulyDollar[2].obj.(*JMethodDecl); !ok {
+				ReportCastError("JMethodDecl", JulyDollar[2].obj)
 			} else {
-				jmth.SetName(JulyS[Julypt-1].str)
-				jmth.SetType(NewJReferenceType(NewJTypeName(JulyS[Julypt-1].str, false),
+				jmth.SetName(JulyDollar[1].str)
+				jmth.SetType(NewJReferenceType(NewJTypeName(JulyDollar[1].str, false),
 					nil, 0))
 				JulyVAL.obj = jmth
 			}
 		}
 	case 150:
-		//line grammar/java11.y:1052
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1324
 		{
-			JulyVAL.objlist = JulyS[Julypt-1].objlist
+			JulyVAL.objlist = JulyDollar[2].objlist
 		}
 	case 151:
-		//line grammar/java11.y:1054
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1328
 		{
 			JulyVAL.objlist = make([]JObject, 0)
 		}
 	case 152:
-		//line grammar/java11.y:1059
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1335
 		{
-			JulyVAL.objlist = JulyS[Julypt-0].objlist
+			JulyVAL.objlist = JulyDollar[1].objlist
 		}
 	case 153:
-		//line grammar/java11.y:1063
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1339
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-1].objlist, JulyS[Julypt-0].objlist...)
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[2].objlist...)
 		}
 	case 154:
-		//line grammar/java11.y:1068
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1346
 		{
 			JulyVAL.objlist = make([]JObject, 0)
 		}
 	case 155:
-		//line grammar/java11.y:1072
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1350
 		{
-			JulyVAL.objlist = JulyS[Julypt-0].objlist
+			JulyVAL.objlist = JulyDollar[1].objlist
 		}
 	case 156:
-		//line grammar/java11.y:1077
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1357
 		{
-			JulyVAL.objlist = JulyS[Julypt-0].objlist
+			JulyVAL.objlist = JulyDollar[1].objlist
 		}
 	case 157:
-		//line grammar/java11.y:1079
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1361
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 158:
-		//line grammar/java11.y:1084
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1366
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 159:
-		//line grammar/java11.y:1089
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1371
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 160:
-		//line grammar/java11.y:1097
-		{
-			if jmod, ok := JulyS[Julypt-3].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-3].obj)
-			} else if jtyp, ok := JulyS[Julypt-2].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-2].obj)
-			} else if JulyS[Julypt-0].objlist == nil || len(JulyS[Julypt-0].objlist) == 0 {
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:1379
+		{
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
+			} else if jtyp, ok := JulyDollar[2].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[2].obj)
+			} else if JulyDollar[4].objlist == nil || len(JulyDollar[4].objlist) == 0 {
 				panic("InterfaceMethodOrFieldRest list is nil/empty")
 			} else {
-				for _, obj := range JulyS[Julypt-0].objlist {
+				for _, obj := range JulyDollar[4].objlist {
 					if jimd, ok := obj.(*JInterfaceMethodDecl); ok {
 						jimd.SetModifiers(jmod)
 						jimd.SetType(jtyp)
-						jimd.SetName(JulyS[Julypt-1].str)
+						jimd.SetName(JulyDollar[3].str)
+					} else if jmth, ok := obj.(*JMethodDecl); ok {
+						jmth.SetModifiers(jmod)
+						jmth.SetType(jtyp)
+						jmth.SetName(JulyDollar[3].str)
 					} else if jcd, ok := obj.(*JConstantDecl); ok {
 						jcd.SetModifiers(jmod)
 						jcd.SetType(jtyp)
 						if !jcd.HasName() {
-							jcd.SetName(JulyS[Julypt-1].str)
+							jcd.SetName(JulyDollar[3].str)
 						}
 					} else {
 						ReportCastError("InterfaceMethodOrFieldDecl", obj)
 					}
 				}
-				JulyVAL.objlist = JulyS[Julypt-0].objlist
+				JulyVAL.objlist = JulyDollar[4].objlist
 			}
 		}
 	case 161:
-		//line grammar/java11.y:1127
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1413
 		{
-			JulyVAL.objlist = JulyS[Julypt-1].objlist
+			JulyVAL.objlist = JulyDollar[1].objlist
 		}
 	case 162:
-		//line grammar/java11.y:1131
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1417
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 163:
-		//line grammar/java11.y:1139
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1425
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 164:
-		//line grammar/java11.y:1144
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1430
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-0].objlist, JulyS[Julypt-2].obj)
+			JulyVAL.objlist = append(JulyDollar[3].objlist, JulyDollar[1].obj)
 		}
 	case 165:
-		//line grammar/java11.y:1149
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1437
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 166:
-		//line grammar/java11.y:1154
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1442
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-2].objlist, JulyS[Julypt-0].obj)
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[3].obj)
 		}
 	case 167:
-		//line grammar/java11.y:1159
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:1449
 		{
-			if init, ok := JulyS[Julypt-0].obj.(*JVariableInit); !ok {
-				ReportCastError("JVariableInit", JulyS[Julypt-0].obj)
+			if init, ok := JulyDollar[4].obj.(*JVariableInit); !ok {
+				ReportCastError("JVariableInit", JulyDollar[4].obj)
 			} else {
-				JulyVAL.obj = NewJConstantDecl(JulyS[Julypt-3].str, JulyS[Julypt-2].count, init)
+				JulyVAL.obj = NewJConstantDecl(JulyDollar[1].str, JulyDollar[2].count, init)
 			}
 		}
 	case 168:
-		//line grammar/java11.y:1167
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1457
 		{
-			if init, ok := JulyS[Julypt-0].obj.(*JVariableInit); !ok {
-				ReportCastError("JVariableInit", JulyS[Julypt-0].obj)
+			if init, ok := JulyDollar[3].obj.(*JVariableInit); !ok {
+				ReportCastError("JVariableInit", JulyDollar[3].obj)
 			} else {
-				JulyVAL.obj = NewJConstantDecl(JulyS[Julypt-2].str, 0, init)
+				JulyVAL.obj = NewJConstantDecl(JulyDollar[1].str, 0, init)
 			}
 		}
 	case 169:
-		//line grammar/java11.y:1178
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1468
 		{
-			if init, ok := JulyS[Julypt-0].obj.(*JVariableInit); !ok {
-				ReportCastError("JVariableInit", JulyS[Julypt-0].obj)
+			if init, ok := JulyDollar[3].obj.(*JVariableInit); !ok {
+				ReportCastError("JVariableInit", JulyDollar[3].obj)
 			} else {
-				JulyVAL.obj = NewJConstantDecl("", JulyS[Julypt-2].count, init)
+				JulyVAL.obj = NewJConstantDecl("", JulyDollar[1].count, init)
 			}
 		}
 	case 170:
-		//line grammar/java11.y:1186
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1476
 		{
-			if init, ok := JulyS[Julypt-0].obj.(*JVariableInit); !ok {
-				ReportCastError("JVariableInit", JulyS[Julypt-0].obj)
+			if init, ok := JulyDollar[2].obj.(*JVariableInit); !ok {
+				ReportCastError("JVariableInit", JulyDollar[2].obj)
 			} else {
 				JulyVAL.obj = NewJConstantDecl("", 0, init)
 			}
 		}
 	case 171:
-		//line grammar/java11.y:1197
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:1487
 		{
-			JulyVAL.obj = NewJInterfaceMethodDecl(makeFormalParamList(JulyS[Julypt-3].objlist),
-				JulyS[Julypt-2].count, JulyS[Julypt-1].namelist)
+			JulyVAL.obj = NewJInterfaceMethodDecl(makeFormalParamList(JulyDollar[1].objlist),
+				JulyDollar[2].count, JulyDollar[3].namelist)
 		}
 	case 172:
-		//line grammar/java11.y:1202
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1492
 		{
-			JulyVAL.obj = NewJInterfaceMethodDecl(makeFormalParamList(JulyS[Julypt-2].objlist),
-				JulyS[Julypt-1].count, nil)
+			JulyVAL.obj = NewJInterfaceMethodDecl(makeFormalParamList(JulyDollar[1].objlist),
+				JulyDollar[2].count, nil)
 		}
 	case 173:
-		//line grammar/java11.y:1207
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1497
 		{
-			JulyVAL.obj = NewJInterfaceMethodDecl(makeFormalParamList(JulyS[Julypt-2].objlist),
-				0, JulyS[Julypt-0].namelist)
+			JulyVAL.obj = NewJInterfaceMethodDecl(makeFormalParamList(JulyDollar[1].objlist),
+				0, JulyDollar[3].namelist)
 		}
 	case 174:
-		//line grammar/java11.y:1212
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1502
 		{
-			JulyVAL.obj = NewJInterfaceMethodDecl(makeFormalParamList(JulyS[Julypt-1].objlist),
+			JulyVAL.obj = NewJInterfaceMethodDecl(makeFormalParamList(JulyDollar[1].objlist),
 				0, nil)
 		}
 	case 175:
-		//line grammar/java11.y:1220
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:1507
 		{
-			JulyVAL.obj = NewJInterfaceMethodDecl(makeFormalParamList(JulyS[Julypt-2].objlist),
-				0, JulyS[Julypt-0].namelist)
+			if jblk, ok := JulyDollar[4].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[4].obj)
+			} else {
+				JulyVAL.obj = NewJMethodDecl(makeFormalParamList(JulyDollar[1].objlist),
+					JulyDollar[2].count, JulyDollar[3].namelist, jblk)
+			}
 		}
 	case 176:
-		//line grammar/java11.y:1225
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1516
 		{
-			JulyVAL.obj = NewJInterfaceMethodDecl(makeFormalParamList(JulyS[Julypt-1].objlist),
-				0, nil)
+			if jblk, ok := JulyDollar[3].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[3].obj)
+			} else {
+				JulyVAL.obj = NewJMethodDecl(makeFormalParamList(JulyDollar[1].objlist),
+					JulyDollar[2].count, nil, jblk)
+			}
 		}
 	case 177:
-		//line grammar/java11.y:1233
-		{
-			if jmod, ok := JulyS[Julypt-4].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-4].obj)
-			} else if jtyp, ok := JulyS[Julypt-2].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-2].obj)
-			} else if jifc, ok := JulyS[Julypt-0].obj.(*JInterfaceMethodDecl); !ok {
-				ReportCastError("JInterfaceMethodDecl", JulyS[Julypt-0].obj)
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1525
+		{
+			if jblk, ok := JulyDollar[3].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[3].obj)
+			} else {
+				JulyVAL.obj = NewJMethodDecl(makeFormalParamList(JulyDollar[1].objlist),
+					0, JulyDollar[2].namelist, jblk)
+			}
+		}
+	case 178:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1534
+		{
+			if jblk, ok := JulyDollar[2].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[2].obj)
+			} else {
+				JulyVAL.obj = NewJMethodDecl(makeFormalParamList(JulyDollar[1].objlist),
+					0, nil, jblk)
+			}
+		}
+	case 179:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1546
+		{
+			JulyVAL.obj = NewJInterfaceMethodDecl(makeFormalParamList(JulyDollar[1].objlist),
+				0, JulyDollar[3].namelist)
+		}
+	case 180:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1551
+		{
+			JulyVAL.obj = NewJInterfaceMethodDecl(makeFormalParamList(JulyDollar[1].objlist),
+				0, nil)
+		}
+	case 181:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1556
+		{
+			if jblk, ok := JulyDollar[3].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[3].obj)
+			} else {
+				JulyVAL.obj = NewJMethodDecl(makeFormalParamList(JulyDollar[1].objlist),
+					0, JulyDollar[2].namelist, jblk)
+			}
+		}
+	case 182:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1565
+		{
+			if jblk, ok := JulyDollar[2].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[2].obj)
+			} else {
+				JulyVAL.obj = NewJMethodDecl(makeFormalParamList(JulyDollar[1].objlist),
+					0, nil, jblk)
+			}
+		}
+	case 183:
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:1577
+		{
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
+			} else if jtyp, ok := JulyDollar[3].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[3].obj)
+			} else if jifc, ok := JulyDollar[5].obj.(methodDecl); !ok {
+				ReportCastError("JInterfaceMethodDecl", JulyDollar[5].obj)
 			} else {
 				jifc.SetModifiers(jmod)
-				jifc.SetTypeParameters(JulyS[Julypt-3].objlist)
+				jifc.SetTypeParameters(JulyDollar[2].objlist)
 				jifc.SetType(jtyp)
-				jifc.SetName(JulyS[Julypt-1].str)
+				jifc.SetName(JulyDollar[4].str)
 
 				JulyVAL.obj = jifc
 			}
 		}
-	case 178:
-		//line grammar/java11.y:1250
+	case 184:
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:1594
 		{
-			if jmod, ok := JulyS[Julypt-4].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-4].obj)
-			} else if jifc, ok := JulyS[Julypt-0].obj.(*JInterfaceMethodDecl); !ok {
-				ReportCastError("JInterfaceMethodDecl", JulyS[Julypt-0].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
+			} else if jifc, ok := JulyDollar[5].obj.(methodDecl); !ok {
+				ReportCastError("JInterfaceMethodDecl", JulyDollar[5].obj)
 			} else {
 				jifc.SetModifiers(jmod)
-				jifc.SetTypeParameters(JulyS[Julypt-3].objlist)
+				jifc.SetTypeParameters(JulyDollar[2].objlist)
 				jifc.SetType(NewJReferenceType(NewJTypeName("void", true),
 					nil, 0))
-				jifc.SetName(JulyS[Julypt-1].str)
+				jifc.SetName(JulyDollar[4].str)
 
 				JulyVAL.obj = jifc
 			}
 		}
-	case 179:
-		//line grammar/java11.y:1266
+	case 185:
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:1610
 		{
-			if jmod, ok := JulyS[Julypt-3].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-3].obj)
-			} else if jifc, ok := JulyS[Julypt-0].obj.(*JInterfaceMethodDecl); !ok {
-				ReportCastError("JInterfaceMethodDecl", JulyS[Julypt-0].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
+			} else if jifc, ok := JulyDollar[4].obj.(methodDecl); !ok {
+				ReportCastError("JInterfaceMethodDecl", JulyDollar[4].obj)
 			} else {
 				jifc.SetModifiers(jmod)
 				jifc.SetType(NewJReferenceType(NewJTypeName("void", true),
 					nil, 0))
-				jifc.SetName(JulyS[Julypt-1].str)
+				jifc.SetName(JulyDollar[3].str)
 
 				JulyVAL.obj = jifc
 			}
 		}
-	case 180:
-		//line grammar/java11.y:1284
+	case 186:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1628
 		{
-			JulyVAL.objlist = JulyS[Julypt-1].objlist
+			JulyVAL.obj = JulyDollar[1].obj
 		}
-	case 181:
-		//line grammar/java11.y:1286
+	case 187:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1632
+		{
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
+			} else {
+				jmod.AddModifier(JulyDollar[2].str)
+				JulyVAL.obj = jmod
+			}
+		}
+	case 188:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1644
+		{
+			JulyVAL.objlist = JulyDollar[2].objlist
+		}
+	case 189:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1648
 		{
 			JulyVAL.objlist = nil
 		}
-	case 182:
-		//line grammar/java11.y:1291
+	case 190:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1655
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
-	case 183:
-		//line grammar/java11.y:1296
+	case 191:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1660
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-2].objlist, JulyS[Julypt-0].obj)
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[3].obj)
 		}
-	case 184:
-		//line grammar/java11.y:1301
+	case 192:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1667
 		{
-			if jmod, ok := JulyS[Julypt-1].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-1].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
 			} else {
-				if fprm, ok := JulyS[Julypt-0].obj.(*JFormalParameter); !ok {
-					ReportCastError("JFormalParameter", JulyS[Julypt-0].obj)
+				if fprm, ok := JulyDollar[2].obj.(*JFormalParameter); !ok {
+					ReportCastError("JFormalParameter", JulyDollar[2].obj)
 				} else {
 					fprm.SetModifiers(jmod)
 					JulyVAL.obj = fprm
 				}
 			}
 		}
-	case 185:
-		//line grammar/java11.y:1314
+	case 193:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1680
 		{
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = JulyDollar[1].obj
 		}
-	case 186:
-		//line grammar/java11.y:1319
+	case 194:
+		JulyDollar = JulyS[Julypt-6 : Julypt+1]
+//line grammar/java11.y:1687
 		{
-			if jtyp, ok := JulyS[Julypt-5].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-5].obj)
+			if jtyp, ok := JulyDollar[1].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[1].obj)
 			} else {
-				JulyVAL.obj = NewJFormalParameter(jtyp, true, JulyS[Julypt-1].str, JulyS[Julypt-0].count)
+				JulyVAL.obj = NewJFormalParameter(jtyp, true, JulyDollar[5].str, JulyDollar[6].count)
 			}
 		}
-	case 187:
-		//line grammar/java11.y:1327
+	case 195:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1695
 		{
-			if jtyp, ok := JulyS[Julypt-2].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-2].obj)
+			if jtyp, ok := JulyDollar[1].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[1].obj)
 			} else {
-				JulyVAL.obj = NewJFormalParameter(jtyp, false, JulyS[Julypt-1].str, JulyS[Julypt-0].count)
+				JulyVAL.obj = NewJFormalParameter(jtyp, false, JulyDollar[2].str, JulyDollar[3].count)
 			}
 		}
-	case 188:
-		//line grammar/java11.y:1335
+	case 196:
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:1703
 		{
-			if jtyp, ok := JulyS[Julypt-4].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-4].obj)
+			if jtyp, ok := JulyDollar[1].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[1].obj)
 			} else {
-				JulyVAL.obj = NewJFormalParameter(jtyp, true, JulyS[Julypt-0].str, 0)
+				JulyVAL.obj = NewJFormalParameter(jtyp, true, JulyDollar[5].str, 0)
 			}
 		}
-	case 189:
-		//line grammar/java11.y:1343
+	case 197:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1711
 		{
-			if jtyp, ok := JulyS[Julypt-1].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-1].obj)
+			if jtyp, ok := JulyDollar[1].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[1].obj)
 			} else {
-				JulyVAL.obj = NewJFormalParameter(jtyp, false, JulyS[Julypt-0].str, 0)
+				JulyVAL.obj = NewJFormalParameter(jtyp, false, JulyDollar[2].str, 0)
 			}
 		}
-	case 190:
-		//line grammar/java11.y:1354
+	case 198:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1722
 		{
-			JulyVAL.obj = NewJModifiers(JulyS[Julypt-0].str, nil)
+			JulyVAL.obj = NewJModifiers(JulyDollar[1].str, nil)
 		}
-	case 191:
-		//line grammar/java11.y:1356
+	case 199:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1726
 		{
-			if jann, ok := JulyS[Julypt-0].obj.(*JAnnotation); !ok {
-				ReportCastError("JAnnotation", JulyS[Julypt-0].obj)
+			if jann, ok := JulyDollar[1].obj.(*JAnnotation); !ok {
+				ReportCastError("JAnnotation", JulyDollar[1].obj)
 			} else {
 				jmod := NewJModifiers("", jann)
 				JulyVAL.obj = jmod
 			}
 		}
-	case 192:
-		//line grammar/java11.y:1365
+	case 200:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1735
 		{
-			if jmod, ok := JulyS[Julypt-1].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-1].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
 			} else {
-				jmod.AddModifier(JulyS[Julypt-0].str)
+				jmod.AddModifier(JulyDollar[2].str)
 				JulyVAL.obj = jmod
 			}
 		}
-	case 193:
-		//line grammar/java11.y:1374
+	case 201:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1744
 		{
-			if jmod, ok := JulyS[Julypt-1].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-1].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
 			} else {
-				if jann, ok := JulyS[Julypt-0].obj.(*JAnnotation); !ok {
-					ReportCastError("JAnnotation", JulyS[Julypt-0].obj)
+				if jann, ok := JulyDollar[2].obj.(*JAnnotation); !ok {
+					ReportCastError("JAnnotation", JulyDollar[2].obj)
 				} else {
 					jmod.AddAnnotation(jann)
 					JulyVAL.obj = jmod
 				}
 			}
 		}
-	case 194:
-		//line grammar/java11.y:1390
+	case 202:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1760
 		{
-			JulyVAL.obj = &tmpVariableId{name: JulyS[Julypt-1].str, dims: JulyS[Julypt-0].count}
+			JulyVAL.obj = &tmpVariableId{name: JulyDollar[1].str, dims: JulyDollar[2].count}
 		}
-	case 195:
-		//line grammar/java11.y:1392
+	case 203:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1764
 		{
-			JulyVAL.obj = &tmpVariableId{name: JulyS[Julypt-0].str, dims: 0}
+			JulyVAL.obj = &tmpVariableId{name: JulyDollar[1].str, dims: 0}
 		}
-	case 196:
-		//line grammar/java11.y:1397
+	case 204:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1771
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
-	case 197:
-		//line grammar/java11.y:1402
+	case 205:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1776
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-2].objlist, JulyS[Julypt-0].obj)
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[3].obj)
 		}
-	case 198:
-		//line grammar/java11.y:1407
+	case 206:
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:1783
 		{
-			if init, ok := JulyS[Julypt-0].obj.(*JVariableInit); !ok {
-				ReportCastError("JVariableInit", JulyS[Julypt-0].obj)
+			if init, ok := JulyDollar[4].obj.(*JVariableInit); !ok {
+				ReportCastError("JVariableInit", JulyDollar[4].obj)
 			} else {
-				JulyVAL.obj = NewJVariableDecl(JulyS[Julypt-3].str, JulyS[Julypt-2].count, init)
+				JulyVAL.obj = NewJVariableDecl(JulyDollar[1].str, JulyDollar[2].count, init)
 			}
 		}
-	case 199:
-		//line grammar/java11.y:1415
+	case 207:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1791
 		{
-			JulyVAL.obj = NewJVariableDecl(JulyS[Julypt-1].str, JulyS[Julypt-0].count, nil)
+			JulyVAL.obj = NewJVariableDecl(JulyDollar[1].str, JulyDollar[2].count, nil)
 		}
-	case 200:
-		//line grammar/java11.y:1417
+	case 208:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1795
 		{
-			if init, ok := JulyS[Julypt-0].obj.(*JVariableInit); !ok {
-				ReportCastError("JVariableInit", JulyS[Julypt-0].obj)
+			if init, ok := JulyDollar[3].obj.(*JVariableInit); !ok {
+				ReportCastError("JVariableInit", JulyDollar[3].obj)
 			} else {
-				JulyVAL.obj = NewJVariableDecl(JulyS[Julypt-2].str, 0, init)
+				JulyVAL.obj = NewJVariableDecl(JulyDollar[1].str, 0, init)
 			}
 		}
-	case 201:
-		//line grammar/java11.y:1425
+	case 209:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1803
 		{
-			JulyVAL.obj = NewJVariableDecl(JulyS[Julypt-0].str, 0, nil)
+			JulyVAL.obj = NewJVariableDecl(JulyDollar[1].str, 0, nil)
 		}
-	case 202:
-		//line grammar/java11.y:1430
+	case 210:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1810
 		{
-			JulyVAL.obj = NewJVariableInit(nil, JulyS[Julypt-0].varlist)
+			JulyVAL.obj = NewJVariableInit(nil, JulyDollar[1].varlist)
 		}
-	case 203:
-		//line grammar/java11.y:1434
+	case 211:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1814
 		{
-			JulyVAL.obj = NewJVariableInit(JulyS[Julypt-0].obj, nil)
+			JulyVAL.obj = NewJVariableInit(JulyDollar[1].obj, nil)
 		}
-	case 204:
-		//line grammar/java11.y:1441
+	case 212:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1821
 		{
-			if init, ok := JulyS[Julypt-0].obj.(*JVariableInit); !ok {
-				ReportCastError("JVariableInit", JulyS[Julypt-0].obj)
+			if init, ok := JulyDollar[1].obj.(*JVariableInit); !ok {
+				ReportCastError("JVariableInit", JulyDollar[1].obj)
 			} else {
 				JulyVAL.varlist = make([]*JVariableInit, 1)
 				JulyVAL.varlist[0] = init
 			}
 		}
-	case 205:
-		//line grammar/java11.y:1450
+	case 213:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1830
 		{
-			if init, ok := JulyS[Julypt-0].obj.(*JVariableInit); !ok {
-				ReportCastError("JVariableInit", JulyS[Julypt-0].obj)
+			if init, ok := JulyDollar[3].obj.(*JVariableInit); !ok {
+				ReportCastError("JVariableInit", JulyDollar[3].obj)
 			} else {
-				JulyVAL.varlist = append(JulyS[Julypt-2].varlist, init)
+				JulyVAL.varlist = append(JulyDollar[1].varlist, init)
 			}
 		}
-	case 206:
-		//line grammar/java11.y:1461
+	case 214:
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:1841
 		{
-			JulyVAL.varlist = JulyS[Julypt-2].varlist
+			JulyVAL.varlist = JulyDollar[2].varlist
 		}
-	case 207:
-		//line grammar/java11.y:1463
+	case 215:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1845
 		{
-			JulyVAL.varlist = JulyS[Julypt-1].varlist
+			JulyVAL.varlist = JulyDollar[2].varlist
 		}
-	case 208:
-		//line grammar/java11.y:1465
+	case 216:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1849
 		{
 			JulyVAL.varlist = make([]*JVariableInit, 0)
 		}
-	case 209:
-		//line grammar/java11.y:1470
+	case 217:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1856
 		{
-			JulyVAL.obj = NewJBlock(JulyS[Julypt-1].objlist)
+			JulyVAL.obj = NewJBlock(JulyDollar[2].objlist)
 		}
-	case 210:
-		//line grammar/java11.y:1475
+	case 218:
+		JulyDollar = JulyS[Julypt-0 : Julypt+1]
+//line grammar/java11.y:1863
 		{
 			JulyVAL.objlist = nil
 		}
-	case 211:
-		//line grammar/java11.y:1477
+	case 219:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1867
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
-	case 212:
-		//line grammar/java11.y:1482
+	case 220:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1872
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-1].objlist, JulyS[Julypt-0].obj)
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[2].obj)
 		}
-	case 213:
-		//line grammar/java11.y:1487
+	case 221:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1879
 		{
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = JulyDollar[1].obj
 		}
-	case 214:
-		//line grammar/java11.y:1489
+	case 222:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1883
 		{
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = JulyDollar[1].obj
 		}
-	case 215:
-		//line grammar/java11.y:1491
+	case 223:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1887
 		{
-			if JulyS[Julypt-0].obj == nil {
+			if JulyDollar[1].obj == nil {
 				panic("Found nil block statement")
 			}
 
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = JulyDollar[1].obj
 		}
-	case 216:
-		//line grammar/java11.y:1502
+	case 224:
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:1898
 		{
-			if jmod, ok := JulyS[Julypt-3].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-3].obj)
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
 			} else {
-				if jtyp, ok := JulyS[Julypt-2].obj.(*JReferenceType); !ok {
-					ReportCastError("JReferenceType", JulyS[Julypt-2].obj)
+				if jtyp, ok := JulyDollar[2].obj.(*JReferenceType); !ok {
+					ReportCastError("JReferenceType", JulyDollar[2].obj)
 				} else {
 					JulyVAL.obj = NewJLocalVariableDecl(jmod, jtyp,
-						makeVarDeclList(JulyS[Julypt-1].objlist))
+						makeVarDeclList(JulyDollar[3].objlist))
 				}
 			}
 		}
-	case 217:
-		//line grammar/java11.y:1515
+	case 225:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1911
 		{
-			if jtyp, ok := JulyS[Julypt-2].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-2].obj)
+			if jtyp, ok := JulyDollar[1].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[1].obj)
 			} else {
 				JulyVAL.obj = NewJLocalVariableDecl(nil, jtyp,
-					makeVarDeclList(JulyS[Julypt-1].objlist))
+					makeVarDeclList(JulyDollar[2].objlist))
 			}
 		}
-	case 218:
-		//line grammar/java11.y:1527
+	case 226:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1923
 		{
-			if jblk, ok := JulyS[Julypt-0].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-0].obj)
+			if jblk, ok := JulyDollar[1].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[1].obj)
 			} else {
 				JulyVAL.obj = jblk
 			}
 		}
-	case 219:
-		//line grammar/java11.y:1535
-		{
-			JulyVAL.obj = NewJEmpty()
-		}
-	case 220:
-		//line grammar/java11.y:1537
-		{
-			JulyVAL.obj = NewJLabeledStatement(JulyS[Julypt-2].str, JulyS[Julypt-0].obj)
-		}
-	case 221:
-		//line grammar/java11.y:1539
-		{
-			JulyVAL.obj = NewJSimpleStatement(nil, JulyS[Julypt-1].obj)
-		}
-	case 222:
-		//line grammar/java11.y:1543
-		{
-			JulyVAL.obj = NewJIfElseStmt(JulyS[Julypt-4].obj, JulyS[Julypt-2].obj, JulyS[Julypt-0].obj)
-		}
-	case 223:
-		//line grammar/java11.y:1547
-		{
-			JulyVAL.obj = NewJIfElseStmt(JulyS[Julypt-2].obj, JulyS[Julypt-0].obj, nil)
-		}
-	case 224:
-		//line grammar/java11.y:1551
-		{
-			JulyVAL.obj = NewJUnimplemented("Statement#6")
-		}
-	case 225:
-		//line grammar/java11.y:1553
-		{
-			JulyVAL.obj = NewJUnimplemented("Statement#7")
-		}
-	case 226:
-		//line grammar/java11.y:1555
-		{
-			JulyVAL.obj = NewJSwitch(JulyS[Julypt-4].obj, JulyS[Julypt-1].objlist)
-		}
 	case 227:
-		//line grammar/java11.y:1557
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:1931
 		{
-			JulyVAL.obj = NewJSwitch(JulyS[Julypt-3].obj, nil)
+			JulyVAL.obj = NewJEmpty()
 		}
 	case 228:
-		//line grammar/java11.y:1559
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1935
 		{
-			JulyVAL.obj = NewJWhile(JulyS[Julypt-2].obj, JulyS[Julypt-0].obj, false)
+			JulyVAL.obj = NewJLabeledStatement(JulyDollar[1].str, JulyDollar[3].obj)
 		}
 	case 229:
-		//line grammar/java11.y:1563
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1939
 		{
-			JulyVAL.obj = NewJWhile(JulyS[Julypt-2].obj, JulyS[Julypt-5].obj, true)
+			JulyVAL.obj = NewJSimpleStatement(nil, JulyDollar[1].obj)
 		}
 	case 230:
-		//line grammar/java11.y:1567
+		JulyDollar = JulyS[Julypt-7 : Julypt+1]
+//line grammar/java11.y:1943
 		{
-			if jfor, ok := JulyS[Julypt-2].obj.(*JForColon); ok {
-				jfor.SetBody(JulyS[Julypt-0].obj)
-				JulyVAL.obj = jfor
-			} else if jforexp, ok := JulyS[Julypt-2].obj.(*JForExpr); ok {
-				jforexp.SetBody(JulyS[Julypt-0].obj)
-				JulyVAL.obj = jforexp
-			} else if jforvar, ok := JulyS[Julypt-2].obj.(*JForVar); ok {
-				jforvar.SetBody(JulyS[Julypt-0].obj)
-				JulyVAL.obj = jforvar
-			} else {
-				ReportCastError("JForVar", JulyS[Julypt-2].obj)
-			}
+			JulyVAL.obj = NewJIfElseStmt(JulyDollar[3].obj, JulyDollar[5].obj, JulyDollar[7].obj)
 		}
 	case 231:
-		//line grammar/java11.y:1582
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:1947
 		{
-			JulyVAL.obj = NewJJumpToLabel(JulyS[Julypt-2].token, JulyS[Julypt-1].str)
+			JulyVAL.obj = NewJIfElseStmt(JulyDollar[3].obj, JulyDollar[5].obj, nil)
 		}
 	case 232:
-		//line grammar/java11.y:1584
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:1951
 		{
-			JulyVAL.obj = NewJSimpleStatement(NewJKeyword(JulyS[Julypt-1].token, JulyS[Julypt-1].str), nil)
+			JulyVAL.obj = NewJUnimplemented("Statement#6")
 		}
 	case 233:
-		//line grammar/java11.y:1586
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1955
 		{
-			JulyVAL.obj = NewJJumpToLabel(JulyS[Julypt-2].token, JulyS[Julypt-1].str)
+			JulyVAL.obj = NewJUnimplemented("Statement#7")
 		}
 	case 234:
-		//line grammar/java11.y:1588
+		JulyDollar = JulyS[Julypt-7 : Julypt+1]
+//line grammar/java11.y:1959
 		{
-			JulyVAL.obj = NewJSimpleStatement(NewJKeyword(JulyS[Julypt-1].token, JulyS[Julypt-1].str), nil)
+			JulyVAL.obj = NewJSwitch(JulyDollar[3].obj, JulyDollar[6].objlist)
 		}
 	case 235:
-		//line grammar/java11.y:1590
+		JulyDollar = JulyS[Julypt-6 : Julypt+1]
+//line grammar/java11.y:1963
 		{
-			JulyVAL.obj = NewJSimpleStatement(NewJKeyword(JulyS[Julypt-2].token, JulyS[Julypt-2].str), JulyS[Julypt-1].obj)
+			JulyVAL.obj = NewJSwitch(JulyDollar[3].obj, nil)
 		}
 	case 236:
-		//line grammar/java11.y:1592
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:1967
 		{
-			JulyVAL.obj = NewJSimpleStatement(NewJKeyword(JulyS[Julypt-1].token, JulyS[Julypt-1].str), nil)
+			JulyVAL.obj = NewJWhile(JulyDollar[3].obj, JulyDollar[5].obj, false)
 		}
 	case 237:
-		//line grammar/java11.y:1594
+		JulyDollar = JulyS[Julypt-7 : Julypt+1]
+//line grammar/java11.y:1971
 		{
-			JulyVAL.obj = NewJSimpleStatement(NewJKeyword(JulyS[Julypt-2].token, JulyS[Julypt-2].str), JulyS[Julypt-1].obj)
+			JulyVAL.obj = NewJWhile(JulyDollar[5].obj, JulyDollar[2].obj, true)
 		}
 	case 238:
-		//line grammar/java11.y:1596
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:1975
 		{
-			if jblk, ok := JulyS[Julypt-0].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-0].obj)
+			if jfor, ok := JulyDollar[3].obj.(*JForColon); ok {
+				jfor.SetBody(JulyDollar[5].obj)
+				JulyVAL.obj = jfor
+			} else if jforexp, ok := JulyDollar[3].obj.(*JForExpr); ok {
+				jforexp.SetBody(JulyDollar[5].obj)
+				JulyVAL.obj = jforexp
+			} else if jforvar, ok := JulyDollar[3].obj.(*JForVar); ok {
+				jforvar.SetBody(JulyDollar[5].obj)
+				JulyVAL.obj = jforvar
 			} else {
-				JulyVAL.obj = NewJSynchronized(JulyS[Julypt-2].obj, jblk)
+				ReportCastError("JForVar", JulyDollar[3].obj)
 			}
 		}
 	case 239:
-		//line grammar/java11.y:1604
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1990
 		{
-			if jblk, ok := JulyS[Julypt-1].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-1].obj)
-			} else {
-				JulyVAL.obj = NewJTry(jblk, JulyS[Julypt-0].objlist, nil)
-			}
+			JulyVAL.obj = NewJJumpToLabel(JulyDollar[1].token, JulyDollar[2].str)
 		}
 	case 240:
-		//line grammar/java11.y:1612
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:1994
 		{
-			if jblk, ok := JulyS[Julypt-2].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-2].obj)
-			} else if jfin, ok := JulyS[Julypt-0].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-0].obj)
-			} else {
-				JulyVAL.obj = NewJTry(jblk, JulyS[Julypt-1].objlist, jfin)
-			}
+			JulyVAL.obj = NewJSimpleStatement(NewJKeyword(JulyDollar[1].token, JulyDollar[1].str), nil)
 		}
 	case 241:
-		//line grammar/java11.y:1622
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:1998
 		{
-			if jblk, ok := JulyS[Julypt-1].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-1].obj)
-			} else if jfin, ok := JulyS[Julypt-0].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-0].obj)
-			} else {
-				JulyVAL.obj = NewJTry(jblk, nil, jfin)
-			}
+			JulyVAL.obj = NewJJumpToLabel(JulyDollar[1].token, JulyDollar[2].str)
 		}
 	case 242:
-		//line grammar/java11.y:1632
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:2002
 		{
-			JulyVAL.obj = NewJUnimplemented("Statement#24")
+			JulyVAL.obj = NewJSimpleStatement(NewJKeyword(JulyDollar[1].token, JulyDollar[1].str), nil)
 		}
 	case 243:
-		//line grammar/java11.y:1634
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2006
 		{
-			JulyVAL.obj = NewJUnimplemented("Statement#25")
+			JulyVAL.obj = NewJSimpleStatement(NewJKeyword(JulyDollar[1].token, JulyDollar[1].str), JulyDollar[2].obj)
 		}
 	case 244:
-		//line grammar/java11.y:1636
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:2010
 		{
-			JulyVAL.obj = NewJUnimplemented("Statement#26")
+			JulyVAL.obj = NewJSimpleStatement(NewJKeyword(JulyDollar[1].token, JulyDollar[1].str), nil)
 		}
 	case 245:
-		//line grammar/java11.y:1638
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2014
 		{
-			JulyVAL.obj = NewJUnimplemented("Statement#27")
+			JulyVAL.obj = NewJSimpleStatement(NewJKeyword(JulyDollar[1].token, JulyDollar[1].str), JulyDollar[2].obj)
 		}
 	case 246:
-		//line grammar/java11.y:1643
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:2018
 		{
-			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			if jblk, ok := JulyDollar[5].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[5].obj)
+			} else {
+				JulyVAL.obj = NewJSynchronized(JulyDollar[3].obj, jblk)
+			}
 		}
 	case 247:
-		//line grammar/java11.y:1648
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2026
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-1].objlist, JulyS[Julypt-0].obj)
+			if jblk, ok := JulyDollar[2].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[2].obj)
+			} else {
+				JulyVAL.obj = NewJTry(jblk, JulyDollar[3].objlist, nil)
+			}
 		}
 	case 248:
-		//line grammar/java11.y:1653
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:2034
 		{
-			if jmod, ok := JulyS[Julypt-4].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-4].obj)
+			if jblk, ok := JulyDollar[2].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[2].obj)
+			} else if jfin, ok := JulyDollar[4].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[4].obj)
 			} else {
-				if jblk, ok := JulyS[Julypt-0].obj.(*JBlock); !ok {
-					ReportCastError("JBlock", JulyS[Julypt-0].obj)
-				} else {
-					JulyVAL.obj = NewJCatch(jmod, JulyS[Julypt-3].namelist, JulyS[Julypt-2].str, jblk)
-				}
+				JulyVAL.obj = NewJTry(jblk, JulyDollar[3].objlist, jfin)
 			}
 		}
 	case 249:
-		//line grammar/java11.y:1665
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2044
 		{
-			if jblk, ok := JulyS[Julypt-0].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-0].obj)
+			if jblk, ok := JulyDollar[2].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[2].obj)
+			} else if jfin, ok := JulyDollar[3].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[3].obj)
 			} else {
-				JulyVAL.obj = NewJCatch(nil, JulyS[Julypt-3].namelist, JulyS[Julypt-2].str, jblk)
+				JulyVAL.obj = NewJTry(jblk, nil, jfin)
 			}
 		}
 	case 250:
-		//line grammar/java11.y:1676
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:2054
 		{
-			JulyVAL.namelist = make([]*JTypeName, 1)
-			JulyVAL.namelist[0] = JulyS[Julypt-0].name
+			if jblk, ok := JulyDollar[3].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[3].obj)
+			} else if jfin, ok := JulyDollar[5].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[5].obj)
+			} else {
+				JulyVAL.obj = NewJTryWithResources(JulyDollar[2].objlist, jblk,
+					JulyDollar[4].objlist, jfin)
+			}
 		}
 	case 251:
-		//line grammar/java11.y:1681
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:2065
 		{
-			JulyVAL.namelist = append(JulyS[Julypt-2].namelist, JulyS[Julypt-0].name)
+			if jblk, ok := JulyDollar[3].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[3].obj)
+			} else {
+				JulyVAL.obj = NewJTryWithResources(JulyDollar[2].objlist, jblk,
+					JulyDollar[4].objlist, nil)
+			}
 		}
 	case 252:
-		//line grammar/java11.y:1686
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:2074
 		{
-			if jblk, ok := JulyS[Julypt-0].obj.(*JBlock); !ok {
-				ReportCastError("JBlock", JulyS[Julypt-0].obj)
+			if jblk, ok := JulyDollar[3].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[3].obj)
+			} else if jfin, ok := JulyDollar[4].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[4].obj)
 			} else {
-				JulyVAL.obj = jblk
+				JulyVAL.obj = NewJTryWithResources(JulyDollar[2].objlist, jblk,
+					nil, jfin)
 			}
 		}
 	case 253:
-		//line grammar/java11.y:1697
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2085
 		{
-			JulyVAL.obj = NewJUnimplemented("ResourceSpecification#0")
+			if jblk, ok := JulyDollar[3].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[3].obj)
+			} else {
+				JulyVAL.obj = NewJTryWithResources(JulyDollar[2].objlist, jblk,
+					nil, nil)
+			}
 		}
 	case 254:
-		//line grammar/java11.y:1699
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2097
 		{
-			JulyVAL.obj = NewJUnimplemented("ResourceSpecification#1")
+			JulyVAL.objlist = make([]JObject, 1)
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 255:
-		//line grammar/java11.y:1704
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:2102
 		{
-			JulyVAL.obj = NewJUnimplemented("Resources#0")
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[2].obj)
 		}
 	case 256:
-		//line grammar/java11.y:1706
+		JulyDollar = JulyS[Julypt-7 : Julypt+1]
+//line grammar/java11.y:2109
 		{
-			JulyVAL.obj = NewJUnimplemented("Resources#1")
+			if jmod, ok := JulyDollar[3].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[3].obj)
+			} else {
+				if jblk, ok := JulyDollar[7].obj.(*JBlock); !ok {
+					ReportCastError("JBlock", JulyDollar[7].obj)
+				} else {
+					JulyVAL.obj = NewJCatch(jmod, JulyDollar[4].namelist, JulyDollar[5].str, jblk)
+				}
+			}
 		}
 	case 257:
-		//line grammar/java11.y:1711
+		JulyDollar = JulyS[Julypt-6 : Julypt+1]
+//line grammar/java11.y:2121
 		{
-			JulyVAL.obj = NewJUnimplemented("Resource#0")
+			if jblk, ok := JulyDollar[6].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[6].obj)
+			} else {
+				JulyVAL.obj = NewJCatch(nil, JulyDollar[3].namelist, JulyDollar[4].str, jblk)
+			}
 		}
 	case 258:
-		//line grammar/java11.y:1713
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2132
 		{
-			JulyVAL.obj = NewJUnimplemented("Resource#1")
+			JulyVAL.namelist = make([]*JTypeName, 1)
+			JulyVAL.namelist[0] = JulyDollar[1].name
 		}
 	case 259:
-		//line grammar/java11.y:1718
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2137
 		{
-			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.namelist = append(JulyDollar[1].namelist, JulyDollar[3].name)
 		}
 	case 260:
-		//line grammar/java11.y:1723
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:2144
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-1].objlist, JulyS[Julypt-0].obj)
+			if jblk, ok := JulyDollar[2].obj.(*JBlock); !ok {
+				ReportCastError("JBlock", JulyDollar[2].obj)
+			} else {
+				JulyVAL.obj = jblk
+			}
 		}
 	case 261:
-		//line grammar/java11.y:1728
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:2155
 		{
-			JulyVAL.obj = NewJSwitchGroup(JulyS[Julypt-1].objlist, JulyS[Julypt-0].objlist)
+			JulyVAL.objlist = JulyDollar[2].objlist
 		}
 	case 262:
-		//line grammar/java11.y:1733
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2159
 		{
-			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist = JulyDollar[2].objlist
 		}
 	case 263:
-		//line grammar/java11.y:1738
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2166
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-1].objlist, JulyS[Julypt-0].obj)
+			JulyVAL.objlist = make([]JObject, 1)
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 264:
-		//line grammar/java11.y:1743
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2171
 		{
-			JulyVAL.obj = NewJSwitchLabel("", JulyS[Julypt-1].obj, false)
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[3].obj)
 		}
 	case 265:
-		//line grammar/java11.y:1745
-		{
-			JulyVAL.obj = NewJSwitchLabel(JulyS[Julypt-2].str, nil, false)
-		}
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:2178
+		{
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
+			} else if jtyp, ok := JulyDollar[2].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[2].obj)
+			} else if jvid, ok := JulyDollar[3].obj.(*tmpVariableId); !ok {
+				ReportCastError("tmpVariableId", JulyDollar[3].obj)
+			} else {
+				JulyVAL.obj = NewJLocalVariableDecl(jmod, jtyp,
+					[]*JVariableDecl{NewJVariableDecl(jvid.name, jvid.dims,
+						NewJVariableInit(JulyDollar[5].obj, nil))})
+			}
+		}
 	case 266:
-		//line grammar/java11.y:1747
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:2192
 		{
-			JulyVAL.obj = NewJSwitchLabel("", nil, true)
+			if jtyp, ok := JulyDollar[1].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[1].obj)
+			} else if jvid, ok := JulyDollar[2].obj.(*tmpVariableId); !ok {
+				ReportCastError("tmpVariableId", JulyDollar[2].obj)
+			} else {
+				JulyVAL.obj = NewJLocalVariableDecl(nil, jtyp,
+					[]*JVariableDecl{NewJVariableDecl(jvid.name, jvid.dims,
+						NewJVariableInit(JulyDollar[4].obj, nil))})
+			}
 		}
 	case 267:
-		//line grammar/java11.y:1752
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2207
 		{
-			JulyVAL.namelist = make([]*JTypeName, 1)
-			JulyVAL.namelist[0] = JulyS[Julypt-0].name
+			JulyVAL.objlist = make([]JObject, 1)
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 268:
-		//line grammar/java11.y:1757
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:2212
 		{
-			JulyVAL.namelist = append(JulyS[Julypt-2].namelist, JulyS[Julypt-0].name)
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[2].obj)
 		}
 	case 269:
-		//line grammar/java11.y:1762
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:2219
 		{
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = NewJSwitchGroup(JulyDollar[1].objlist, JulyDollar[2].objlist)
 		}
 	case 270:
-		//line grammar/java11.y:1764
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2226
 		{
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.objlist = make([]JObject, 1)
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 271:
-		//line grammar/java11.y:1766
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:2231
 		{
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[2].obj)
 		}
 	case 272:
-		//line grammar/java11.y:1768
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2238
 		{
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = NewJSwitchLabel("", JulyDollar[2].obj, false)
 		}
 	case 273:
-		//line grammar/java11.y:1773
-		{
-			if jmod, ok := JulyS[Julypt-4].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-4].obj)
-			} else if jtyp, ok := JulyS[Julypt-3].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-3].obj)
-			} else if jvid, ok := JulyS[Julypt-2].obj.(*tmpVariableId); !ok {
-				ReportCastError("tmpVariableId", JulyS[Julypt-2].obj)
-			} else {
-				JulyVAL.obj = NewJForColon(jmod, jtyp, jvid.name, jvid.dims, JulyS[Julypt-0].obj)
-			}
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2242
+		{
+			JulyVAL.obj = NewJSwitchLabel(JulyDollar[1].str, nil, false)
 		}
 	case 274:
-		//line grammar/java11.y:1785
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:2246
 		{
-			if jtyp, ok := JulyS[Julypt-3].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-3].obj)
-			} else if jvid, ok := JulyS[Julypt-2].obj.(*tmpVariableId); !ok {
-				ReportCastError("tmpVariableId", JulyS[Julypt-2].obj)
-			} else {
-				JulyVAL.obj = NewJForColon(nil, jtyp, jvid.name, jvid.dims, JulyS[Julypt-0].obj)
-			}
+			JulyVAL.obj = NewJSwitchLabel("", nil, true)
 		}
 	case 275:
-		//line grammar/java11.y:1798
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2253
 		{
-			JulyVAL.obj = NewJForExpr(nil, JulyS[Julypt-2].obj, JulyS[Julypt-0].objlist)
+			JulyVAL.namelist = make([]*JTypeName, 1)
+			JulyVAL.namelist[0] = JulyDollar[1].name
 		}
 	case 276:
-		//line grammar/java11.y:1802
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2258
 		{
-			JulyVAL.obj = NewJForExpr(nil, JulyS[Julypt-1].obj, nil)
+			JulyVAL.namelist = append(JulyDollar[1].namelist, JulyDollar[3].name)
 		}
 	case 277:
-		//line grammar/java11.y:1806
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2265
 		{
-			JulyVAL.obj = NewJForExpr(nil, nil, JulyS[Julypt-0].objlist)
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 278:
-		//line grammar/java11.y:1810
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2269
 		{
-			JulyVAL.obj = NewJForExpr(nil, nil, nil)
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 279:
-		//line grammar/java11.y:1817
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2273
 		{
-			JulyVAL.obj = NewJForExpr(JulyS[Julypt-4].objlist, JulyS[Julypt-2].obj, JulyS[Julypt-0].objlist)
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 280:
-		//line grammar/java11.y:1821
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2277
 		{
-			JulyVAL.obj = NewJForExpr(JulyS[Julypt-3].objlist, JulyS[Julypt-1].obj, nil)
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 281:
-		//line grammar/java11.y:1825
-		{
-			JulyVAL.obj = NewJForExpr(JulyS[Julypt-3].objlist, nil, JulyS[Julypt-0].objlist)
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:2284
+		{
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
+			} else if jtyp, ok := JulyDollar[2].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[2].obj)
+			} else if jvid, ok := JulyDollar[3].obj.(*tmpVariableId); !ok {
+				ReportCastError("tmpVariableId", JulyDollar[3].obj)
+			} else {
+				JulyVAL.obj = NewJForColon(jmod, jtyp, jvid.name, jvid.dims, JulyDollar[5].obj)
+			}
 		}
 	case 282:
-		//line grammar/java11.y:1829
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:2296
 		{
-			JulyVAL.obj = NewJForExpr(JulyS[Julypt-2].objlist, nil, nil)
+			if jtyp, ok := JulyDollar[1].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[1].obj)
+			} else if jvid, ok := JulyDollar[2].obj.(*tmpVariableId); !ok {
+				ReportCastError("tmpVariableId", JulyDollar[2].obj)
+			} else {
+				JulyVAL.obj = NewJForColon(nil, jtyp, jvid.name, jvid.dims, JulyDollar[4].obj)
+			}
 		}
 	case 283:
-		//line grammar/java11.y:1836
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:2309
+		{
+			JulyVAL.obj = NewJForExpr(nil, JulyDollar[2].obj, JulyDollar[4].objlist)
+		}
+	case 284:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2313
+		{
+			JulyVAL.obj = NewJForExpr(nil, JulyDollar[2].obj, nil)
+		}
+	case 285:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2317
+		{
+			JulyVAL.obj = NewJForExpr(nil, nil, JulyDollar[3].objlist)
+		}
+	case 286:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:2321
+		{
+			JulyVAL.obj = NewJForExpr(nil, nil, nil)
+		}
+	case 287:
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:2328
 		{
-			if jfor, ok := JulyS[Julypt-4].obj.(*JForVar); !ok {
-				ReportCastError("JForVar", JulyS[Julypt-4].obj)
+			JulyVAL.obj = NewJForExpr(JulyDollar[1].objlist, JulyDollar[3].obj, JulyDollar[5].objlist)
+		}
+	case 288:
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:2332
+		{
+			JulyVAL.obj = NewJForExpr(JulyDollar[1].objlist, JulyDollar[3].obj, nil)
+		}
+	case 289:
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:2336
+		{
+			JulyVAL.obj = NewJForExpr(JulyDollar[1].objlist, nil, JulyDollar[4].objlist)
+		}
+	case 290:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2340
+		{
+			JulyVAL.obj = NewJForExpr(JulyDollar[1].objlist, nil, nil)
+		}
+	case 291:
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:2347
+		{
+			if jfor, ok := JulyDollar[1].obj.(*JForVar); !ok {
+				ReportCastError("JForVar", JulyDollar[1].obj)
 			} else {
-				if JulyS[Julypt-2].obj != nil {
-					jfor.SetExpr(JulyS[Julypt-2].obj)
+				if JulyDollar[3].obj != nil {
+					jfor.SetExpr(JulyDollar[3].obj)
 				}
 
-				if JulyS[Julypt-0].objlist != nil {
-					jfor.SetIncr(JulyS[Julypt-0].objlist)
+				if JulyDollar[5].objlist != nil {
+					jfor.SetIncr(JulyDollar[5].objlist)
 				}
 
 				JulyVAL.obj = jfor
 			}
 		}
-	case 284:
-		//line grammar/java11.y:1852
+	case 292:
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:2363
 		{
-			if jfor, ok := JulyS[Julypt-3].obj.(*JForVar); !ok {
-				ReportCastError("JForVar", JulyS[Julypt-3].obj)
+			if jfor, ok := JulyDollar[1].obj.(*JForVar); !ok {
+				ReportCastError("JForVar", JulyDollar[1].obj)
 			} else {
-				if JulyS[Julypt-1].obj != nil {
-					jfor.SetExpr(JulyS[Julypt-1].obj)
+				if JulyDollar[3].obj != nil {
+					jfor.SetExpr(JulyDollar[3].obj)
 				}
 
 				JulyVAL.obj = jfor
 			}
 		}
-	case 285:
-		//line grammar/java11.y:1864
+	case 293:
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:2375
 		{
-			if jfor, ok := JulyS[Julypt-3].obj.(*JForVar); !ok {
-				ReportCastError("JForVar", JulyS[Julypt-3].obj)
+			if jfor, ok := JulyDollar[1].obj.(*JForVar); !ok {
+				ReportCastError("JForVar", JulyDollar[1].obj)
 			} else {
-				if JulyS[Julypt-0].objlist != nil {
-					jfor.SetIncr(JulyS[Julypt-0].objlist)
+				if JulyDollar[4].objlist != nil {
+					jfor.SetIncr(JulyDollar[4].objlist)
 				}
 
 				JulyVAL.obj = jfor
 			}
 		}
-	case 286:
-		//line grammar/java11.y:1876
+	case 294:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2387
 		{
-			if jfor, ok := JulyS[Julypt-2].obj.(*JForVar); !ok {
-				ReportCastError("JForVar", JulyS[Julypt-2].obj)
+			if jfor, ok := JulyDollar[1].obj.(*JForVar); !ok {
+				ReportCastError("JForVar", JulyDollar[1].obj)
 			} else {
 				JulyVAL.obj = jfor
 			}
 		}
-	case 287:
-		//line grammar/java11.y:1887
+	case 295:
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:2398
 		{
-			if jfor, ok := JulyS[Julypt-4].obj.(*JForVar); !ok {
-				ReportCastError("JForVar", JulyS[Julypt-4].obj)
-			} else if init, ok := JulyS[Julypt-2].obj.(*JVariableInit); !ok {
-				ReportCastError("JVariableInit", JulyS[Julypt-3].obj)
+			if jfor, ok := JulyDollar[1].obj.(*JForVar); !ok {
+				ReportCastError("JForVar", JulyDollar[1].obj)
+			} else if init, ok := JulyDollar[3].obj.(*JVariableInit); !ok {
+				ReportCastError("JVariableInit", JulyDollar[2].obj)
 			} else {
 				jfor.SetInit(init)
-				jfor.SetDecl(JulyS[Julypt-0].obj)
+				jfor.SetDecl(JulyDollar[5].obj)
 				JulyVAL.obj = jfor
 			}
 		}
-	case 288:
-		//line grammar/java11.y:1899
+	case 296:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2410
 		{
-			if jfor, ok := JulyS[Julypt-2].obj.(*JForVar); !ok {
-				ReportCastError("JForVar", JulyS[Julypt-2].obj)
-			} else if init, ok := JulyS[Julypt-0].obj.(*JVariableInit); !ok {
-				ReportCastError("JVariableInit", JulyS[Julypt-1].obj)
+			if jfor, ok := JulyDollar[1].obj.(*JForVar); !ok {
+				ReportCastError("JForVar", JulyDollar[1].obj)
+			} else if init, ok := JulyDollar[3].obj.(*JVariableInit); !ok {
+				ReportCastError("JVariableInit", JulyDollar[2].obj)
 			} else {
 				jfor.SetInit(init)
 				JulyVAL.obj = jfor
 			}
 		}
-	case 289:
-		//line grammar/java11.y:1910
+	case 297:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2421
 		{
-			if jfor, ok := JulyS[Julypt-2].obj.(*JForVar); !ok {
-				ReportCastError("JForVar", JulyS[Julypt-2].obj)
+			if jfor, ok := JulyDollar[1].obj.(*JForVar); !ok {
+				ReportCastError("JForVar", JulyDollar[1].obj)
 			} else {
-				jfor.SetDecl(JulyS[Julypt-0].obj)
+				jfor.SetDecl(JulyDollar[3].obj)
 				JulyVAL.obj = jfor
 			}
 		}
-	case 290:
-		//line grammar/java11.y:1919
+	case 298:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2430
 		{
-			if jfor, ok := JulyS[Julypt-0].obj.(*JForVar); !ok {
-				ReportCastError("JForVar", JulyS[Julypt-0].obj)
+			if jfor, ok := JulyDollar[1].obj.(*JForVar); !ok {
+				ReportCastError("JForVar", JulyDollar[1].obj)
 			} else {
 				JulyVAL.obj = jfor
 			}
 		}
-	case 291:
-		//line grammar/java11.y:1930
-		{
-			if jmod, ok := JulyS[Julypt-2].obj.(*JModifiers); !ok {
-				ReportCastError("JModifiers", JulyS[Julypt-2].obj)
-			} else if jtyp, ok := JulyS[Julypt-1].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-1].obj)
-			} else if jvid, ok := JulyS[Julypt-0].obj.(*tmpVariableId); !ok {
-				ReportCastError("tmpVariableId", JulyS[Julypt-0].obj)
+	case 299:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2441
+		{
+			if jmod, ok := JulyDollar[1].obj.(*JModifiers); !ok {
+				ReportCastError("JModifiers", JulyDollar[1].obj)
+			} else if jtyp, ok := JulyDollar[2].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[2].obj)
+			} else if jvid, ok := JulyDollar[3].obj.(*tmpVariableId); !ok {
+				ReportCastError("tmpVariableId", JulyDollar[3].obj)
 			} else {
 				JulyVAL.obj = NewJForVar(jmod, jtyp, jvid.name, jvid.dims)
 			}
 		}
-	case 292:
-		//line grammar/java11.y:1942
+	case 300:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:2453
 		{
-			if jtyp, ok := JulyS[Julypt-1].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-1].obj)
-			} else if jvid, ok := JulyS[Julypt-0].obj.(*tmpVariableId); !ok {
-				ReportCastError("tmpVariableId", JulyS[Julypt-0].obj)
+			if jtyp, ok := JulyDollar[1].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[1].obj)
+			} else if jvid, ok := JulyDollar[2].obj.(*tmpVariableId); !ok {
+				ReportCastError("tmpVariableId", JulyDollar[2].obj)
 			} else {
 				JulyVAL.obj = NewJForVar(nil, jtyp, jvid.name, jvid.dims)
 			}
 		}
-	case 293:
-		//line grammar/java11.y:1955
-		{
-			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
-		}
-	case 294:
-		//line grammar/java11.y:1960
-		{
-			JulyVAL.objlist = append(JulyS[Julypt-2].objlist, JulyS[Julypt-0].obj)
-		}
-	case 295:
-		//line grammar/java11.y:1965
-		{
-			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
-		}
-	case 296:
-		//line grammar/java11.y:1970
-		{
-			JulyVAL.objlist = append(JulyS[Julypt-2].objlist, JulyS[Julypt-0].obj)
-		}
-	case 297:
-		//line grammar/java11.y:1975
-		{
-			if JulyS[Julypt-0].obj == nil {
-				ReportError("ConditionalExpression cannot be nil")
-			}
-
-			JulyVAL.obj = JulyS[Julypt-0].obj
-		}
-	case 298:
-		//line grammar/java11.y:1983
-		{
-			JulyVAL.obj = NewJAssignmentExpr(JulyS[Julypt-2].obj, JulyS[Julypt-1].str, JulyS[Julypt-0].obj)
-		}
-	case 299:
-		//line grammar/java11.y:1988
-		{
-			JulyVAL.str = JulyS[Julypt-0].str
-		}
-	case 300:
-		//line grammar/java11.y:1990
-		{
-			JulyVAL.str = JulyS[Julypt-0].str
-		}
 	case 301:
-		//line grammar/java11.y:1992
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2466
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.objlist = make([]JObject, 1)
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 302:
-		//line grammar/java11.y:1994
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2471
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[3].obj)
 		}
 	case 303:
-		//line grammar/java11.y:1996
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2478
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.objlist = make([]JObject, 1)
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 304:
-		//line grammar/java11.y:1998
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2483
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[3].obj)
 		}
 	case 305:
-		//line grammar/java11.y:2000
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2490
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			if JulyDollar[1].obj == nil {
+				ReportError("ConditionalExpression cannot be nil")
+			}
+
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 306:
-		//line grammar/java11.y:2002
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2498
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.obj = NewJAssignmentExpr(JulyDollar[1].obj, JulyDollar[2].str, JulyDollar[3].obj)
 		}
 	case 307:
-		//line grammar/java11.y:2004
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2505
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 308:
-		//line grammar/java11.y:2006
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2509
 		{
-			JulyVAL.str = "<<="
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 309:
-		//line grammar/java11.y:2008
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2513
 		{
-			JulyVAL.str = ">>="
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 310:
-		//line grammar/java11.y:2010
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2517
 		{
-			JulyVAL.str = ">>>="
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 311:
-		//line grammar/java11.y:2015
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2521
 		{
-			if JulyS[Julypt-0].obj == nil {
-				ReportError("LogicalOrExpression cannot be nil")
-			}
-
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 312:
-		//line grammar/java11.y:2023
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2525
 		{
-			JulyVAL.obj = NewJConditionalExpr(JulyS[Julypt-4].obj, JulyS[Julypt-2].obj, JulyS[Julypt-0].obj)
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 313:
-		//line grammar/java11.y:2030
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2529
 		{
-			if JulyS[Julypt-0].obj == nil {
-				ReportError("LogicalAndExpression cannot be nil")
-			}
-
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 314:
-		//line grammar/java11.y:2038
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2533
 		{
-			JulyVAL.obj = NewJBinaryExpr(JulyS[Julypt-2].obj, JulyS[Julypt-1].str, JulyS[Julypt-0].obj)
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 315:
-		//line grammar/java11.y:2045
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2537
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 316:
-		//line grammar/java11.y:2050
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2541
 		{
-			if JulyS[Julypt-0].obj == nil {
-				ReportError("BitwiseOrExpression cannot be nil")
-			}
-
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.str = "<<="
 		}
 	case 317:
-		//line grammar/java11.y:2058
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2545
 		{
-			JulyVAL.obj = NewJBinaryExpr(JulyS[Julypt-2].obj, JulyS[Julypt-1].str, JulyS[Julypt-0].obj)
+			JulyVAL.str = ">>="
 		}
 	case 318:
-		//line grammar/java11.y:2065
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:2549
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = ">>>="
 		}
 	case 319:
-		//line grammar/java11.y:2070
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2556
 		{
-			if JulyS[Julypt-0].obj == nil {
-				ReportError("BitwiseXorExpression cannot be nil")
+			if JulyDollar[1].obj == nil {
+				ReportError("LogicalOrExpression cannot be nil")
 			}
 
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 320:
-		//line grammar/java11.y:2078
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:2564
 		{
-			JulyVAL.obj = NewJBinaryExpr(JulyS[Julypt-2].obj, JulyS[Julypt-1].str, JulyS[Julypt-0].obj)
+			JulyVAL.obj = NewJConditionalExpr(JulyDollar[1].obj, JulyDollar[3].obj, JulyDollar[5].obj)
 		}
 	case 321:
-		//line grammar/java11.y:2085
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2571
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			if JulyDollar[1].obj == nil {
+				ReportError("LogicalAndExpression cannot be nil")
+			}
+
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 322:
-		//line grammar/java11.y:2090
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2579
 		{
-			if JulyS[Julypt-0].obj == nil {
-				ReportError("BitwiseAndExpression cannot be nil")
-			}
-
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = NewJBinaryExpr(JulyDollar[1].obj, JulyDollar[2].str, JulyDollar[3].obj)
 		}
 	case 323:
-		//line grammar/java11.y:2098
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2586
 		{
-			JulyVAL.obj = NewJBinaryExpr(JulyS[Julypt-2].obj, JulyS[Julypt-1].str, JulyS[Julypt-0].obj)
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 324:
-		//line grammar/java11.y:2105
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2593
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			if JulyDollar[1].obj == nil {
+				ReportError("BitwiseOrExpression cannot be nil")
+			}
+
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 325:
-		//line grammar/java11.y:2110
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2601
 		{
-			if JulyS[Julypt-0].obj == nil {
-				ReportError("EqualityExpression cannot be nil")
-			}
-
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = NewJBinaryExpr(JulyDollar[1].obj, JulyDollar[2].str, JulyDollar[3].obj)
 		}
 	case 326:
-		//line grammar/java11.y:2118
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2608
 		{
-			JulyVAL.obj = NewJBinaryExpr(JulyS[Julypt-2].obj, JulyS[Julypt-1].str, JulyS[Julypt-0].obj)
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 327:
-		//line grammar/java11.y:2125
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2615
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			if JulyDollar[1].obj == nil {
+				ReportError("BitwiseXorExpression cannot be nil")
+			}
+
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 328:
-		//line grammar/java11.y:2130
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2623
 		{
-			if JulyS[Julypt-0].obj == nil {
-				ReportError("RelationalExpression cannot be nil")
-			}
-
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = NewJBinaryExpr(JulyDollar[1].obj, JulyDollar[2].str, JulyDollar[3].obj)
 		}
 	case 329:
-		//line grammar/java11.y:2138
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2630
 		{
-			JulyVAL.obj = NewJBinaryExpr(JulyS[Julypt-2].obj, JulyS[Julypt-1].str, JulyS[Julypt-0].obj)
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 330:
-		//line grammar/java11.y:2145
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2637
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			if JulyDollar[1].obj == nil {
+				ReportError("BitwiseAndExpression cannot be nil")
+			}
+
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 331:
-		//line grammar/java11.y:2147
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2645
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.obj = NewJBinaryExpr(JulyDollar[1].obj, JulyDollar[2].str, JulyDollar[3].obj)
 		}
 	case 332:
-		//line grammar/java11.y:2152
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2652
 		{
-			if JulyS[Julypt-0].obj == nil {
-				ReportError("AdditiveExpression cannot be nil")
-			}
-
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 333:
-		//line grammar/java11.y:2160
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2659
 		{
-			JulyVAL.obj = NewJBinaryExpr(JulyS[Julypt-2].obj, JulyS[Julypt-1].str, JulyS[Julypt-0].obj)
+			if JulyDollar[1].obj == nil {
+				ReportError("EqualityExpression cannot be nil")
+			}
+
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 334:
-		//line grammar/java11.y:2164
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2667
 		{
-			if jtyp, ok := JulyS[Julypt-0].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-0].obj)
-			} else {
-				JulyVAL.obj = NewJInstanceOf(JulyS[Julypt-2].obj, jtyp)
-			}
+			JulyVAL.obj = NewJBinaryExpr(JulyDollar[1].obj, JulyDollar[2].str, JulyDollar[3].obj)
 		}
 	case 335:
-		//line grammar/java11.y:2175
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2674
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 336:
-		//line grammar/java11.y:2177
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2681
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			if JulyDollar[1].obj == nil {
+				ReportError("RelationalExpression cannot be nil")
+			}
+
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 337:
-		//line grammar/java11.y:2179
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2689
 		{
-			JulyVAL.str = "<="
+			JulyVAL.obj = NewJBinaryExpr(JulyDollar[1].obj, JulyDollar[2].str, JulyDollar[3].obj)
 		}
 	case 338:
-		//line grammar/java11.y:2181
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2696
 		{
-			JulyVAL.str = ">="
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 339:
-		//line grammar/java11.y:2183
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2700
 		{
-			JulyVAL.str = "<<"
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 340:
-		//line grammar/java11.y:2185
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2707
 		{
-			JulyVAL.str = ">>"
+			if JulyDollar[1].obj == nil {
+				ReportError("AdditiveExpression cannot be nil")
+			}
+
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 341:
-		//line grammar/java11.y:2187
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2715
 		{
-			JulyVAL.str = ">>>"
+			JulyVAL.obj = NewJBinaryExpr(JulyDollar[1].obj, JulyDollar[2].str, JulyDollar[3].obj)
 		}
 	case 342:
-		//line grammar/java11.y:2192
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2719
 		{
-			if JulyS[Julypt-0].obj == nil {
-				ReportError("MultiplicativeExpression cannot be nil")
+			if jtyp, ok := JulyDollar[3].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[3].obj)
+			} else {
+				JulyVAL.obj = NewJInstanceOf(JulyDollar[1].obj, jtyp)
 			}
-
-			JulyVAL.obj = JulyS[Julypt-0].obj
 		}
 	case 343:
-		//line grammar/java11.y:2200
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:2727
 		{
-			JulyVAL.obj = NewJBinaryExpr(JulyS[Julypt-2].obj, JulyS[Julypt-1].str, JulyS[Julypt-0].obj)
+			if jtyp, ok := JulyDollar[3].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[3].obj)
+			} else {
+				jins := NewJInstanceOf(JulyDollar[1].obj, jtyp)
+				jins.Name = JulyDollar[4].str
+				JulyVAL.obj = jins
+			}
 		}
 	case 344:
-		//line grammar/java11.y:2207
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2740
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 345:
-		//line grammar/java11.y:2209
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2744
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 346:
-		//line grammar/java11.y:2214
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:2748
 		{
-			if JulyS[Julypt-0].obj == nil {
-				ReportError("CastExpression cannot be nil")
-			}
-
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.str = "<="
 		}
 	case 347:
-		//line grammar/java11.y:2222
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:2752
 		{
-			JulyVAL.obj = NewJBinaryExpr(JulyS[Julypt-2].obj, JulyS[Julypt-1].str, JulyS[Julypt-0].obj)
+			JulyVAL.str = ">="
 		}
 	case 348:
-		//line grammar/java11.y:2229
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:2756
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = "<<"
 		}
 	case 349:
-		//line grammar/java11.y:2231
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:2760
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = ">>"
 		}
 	case 350:
-		//line grammar/java11.y:2233
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2764
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = ">>>"
 		}
 	case 351:
-		//line grammar/java11.y:2238
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2771
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			if JulyDollar[1].obj == nil {
+				ReportError("MultiplicativeExpression cannot be nil")
+			}
+
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 352:
-		//line grammar/java11.y:2240
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2779
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.obj = NewJBinaryExpr(JulyDollar[1].obj, JulyDollar[2].str, JulyDollar[3].obj)
 		}
 	case 353:
-		//line grammar/java11.y:2242
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2786
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 354:
-		//line grammar/java11.y:2244
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2790
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 355:
-		//line grammar/java11.y:2246
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2797
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			if JulyDollar[1].obj == nil {
+				ReportError("CastExpression cannot be nil")
+			}
+
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 356:
-		//line grammar/java11.y:2248
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2805
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.obj = NewJBinaryExpr(JulyDollar[1].obj, JulyDollar[2].str, JulyDollar[3].obj)
 		}
 	case 357:
-		//line grammar/java11.y:2253
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2812
 		{
-			JulyVAL.obj = NewJUnaryExpr(JulyS[Julypt-1].str, JulyS[Julypt-0].obj, true)
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 358:
-		//line grammar/java11.y:2255
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2816
 		{
-			if ref, ok := JulyS[Julypt-2].obj.(*JReferenceType); !ok {
-				ReportCastError("JReferenceType", JulyS[Julypt-2].obj)
-			} else {
-				JulyVAL.obj = NewJCastExpr(ref, JulyS[Julypt-0].obj)
-			}
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 359:
-		//line grammar/java11.y:2263
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2820
 		{
-			ref := NewJReferenceType(JulyS[Julypt-3].name, nil, JulyS[Julypt-2].count)
-			JulyVAL.obj = NewJCastExpr(ref, JulyS[Julypt-0].obj)
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 360:
-		//line grammar/java11.y:2268
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2827
 		{
-			ref := NewJReferenceType(NewJTypeName(JulyS[Julypt-2].str, true), nil, 0)
-			JulyVAL.obj = NewJCastExpr(ref, JulyS[Julypt-0].obj)
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 361:
-		//line grammar/java11.y:2273
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2831
 		{
-			ref := NewJReferenceType(NewJTypeName(JulyS[Julypt-3].str, true), nil, JulyS[Julypt-2].count)
-			JulyVAL.obj = NewJCastExpr(ref, JulyS[Julypt-0].obj)
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 362:
-		//line grammar/java11.y:2278
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2835
 		{
-			JulyVAL.obj = NewJUnaryExpr(JulyS[Julypt-0].str, JulyS[Julypt-1].obj, false)
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 363:
-		//line grammar/java11.y:2280
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2839
 		{
-			if JulyS[Julypt-0].obj == nil {
-				ReportError("PrimaryExpression cannot be nil")
-			}
-
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 364:
-		//line grammar/java11.y:2291
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2843
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 365:
-		//line grammar/java11.y:2293
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2847
 		{
-			JulyVAL.str = JulyS[Julypt-0].str
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 366:
-		//line grammar/java11.y:2298
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:2854
 		{
-			JulyVAL.obj = NewJReferenceType(JulyS[Julypt-0].name, nil, 0)
+			JulyVAL.obj = NewJUnaryExpr(JulyDollar[1].str, JulyDollar[2].obj, true)
 		}
 	case 367:
-		//line grammar/java11.y:2300
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:2858
 		{
-			JulyVAL.obj = NewJKeyword(JulyS[Julypt-0].token, JulyS[Julypt-0].str)
+			if ref, ok := JulyDollar[2].obj.(*JReferenceType); !ok {
+				ReportCastError("JReferenceType", JulyDollar[2].obj)
+			} else {
+				JulyVAL.obj = NewJCastExpr(ref, JulyDollar[4].obj)
+			}
 		}
 	case 368:
-		//line grammar/java11.y:2302
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:2866
 		{
-			JulyVAL.obj = NewJKeyword(JulyS[Julypt-0].token, JulyS[Julypt-0].str)
+			ref := NewJReferenceType(JulyDollar[2].name, nil, JulyDollar[3].count)
+			JulyVAL.obj = NewJCastExpr(ref, JulyDollar[5].obj)
 		}
 	case 369:
-		//line grammar/java11.y:2304
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:2871
 		{
-			JulyVAL.obj = NewJKeyword(JulyS[Julypt-0].token, JulyS[Julypt-0].str)
+			ref := NewJReferenceType(NewJTypeName(JulyDollar[2].str, true), nil, 0)
+			JulyVAL.obj = NewJCastExpr(ref, JulyDollar[4].obj)
 		}
 	case 370:
-		//line grammar/java11.y:2306
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:2876
 		{
-			if JulyS[Julypt-0].obj == nil {
-				ReportError("PlainNewAllocationExpression cannot be nil")
-			}
-
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			ref := NewJReferenceType(NewJTypeName(JulyDollar[2].str, true), nil, JulyDollar[3].count)
+			JulyVAL.obj = NewJCastExpr(ref, JulyDollar[5].obj)
 		}
 	case 371:
-		//line grammar/java11.y:2314
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:2881
 		{
-			if JulyS[Julypt-0].obj == nil {
-				ReportError("PlainNewAllocationExpression cannot be nil")
-			}
-
-			JulyVAL.obj = NewJNameDotObject(JulyS[Julypt-2].name, JulyS[Julypt-0].obj)
+			JulyVAL.obj = NewJUnaryExpr(JulyDollar[2].str, JulyDollar[1].obj, false)
 		}
 	case 372:
-		//line grammar/java11.y:2322
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2885
 		{
-			if JulyS[Julypt-0].obj == nil {
-				ReportError("ComplexPrimaryNoParenthesis cannot be nil")
+			if JulyDollar[1].obj == nil {
+				ReportError("PrimaryExpression cannot be nil")
 			}
 
-			JulyVAL.obj = JulyS[Julypt-0].obj
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 373:
-		//line grammar/java11.y:2330
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2896
 		{
-			if JulyS[Julypt-1].obj == nil {
-				ReportError("Expression cannot be nil")
-			}
-
-			JulyVAL.obj = JulyS[Julypt-1].obj
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 374:
-		//line grammar/java11.y:2341
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2900
 		{
-			if JulyS[Julypt-0].obj == nil {
-				ReportError("ArrayAllocationExpression cannot be nil")
-			} else if aae, ok := JulyS[Julypt-0].obj.(*JArrayAlloc); !ok {
-				ReportCastError("JArrayAlloc", JulyS[Julypt-0].obj)
-			} else {
-				JulyVAL.obj = aae
-			}
+			JulyVAL.str = JulyDollar[1].str
 		}
 	case 375:
-		//line grammar/java11.y:2351
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2907
 		{
-			if JulyS[Julypt-2].obj == nil {
-				ReportError("ArrayAllocationExpression cannot be nil")
-			} else if aae, ok := JulyS[Julypt-2].obj.(*JArrayAlloc); !ok {
-				ReportCastError("JArrayAlloc", JulyS[Julypt-2].obj)
-			} else {
-				JulyVAL.obj = aae
-			}
+			JulyVAL.obj = NewJReferenceType(JulyDollar[1].name, nil, 0)
 		}
 	case 376:
-		//line grammar/java11.y:2361
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2911
 		{
-			if JulyS[Julypt-3].obj == nil {
-				ReportError("ArrayAllocationExpression cannot be nil")
-			} else if aae, ok := JulyS[Julypt-3].obj.(*JArrayAlloc); !ok {
-				ReportCastError("JArrayAlloc", JulyS[Julypt-3].obj)
-			} else {
-				aae.SetInitializers(JulyS[Julypt-1].varlist)
-				JulyVAL.obj = aae
-			}
+			JulyVAL.obj = NewJKeyword(JulyDollar[1].token, JulyDollar[1].str)
 		}
 	case 377:
-		//line grammar/java11.y:2372
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2915
 		{
-			if JulyS[Julypt-0].obj == nil {
-				ReportError("ClassAllocationExpression cannot be nil")
-			} else if cae, ok := JulyS[Julypt-0].obj.(*JClassAllocationExpr); !ok {
-				ReportCastError("JClassAllocationExpr", JulyS[Julypt-0].obj)
-			} else {
-				JulyVAL.obj = cae
-			}
+			JulyVAL.obj = NewJKeyword(JulyDollar[1].token, JulyDollar[1].str)
 		}
 	case 378:
-		//line grammar/java11.y:2382
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2919
 		{
-			if JulyS[Julypt-1].obj == nil {
-				ReportError("ClassAllocationExpression cannot be nil")
-			} else if cae, ok := JulyS[Julypt-1].obj.(*JClassAllocationExpr); !ok {
-				ReportCastError("JClassAllocationExpr", JulyS[Julypt-1].obj)
-			} else {
-				cae.SetBody(JulyS[Julypt-0].objlist)
-				JulyVAL.obj = cae
-			}
+			JulyVAL.obj = NewJKeyword(JulyDollar[1].token, JulyDollar[1].str)
 		}
 	case 379:
-		//line grammar/java11.y:2396
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2923
 		{
-			JulyVAL.obj = NewJLiteral(JulyS[Julypt-0].str)
+			if JulyDollar[1].obj == nil {
+				ReportError("PlainNewAllocationExpression cannot be nil")
+			}
+
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 380:
-		//line grammar/java11.y:2398
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2931
 		{
-			JulyVAL.obj = NewJKeyword(JulyS[Julypt-0].token, JulyS[Julypt-0].str)
+			if JulyDollar[3].obj == nil {
+				ReportError("PlainNewAllocationExpression cannot be nil")
+			}
+
+			JulyVAL.obj = NewJNameDotObject(JulyDollar[1].name, JulyDollar[3].obj)
 		}
 	case 381:
-		//line grammar/java11.y:2400
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2939
 		{
-			JulyVAL.obj = NewJArrayReference(JulyS[Julypt-3].name, nil, JulyS[Julypt-1].obj)
+			if JulyDollar[1].obj == nil {
+				ReportError("ComplexPrimaryNoParenthesis cannot be nil")
+			}
+
+			JulyVAL.obj = JulyDollar[1].obj
 		}
 	case 382:
-		//line grammar/java11.y:2402
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2947
 		{
-			JulyVAL.obj = NewJArrayReference(nil, NewJParens(JulyS[Julypt-4].obj), JulyS[Julypt-1].obj)
+			if JulyDollar[2].obj == nil {
+				ReportError("Expression cannot be nil")
+			}
+
+			JulyVAL.obj = JulyDollar[2].obj
 		}
 	case 383:
-		//line grammar/java11.y:2404
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2958
 		{
-			JulyVAL.obj = NewJArrayReference(nil, JulyS[Julypt-3].obj, JulyS[Julypt-1].obj)
+			if JulyDollar[1].obj == nil {
+				ReportError("ArrayAllocationExpression cannot be nil")
+			} else if aae, ok := JulyDollar[1].obj.(*JArrayAlloc); !ok {
+				ReportCastError("JArrayAlloc", JulyDollar[1].obj)
+			} else {
+				JulyVAL.obj = aae
+			}
 		}
 	case 384:
-		//line grammar/java11.y:2406
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:2968
 		{
-			JulyVAL.obj = NewJObjectDotName(JulyS[Julypt-2].obj, NewJTypeName(JulyS[Julypt-0].str, false))
+			if JulyDollar[1].obj == nil {
+				ReportError("ArrayAllocationExpression cannot be nil")
+			} else if aae, ok := JulyDollar[1].obj.(*JArrayAlloc); !ok {
+				ReportCastError("JArrayAlloc", JulyDollar[1].obj)
+			} else {
+				JulyVAL.obj = aae
+			}
 		}
 	case 385:
-		//line grammar/java11.y:2408
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:2978
 		{
-			JulyVAL.obj = NewJUnimplemented("ComplexPrimaryNoParenthesis#6")
+			if JulyDollar[1].obj == nil {
+				ReportError("ArrayAllocationExpression cannot be nil")
+			} else if aae, ok := JulyDollar[1].obj.(*JArrayAlloc); !ok {
+				ReportCastError("JArrayAlloc", JulyDollar[1].obj)
+			} else {
+				aae.SetInitializers(JulyDollar[3].varlist)
+				JulyVAL.obj = aae
+			}
 		}
 	case 386:
-		//line grammar/java11.y:2410
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:2989
 		{
-			JulyVAL.obj = NewJNameDotObject(JulyS[Julypt-2].name, NewJKeyword(JulyS[Julypt-0].token, JulyS[Julypt-0].str))
+			if JulyDollar[1].obj == nil {
+				ReportError("ClassAllocationExpression cannot be nil")
+			} else if cae, ok := JulyDollar[1].obj.(*JClassAllocationExpr); !ok {
+				ReportCastError("JClassAllocationExpr", JulyDollar[1].obj)
+			} else {
+				JulyVAL.obj = cae
+			}
 		}
 	case 387:
-		//line grammar/java11.y:2412
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:2999
 		{
-			JulyVAL.obj = NewJNameDotObject(JulyS[Julypt-2].name, NewJKeyword(JulyS[Julypt-0].token, JulyS[Julypt-0].str))
+			if JulyDollar[1].obj == nil {
+				ReportError("ClassAllocationExpression cannot be nil")
+			} else if cae, ok := JulyDollar[1].obj.(*JClassAllocationExpr); !ok {
+				ReportCastError("JClassAllocationExpr", JulyDollar[1].obj)
+			} else {
+				cae.SetBody(JulyDollar[2].objlist)
+				JulyVAL.obj = cae
+			}
 		}
 	case 388:
-		//line grammar/java11.y:2414
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:3013
 		{
-			JulyVAL.obj = NewJNameDotObject(NewJTypeName(JulyS[Julypt-2].str, true),
-				NewJKeyword(JulyS[Julypt-0].token, JulyS[Julypt-0].str))
+			JulyVAL.obj = NewJLiteral(JulyDollar[1].str)
 		}
 	case 389:
-		//line grammar/java11.y:2419
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:3017
 		{
-			JulyVAL.obj = NewJUnimplemented("ComplexPrimaryNoParenthesis#10")
+			JulyVAL.obj = NewJKeyword(JulyDollar[1].token, JulyDollar[1].str)
 		}
 	case 390:
-		//line grammar/java11.y:2421
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:3021
 		{
-			JulyVAL.obj = NewJMethodAccessComplex(JulyS[Julypt-3].obj, JulyS[Julypt-1].str, JulyS[Julypt-0].objlist)
+			JulyVAL.obj = NewJArrayReference(JulyDollar[1].name, nil, JulyDollar[3].obj)
 		}
 	case 391:
-		//line grammar/java11.y:2423
+		JulyDollar = JulyS[Julypt-6 : Julypt+1]
+//line grammar/java11.y:3025
 		{
-			JulyVAL.obj = NewJMethodAccessKeyword(JulyS[Julypt-1].token, JulyS[Julypt-1].str, JulyS[Julypt-0].objlist)
+			JulyVAL.obj = NewJArrayReference(nil, NewJParens(JulyDollar[2].obj), JulyDollar[5].obj)
 		}
 	case 392:
-		//line grammar/java11.y:2425
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:3029
 		{
-			JulyVAL.obj = NewJMethodAccessKeyword(JulyS[Julypt-1].token, JulyS[Julypt-1].str, JulyS[Julypt-0].objlist)
+			JulyVAL.obj = NewJArrayReference(nil, JulyDollar[1].obj, JulyDollar[3].obj)
 		}
 	case 393:
-		//line grammar/java11.y:2427
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3033
 		{
-			// is "null(arg1, arg2, ...)" really valid?
-			JulyVAL.obj = NewJMethodAccessKeyword(JulyS[Julypt-1].token, JulyS[Julypt-1].str, JulyS[Julypt-0].objlist)
+			JulyVAL.obj = NewJObjectDotName(JulyDollar[1].obj, NewJTypeName(JulyDollar[3].str, false))
 		}
 	case 394:
-		//line grammar/java11.y:2432
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:3037
 		{
-			JulyVAL.obj = NewJMethodAccessName(JulyS[Julypt-1].name, JulyS[Julypt-0].objlist)
+			JulyVAL.obj = NewJUnimplemented("ComplexPrimaryNoParenthesis#6")
 		}
 	case 395:
-		//line grammar/java11.y:2437
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3041
 		{
-			JulyVAL.objlist = JulyS[Julypt-1].objlist
+			JulyVAL.obj = NewJNameDotObject(JulyDollar[1].name, NewJKeyword(JulyDollar[3].token, JulyDollar[3].str))
 		}
 	case 396:
-		//line grammar/java11.y:2439
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3045
 		{
-			JulyVAL.objlist = nil
+			JulyVAL.obj = NewJNameDotObject(JulyDollar[1].name, NewJKeyword(JulyDollar[3].token, JulyDollar[3].str))
 		}
 	case 397:
-		//line grammar/java11.y:2444
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3049
 		{
-			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.obj = NewJNameDotObject(NewJTypeName(JulyDollar[1].str, true),
+				NewJKeyword(JulyDollar[3].token, JulyDollar[3].str))
 		}
 	case 398:
-		//line grammar/java11.y:2449
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3054
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-2].objlist, JulyS[Julypt-0].obj)
+			JulyVAL.obj = NewJUnimplemented("ComplexPrimaryNoParenthesis#10")
 		}
 	case 399:
-		//line grammar/java11.y:2454
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:3058
 		{
-			if vin, ok := JulyS[Julypt-0].obj.(*JVariableInit); !ok {
-				ReportCastError("JVariableInit", JulyS[Julypt-0].obj)
-			} else {
-				JulyVAL.varlist = make([]*JVariableInit, 1)
-				JulyVAL.varlist[0] = vin
-			}
+			JulyVAL.obj = NewJMethodAccessComplex(JulyDollar[1].obj, JulyDollar[3].str, JulyDollar[4].objlist)
 		}
 	case 400:
-		//line grammar/java11.y:2463
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:3062
 		{
-			if vin, ok := JulyS[Julypt-0].obj.(*JVariableInit); !ok {
-				ReportCastError("JVariableInit", JulyS[Julypt-0].obj)
-			} else {
-				JulyVAL.varlist = append(JulyS[Julypt-2].varlist, vin)
-			}
+			JulyVAL.obj = NewJMethodAccessKeyword(JulyDollar[1].token, JulyDollar[1].str, JulyDollar[2].objlist)
 		}
 	case 401:
-		//line grammar/java11.y:2471
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:3066
 		{
-			JulyVAL.varlist = JulyS[Julypt-1].varlist
+			JulyVAL.obj = NewJMethodAccessKeyword(JulyDollar[1].token, JulyDollar[1].str, JulyDollar[2].objlist)
 		}
 	case 402:
-		//line grammar/java11.y:2476
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:3070
 		{
-			JulyVAL.obj = NewJClassAllocationExpr(JulyS[Julypt-1].name, nil, JulyS[Julypt-0].objlist)
+			// is "null(arg1, arg2, ...)" really valid?
+			JulyVAL.obj = NewJMethodAccessKeyword(JulyDollar[1].token, JulyDollar[1].str, JulyDollar[2].objlist)
 		}
 	case 403:
-		//line grammar/java11.y:2478
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:3075
 		{
-			JulyVAL.obj = NewJClassAllocationExpr(JulyS[Julypt-2].name, JulyS[Julypt-1].objlist, JulyS[Julypt-0].objlist)
+			JulyVAL.obj = NewJMethodAccessName(JulyDollar[1].name, JulyDollar[2].objlist)
 		}
 	case 404:
-		//line grammar/java11.y:2483
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3082
 		{
-			JulyVAL.obj = NewJArrayAlloc(JulyS[Julypt-2].name, JulyS[Julypt-1].objlist, JulyS[Julypt-0].count)
+			JulyVAL.objlist = JulyDollar[2].objlist
 		}
 	case 405:
-		//line grammar/java11.y:2485
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:3086
 		{
-			JulyVAL.obj = NewJArrayAlloc(JulyS[Julypt-1].name, JulyS[Julypt-0].objlist, 0)
+			JulyVAL.objlist = nil
 		}
 	case 406:
-		//line grammar/java11.y:2487
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:3093
 		{
-			JulyVAL.obj = NewJArrayAlloc(JulyS[Julypt-1].name, nil, JulyS[Julypt-0].count)
+			JulyVAL.objlist = make([]JObject, 1)
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 407:
-		//line grammar/java11.y:2492
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3098
 		{
-			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[3].obj)
 		}
 	case 408:
-		//line grammar/java11.y:2497
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:3105
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-1].objlist, JulyS[Julypt-0].obj)
+			if vin, ok := JulyDollar[1].obj.(*JVariableInit); !ok {
+				ReportCastError("JVariableInit", JulyDollar[1].obj)
+			} else {
+				JulyVAL.varlist = make([]*JVariableInit, 1)
+				JulyVAL.varlist[0] = vin
+			}
 		}
 	case 409:
-		//line grammar/java11.y:2502
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3114
 		{
-			JulyVAL.obj = JulyS[Julypt-1].obj
+			if vin, ok := JulyDollar[3].obj.(*JVariableInit); !ok {
+				ReportCastError("JVariableInit", JulyDollar[3].obj)
+			} else {
+				JulyVAL.varlist = append(JulyDollar[1].varlist, vin)
+			}
 		}
 	case 410:
-		//line grammar/java11.y:2507
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:3122
 		{
-			JulyVAL.obj = NewJEnumBody(JulyS[Julypt-3].objlist, JulyS[Julypt-1].objlist)
+			JulyVAL.varlist = JulyDollar[1].varlist
 		}
 	case 411:
-		//line grammar/java11.y:2509
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3129
 		{
-			JulyVAL.obj = NewJEnumBody(JulyS[Julypt-2].objlist, nil)
+			JulyVAL.obj = NewJClassAllocationExpr(JulyDollar[2].name, nil, JulyDollar[3].objlist)
 		}
 	case 412:
-		//line grammar/java11.y:2511
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:3133
 		{
-			JulyVAL.obj = NewJEnumBody(JulyS[Julypt-2].objlist, JulyS[Julypt-1].objlist)
+			JulyVAL.obj = NewJClassAllocationExpr(JulyDollar[2].name, JulyDollar[3].objlist, JulyDollar[4].objlist)
 		}
 	case 413:
-		//line grammar/java11.y:2513
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:3140
 		{
-			JulyVAL.obj = NewJEnumBody(JulyS[Julypt-1].objlist, nil)
+			JulyVAL.obj = NewJArrayAlloc(JulyDollar[2].name, JulyDollar[3].objlist, JulyDollar[4].count)
 		}
 	case 414:
-		//line grammar/java11.y:2515
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3144
 		{
-			JulyVAL.obj = NewJEnumBody(nil, JulyS[Julypt-1].objlist)
+			JulyVAL.obj = NewJArrayAlloc(JulyDollar[2].name, JulyDollar[3].objlist, 0)
 		}
 	case 415:
-		//line grammar/java11.y:2517
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3148
 		{
-			JulyVAL.obj = NewJEnumBody(nil, nil)
+			JulyVAL.obj = NewJArrayAlloc(JulyDollar[2].name, nil, JulyDollar[3].count)
 		}
 	case 416:
-		//line grammar/java11.y:2519
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:3155
 		{
-			JulyVAL.obj = NewJEnumBody(nil, JulyS[Julypt-1].objlist)
+			JulyVAL.objlist = make([]JObject, 1)
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 417:
-		//line grammar/java11.y:2521
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:3160
 		{
-			JulyVAL.obj = NewJEnumBody(nil, nil)
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[2].obj)
 		}
 	case 418:
-		//line grammar/java11.y:2526
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3167
 		{
-			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.obj = JulyDollar[2].obj
 		}
 	case 419:
-		//line grammar/java11.y:2531
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:3174
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-2].objlist, JulyS[Julypt-0].obj)
+			JulyVAL.obj = NewJEnumBody(JulyDollar[2].objlist, JulyDollar[4].objlist)
 		}
 	case 420:
-		//line grammar/java11.y:2536
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:3178
 		{
-			JulyVAL.obj = NewJEnumConstant(JulyS[Julypt-3].objlist, JulyS[Julypt-2].str, JulyS[Julypt-1].objlist,
-				JulyS[Julypt-0].objlist)
+			JulyVAL.obj = NewJEnumBody(JulyDollar[2].objlist, nil)
 		}
 	case 421:
-		//line grammar/java11.y:2541
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:3182
 		{
-			JulyVAL.obj = NewJEnumConstant(JulyS[Julypt-2].objlist, JulyS[Julypt-1].str, JulyS[Julypt-0].objlist, nil)
+			JulyVAL.obj = NewJEnumBody(JulyDollar[2].objlist, JulyDollar[3].objlist)
 		}
 	case 422:
-		//line grammar/java11.y:2545
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3186
 		{
-			JulyVAL.obj = NewJEnumConstant(JulyS[Julypt-2].objlist, JulyS[Julypt-1].str, nil, JulyS[Julypt-0].objlist)
+			JulyVAL.obj = NewJEnumBody(JulyDollar[2].objlist, nil)
 		}
 	case 423:
-		//line grammar/java11.y:2549
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:3190
 		{
-			JulyVAL.obj = NewJEnumConstant(JulyS[Julypt-1].objlist, JulyS[Julypt-0].str, nil, nil)
+			JulyVAL.obj = NewJEnumBody(nil, JulyDollar[3].objlist)
 		}
 	case 424:
-		//line grammar/java11.y:2553
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3194
 		{
-			JulyVAL.obj = NewJEnumConstant(nil, JulyS[Julypt-2].str, JulyS[Julypt-1].objlist, JulyS[Julypt-0].objlist)
+			JulyVAL.obj = NewJEnumBody(nil, nil)
 		}
 	case 425:
-		//line grammar/java11.y:2557
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3198
 		{
-			JulyVAL.obj = NewJEnumConstant(nil, JulyS[Julypt-1].str, JulyS[Julypt-0].objlist, nil)
+			JulyVAL.obj = NewJEnumBody(nil, JulyDollar[2].objlist)
 		}
 	case 426:
-		//line grammar/java11.y:2561
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:3202
 		{
-			JulyVAL.obj = NewJEnumConstant(nil, JulyS[Julypt-1].str, nil, JulyS[Julypt-0].objlist)
+			JulyVAL.obj = NewJEnumBody(nil, nil)
 		}
 	case 427:
-		//line grammar/java11.y:2565
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:3209
 		{
-			JulyVAL.obj = NewJEnumConstant(nil, JulyS[Julypt-0].str, nil, nil)
+			JulyVAL.objlist = make([]JObject, 1)
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
 	case 428:
-		//line grammar/java11.y:2572
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3214
+		{
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[3].obj)
+		}
+	case 429:
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:3221
 		{
-			if JulyS[Julypt-0].obj == nil {
+			JulyVAL.obj = NewJEnumConstant(JulyDollar[1].objlist, JulyDollar[2].str, JulyDollar[3].objlist,
+				JulyDollar[4].objlist)
+		}
+	case 430:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3226
+		{
+			JulyVAL.obj = NewJEnumConstant(JulyDollar[1].objlist, JulyDollar[2].str, JulyDollar[3].objlist, nil)
+		}
+	case 431:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3230
+		{
+			JulyVAL.obj = NewJEnumConstant(JulyDollar[1].objlist, JulyDollar[2].str, nil, JulyDollar[3].objlist)
+		}
+	case 432:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:3234
+		{
+			JulyVAL.obj = NewJEnumConstant(JulyDollar[1].objlist, JulyDollar[2].str, nil, nil)
+		}
+	case 433:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3238
+		{
+			JulyVAL.obj = NewJEnumConstant(nil, JulyDollar[1].str, JulyDollar[2].objlist, JulyDollar[3].objlist)
+		}
+	case 434:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:3242
+		{
+			JulyVAL.obj = NewJEnumConstant(nil, JulyDollar[1].str, JulyDollar[2].objlist, nil)
+		}
+	case 435:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:3246
+		{
+			JulyVAL.obj = NewJEnumConstant(nil, JulyDollar[1].str, nil, JulyDollar[2].objlist)
+		}
+	case 436:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:3250
+		{
+			JulyVAL.obj = NewJEnumConstant(nil, JulyDollar[1].str, nil, nil)
+		}
+	case 437:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:3257
+		{
+			if JulyDollar[1].obj == nil {
 				ReportError("Found empty class body entry")
 			}
 
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
-	case 429:
-		//line grammar/java11.y:2581
+	case 438:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:3266
 		{
-			if JulyS[Julypt-0].obj == nil {
+			if JulyDollar[2].obj == nil {
 				ReportError("Found empty class body entry")
 			}
 
-			JulyVAL.objlist = append(JulyS[Julypt-1].objlist, JulyS[Julypt-0].obj)
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[2].obj)
 		}
-	case 430:
-		//line grammar/java11.y:2592
+	case 439:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:3277
 		{
-			JulyVAL.objlist = JulyS[Julypt-0].objlist
+			JulyVAL.objlist = JulyDollar[2].objlist
 		}
-	case 431:
-		//line grammar/java11.y:2594
+	case 440:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:3281
 		{
 			JulyVAL.objlist = nil
 		}
-	case 432:
-		//line grammar/java11.y:2599
+	case 441:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3288
 		{
 			JulyVAL.obj = NewJUnimplemented("AnnotationTypeBody#0")
 		}
-	case 433:
-		//line grammar/java11.y:2601
+	case 442:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:3292
 		{
 			JulyVAL.obj = NewJUnimplemented("AnnotationTypeBody#1")
 		}
-	case 434:
-		//line grammar/java11.y:2606
+	case 443:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:3299
 		{
 			JulyVAL.objlist = make([]JObject, 1)
-			JulyVAL.objlist[0] = JulyS[Julypt-0].obj
+			JulyVAL.objlist[0] = JulyDollar[1].obj
 		}
-	case 435:
-		//line grammar/java11.y:2611
+	case 444:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:3304
 		{
-			JulyVAL.objlist = append(JulyS[Julypt-1].objlist, JulyS[Julypt-0].obj)
+			JulyVAL.objlist = append(JulyDollar[1].objlist, JulyDollar[2].obj)
 		}
-	case 436:
-		//line grammar/java11.y:2616
+	case 445:
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:3311
 		{
 			JulyVAL.obj = NewJUnimplemented("AnnotationTypeElementDeclaration#0")
 		}
-	case 437:
-		//line grammar/java11.y:2618
+	case 446:
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:3315
 		{
 			JulyVAL.obj = NewJUnimplemented("AnnotationTypeElementDeclaration#1")
 		}
-	case 438:
-		//line grammar/java11.y:2620
+	case 447:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:3319
 		{
 			JulyVAL.obj = NewJUnimplemented("AnnotationTypeElementDeclaration#2")
 		}
-	case 439:
-		//line grammar/java11.y:2622
+	case 448:
+		JulyDollar = JulyS[Julypt-1 : Julypt+1]
+//line grammar/java11.y:3323
 		{
 			JulyVAL.obj = NewJUnimplemented("AnnotationTypeElementDeclaration#3")
 		}
-	case 440:
-		//line grammar/java11.y:2627
+	case 449:
+		JulyDollar = JulyS[Julypt-5 : Julypt+1]
+//line grammar/java11.y:3330
 		{
 			JulyVAL.obj = NewJUnimplemented("AnnotationMethodRest#0")
 		}
-	case 441:
-		//line grammar/java11.y:2629
+	case 450:
+		JulyDollar = JulyS[Julypt-3 : Julypt+1]
+//line grammar/java11.y:3334
 		{
 			JulyVAL.obj = NewJUnimplemented("AnnotationMethodRest#1")
 		}
-	case 442:
-		//line grammar/java11.y:2631
+	case 451:
+		JulyDollar = JulyS[Julypt-4 : Julypt+1]
+//line grammar/java11.y:3338
 		{
 			JulyVAL.obj = NewJUnimplemented("AnnotationMethodRest#2")
 		}
-	case 443:
-		//line grammar/java11.y:2633
+	case 452:
+		JulyDollar = JulyS[Julypt-2 : Julypt+1]
+//line grammar/java11.y:3342
 		{
 			JulyVAL.obj = NewJUnimplemented("AnnotationMethodRest#3")
 		}
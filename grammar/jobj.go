@@ -122,6 +122,8 @@ func NewJBlock(list []JObject) *JBlock {
 	return &JBlock{List: list}
 }
 
+func (j *JBlock) IsStatic() bool { return j.static }
+
 func (j *JBlock) SetStatic() { j.static = true }
 
 type JCastExpr struct {
@@ -221,6 +223,14 @@ func NewJClassDecl(modifiers *JModifiers, name string, type_params []JObject,
 		Body: body}
 }
 
+func (j *JClassDecl) IsAbstract() bool {
+	return j.modifiers != nil && j.modifiers.IsSet(ModAbstract)
+}
+
+func (j *JClassDecl) IsStatic() bool {
+	return j.modifiers != nil && j.modifiers.IsSet(ModStatic)
+}
+
 type JConditionalExpr struct {
 	CondExpr JObject
 	IfExpr JObject
@@ -497,6 +507,8 @@ func NewJImportStmt(name *JTypeName, is_wild bool, is_static bool) *JImportStmt
 type JInstanceOf struct {
 	Obj JObject
 	TypeSpec *JReferenceType
+	// pattern variable ("obj instanceof Foo f"), or "" if there is none
+	Name string
 }
 
 func NewJInstanceOf(obj JObject, typespec *JReferenceType) *JInstanceOf {
@@ -567,6 +579,15 @@ func (j *JInterfaceMethodDecl) SetTypeParameters(type_params []JObject) {
 	j.type_params = type_params
 }
 
+// interface members may be abstract methods or, for static and default
+// methods, method declarations with a body
+type methodDecl interface {
+	SetModifiers(modifiers *JModifiers)
+	SetName(name string)
+	SetType(typespec *JReferenceType)
+	SetTypeParameters(type_params []JObject)
+}
+
 type JJumpToLabel struct {
 	IsContinue bool
 	Label string
@@ -719,14 +740,15 @@ const (
 	ModPublic = 0x1
 	modProtected = 0x2
 	ModPrivate = 0x4
-	modAbstract = 0x8
+	ModAbstract = 0x8
 	ModFinal = 0x10
 	ModStatic = 0x20
 	modTransient = 0x40
 	modVolatile = 0x80
 	modNative = 0x100
 	modSynchronized = 0x200
-	modMax = modSynchronized
+	modDefault = 0x400
+	modMax = modDefault
 )
 
 type JModifiers struct {
@@ -759,13 +781,14 @@ func (j *JModifiers) AddModifier(name string) *JModifiers {
 	case "public": j.mod_bits |= ModPublic
 	case "protected": j.mod_bits |= modProtected
 	case "private": j.mod_bits |= ModPrivate
-	case "abstract": j.mod_bits |= modAbstract
+	case "abstract": j.mod_bits |= ModAbstract
 	case "final": j.mod_bits |= ModFinal
 	case "static": j.mod_bits |= ModStatic
 	case "transient": j.mod_bits |= modTransient
 	case "volatile": j.mod_bits |= modVolatile
 	case "native": j.mod_bits |= modNative
 	case "synchronized": j.mod_bits |= modSynchronized
+	case "default": j.mod_bits |= modDefault
 	default: ReportError(fmt.Sprintf("Unknown modifier \"%s\"", name))
 	}
 
@@ -801,13 +824,14 @@ func (j *JModifiers) writeModifiers(out io.Writer) {
 			case ModPublic: io.WriteString(out, "public ")
 			case modProtected: io.WriteString(out, "protected ")
 			case ModPrivate: io.WriteString(out, "private ")
-			case modAbstract: io.WriteString(out, "abstract ")
+			case ModAbstract: io.WriteString(out, "abstract ")
 			case ModFinal: io.WriteString(out, "final ")
 			case ModStatic: io.WriteString(out, "static ")
 			case modTransient: io.WriteString(out, "transient ")
 			case modVolatile: io.WriteString(out, "volatile ")
 			case modNative: io.WriteString(out, "native ")
 			case modSynchronized: io.WriteString(out, "synchronized ")
+			case modDefault: io.WriteString(out, "default ")
 			}
 		}
 	}
@@ -1007,6 +1031,7 @@ func NewJSynchronized(expr JObject, block *JBlock) *JSynchronized {
 }
 
 type JTry struct {
+	Resources []*JLocalVariableDecl
 	Block *JBlock
 	Catches []*JCatch
 	Finally *JBlock
@@ -1032,6 +1057,22 @@ func NewJTry(block *JBlock, clist []JObject, finally *JBlock) *JTry {
 	return &JTry{Block: block, Catches: catches, Finally: finally}
 }
 
+func NewJTryWithResources(rlist []JObject, block *JBlock, clist []JObject,
+	finally *JBlock) *JTry {
+	try := NewJTry(block, clist, finally)
+
+	try.Resources = make([]*JLocalVariableDecl, len(rlist))
+	for i, r := range rlist {
+		if rsrc, ok := r.(*JLocalVariableDecl); !ok {
+			ReportCastError(fmt.Sprintf("JLocalVariableDecl#%d", i), r)
+		} else {
+			try.Resources[i] = rsrc
+		}
+	}
+
+	return try
+}
+
 const (
 	TS_NONE = iota
 	TS_EXTENDS
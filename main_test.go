@@ -29,7 +29,7 @@ func TestLexer(t *testing.T) {
 
 		var tname string
 		if rtn >= 57346 {
-			tname = grammar.JulyToknames[rtn-57346]
+			tname = grammar.JulyToknames[rtn-grammar.IDENTIFIER+3]
 		} else {
 			tname = fmt.Sprintf("tok#%d (ch '%c')", rtn, byte(rtn))
 		}
@@ -22,13 +22,14 @@ func findMethod(owner GoMethodOwner, class GoMethodOwner, name string,
 	}
 
 	if mthd == nil {
+		// NewGoMethodReference() adds the reference to 'class'
 		mthd = NewGoMethodReference(class, name, arglist, verbose)
-		if owner != nil && !owner.IsNil() {
+		if class == nil || class.IsNil() {
+			if owner == nil || owner.IsNil() {
+				panic("Both class and owner are nil")
+			}
+
 			owner.AddMethod(mthd)
-		} else if class != nil && !class.IsNil() {
-			class.AddMethod(mthd)
-		} else {
-			panic("Both class and owner are nil")
 		}
 	}
 
@@ -68,6 +69,10 @@ func analyzeAllocationExpr(gs *GoState, owner GoMethodOwner,
 		}
 	}
 
+	if isAnonymousClassBody(alloc.Body) {
+		return analyzeAnonymousClass(gs, owner, alloc, args)
+	}
+
 	alloc_name := alloc.Name.String()
 
 	var cref GoClass
@@ -75,23 +80,6 @@ func analyzeAllocationExpr(gs *GoState, owner GoMethodOwner,
 	var body []GoStatement
 	for _, b := range alloc.Body {
 		switch j := b.(type) {
-		case *grammar.JClassBody:
-			gs2 := NewGoState(gs)
-
-			c2 := NewGoClassDefinition(gs.Program(), owner, alloc_name)
-			analyzeClassBody(gs2, c2, j)
-
-			var anon_name string
-			for i := 0; ; i++ {
-				anon_name = fmt.Sprintf("Anonymous_%s_%d", alloc_name, i)
-				if gs.findClass(owner, anon_name) == nil {
-					break
-				}
-			}
-			c2.name = anon_name
-
-			gs.addClass(c2)
-			cref = c2
 		case *grammar.JBlock:
 			stmt := analyzeBlock(gs, owner, j)
 			if stmt != nil {
@@ -132,6 +120,23 @@ func analyzeAllocationExpr(gs *GoState, owner GoMethodOwner,
 		}
 	}
 
+	if cls, ok := cref.(*GoClassDefinition); ok && cls.outer != nil {
+		// pass the enclosing instance to inner class constructors
+		outer := gs.enclosingInstance(cls.parent.(*GoClassDefinition))
+		if outer == nil {
+			if gs.Program().verbose {
+				log.Printf("//ERR// No enclosing instance for inner"+
+					" class %v\n", cls.name)
+			} else {
+				log.Printf("//ERR// No enclosing instance for inner class\n")
+			}
+
+			outer = NewGoLiteral("nil")
+		}
+
+		args = append([]GoExpr{outer}, args...)
+	}
+
 	arglist := &GoMethodArguments{args}
 	mthd := findMethod(owner, cref, "New"+cref.Name(), arglist,
 		gs.Program().verbose)
@@ -140,6 +145,119 @@ func analyzeAllocationExpr(gs *GoState, owner GoMethodOwner,
 		args: args, body: body}
 }
 
+// return true if the allocation body declares class members
+func isAnonymousClassBody(body []grammar.JObject) bool {
+	for _, b := range body {
+		if _, ok := b.(*grammar.JClassBody); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// return true if the anonymous class body contains a single method
+// and can be translated to a function adapter
+func isSingleMethodBody(body []grammar.JObject) bool {
+	var num_methods int
+	for _, b := range body {
+		switch j := b.(type) {
+		case *grammar.JClassBody:
+			for _, bobj := range j.List {
+				if _, ok := bobj.(*grammar.JMethodDecl); !ok {
+					return false
+				}
+				num_methods++
+			}
+		case *grammar.JEmpty:
+			// ignore empty declarations
+		default:
+			return false
+		}
+	}
+
+	return num_methods == 1
+}
+
+// translate an anonymous class into a named Go type, either a function
+// adapter (for single-method interfaces) or a struct which captures any
+// local variables used by the class
+func analyzeAnonymousClass(gs *GoState, owner GoMethodOwner,
+	alloc *grammar.JClassAllocationExpr, args []GoExpr) *GoClassAlloc {
+	gp := gs.Program()
+
+	cls := NewGoClassDefinition(gp, owner, gp.anonymousClassName(owner))
+
+	alloc_name := alloc.Name.String()
+	if sup, ok := gp.findClass(alloc_name).(*GoClassDefinition); ok {
+		cls.super = sup
+	} else {
+		iface := gp.findInterface(alloc.Name)
+		if iface == nil {
+			iface = gp.addInterfaceReference(alloc.Name)
+		}
+		cls.interfaces = []GoInterface{iface}
+	}
+
+	if len(args) > 0 {
+		if gp.verbose {
+			log.Printf("//ERR// Ignoring %d constructor args for anonymous"+
+				" %v class %v\n", len(args), alloc_name, cls.name)
+		} else {
+			log.Printf("//ERR// Ignoring anonymous class ctor args\n")
+		}
+	}
+
+	gs2 := NewGoState(gs)
+	gs2.class = cls
+
+	if cls.super == nil && isSingleMethodBody(alloc.Body) {
+		// closures capture local variables, so no extra work is needed
+		cls.is_adapter = true
+
+		if gp.config.isFuncType(alloc_name) {
+			cls.func_type = alloc_name
+		}
+	} else {
+		gs2.capture = cls
+	}
+
+	for _, b := range alloc.Body {
+		switch j := b.(type) {
+		case *grammar.JClassBody:
+			analyzeClassBody(gs2, cls, j)
+		case *grammar.JBlock:
+			if blk := analyzeBlock(gs2, cls, j); blk != nil {
+				cls.addInitializer(blk)
+			}
+		case *grammar.JEmpty:
+			// do nothing
+		default:
+			grammar.ReportCastError("JClassAllocationExpr.body", b)
+		}
+	}
+
+	gp.addClass(cls)
+
+	var cargs []GoExpr
+	for _, c := range cls.captures {
+		cargs = append(cargs, c.outer)
+	}
+
+	var ctype *TypeData
+	if cls.func_type != "" {
+		ctype = &TypeData{vtype: VT_INTERFACE, vclass: cls.func_type}
+	} else if cls.is_adapter {
+		ctype = &TypeData{vtype: VT_INTERFACE, vclass: cls.name}
+	} else {
+		ctype = &TypeData{vtype: VT_CLASS, vclass: cls.name}
+	}
+
+	mthd := NewGoFakeMethod(cls, "New"+cls.name, ctype)
+
+	return &GoClassAlloc{class: cls, method: mthd, args: cargs}
+}
+
 func analyzeArrayAlloc(gs *GoState, owner GoMethodOwner, aa *grammar.JArrayAlloc,
 	govar GoVar) GoArrayExpr {
 	td := gs.Program().createTypeData(aa.Typename, nil, aa.Dims)
@@ -392,10 +510,28 @@ func analyzeBranchStmt(gs *GoState, owner GoMethodOwner, tok token.Token,
 }
 
 func analyzeCastExpr(gs *GoState, owner GoMethodOwner,
-	cex *grammar.JCastExpr) *GoCastType {
+	cex *grammar.JCastExpr) GoExpr {
 	td := gs.Program().createTypeData(cex.Reftype.Name,
 		cex.Reftype.TypeArgs, cex.Reftype.Dims)
-	return &GoCastType{target: analyzeExpr(gs, owner, cex.Target), casttype: td}
+	target := analyzeExpr(gs, owner, cex.Target)
+	if td.isPrimitive() && !isObjectValue(target) {
+		// casts between primitive types are Go conversions
+		return NewGoTypeConversion(target, td)
+	}
+
+	return &GoCastType{target: target, casttype: td}
+}
+
+// return true if 'expr' holds an arbitrary object, so casting it
+// requires a type assertion rather than a conversion
+func isObjectValue(expr GoExpr) bool {
+	if expr == nil {
+		return false
+	}
+
+	td := expr.VarType()
+	return td != nil && (td.vtype == VT_GENERIC_OBJECT ||
+		td.vtype == VT_INTERFACE)
 }
 
 func analyzeClassBody(gs *GoState, cls *GoClassDefinition, body *grammar.JClassBody) {
@@ -421,9 +557,10 @@ func analyzeClassBody(gs *GoState, cls *GoClassDefinition, body *grammar.JClassB
 			govar := gs.addVariableDecl(b, true)
 
 			if b.Init == nil {
-				cls.addVar(&GoVarInit{govar: govar})
+				cls.addVar(gs.Program(), &GoVarInit{govar: govar})
 			} else {
-				cls.addVar(analyzeVariableInit(gs, cls, b.Init, govar))
+				cls.addVar(gs.Program(),
+					analyzeVariableInit(gs, cls, b.Init, govar))
 			}
 		default:
 			grammar.ReportCastError("Body.ClassDecl", bobj)
@@ -435,6 +572,9 @@ func analyzeConstant(gs *GoState, owner GoMethodOwner, jcon *grammar.JConstantDe
 	ctype := gs.Program().createTypeData(jcon.TypeSpec.Name,
 		jcon.TypeSpec.TypeArgs, jcon.Dims)
 	init := analyzeVariableInit(gs, owner, jcon.Init, nil)
+	if init.expr != nil {
+		init.expr = foldConstantInt(gs.Program(), ctype, init.expr, nil)
+	}
 	owner.AddConstant(&GoConstant{name: jcon.Name, typedata: ctype, init: init})
 }
 
@@ -459,10 +599,15 @@ func analyzeExpr(gs *GoState, owner GoMethodOwner, obj grammar.JObject) GoExpr {
 		}
 		return &GoUnimplemented{fname: "expr", text: fmt.Sprintf("%T", e)}
 	case *grammar.JInstanceOf:
-		return &GoInstanceOf{expr: analyzeExpr(gs, owner, e.Obj),
-			vartype: analyzeReferenceType(gs, e.TypeSpec)}
+		return analyzeInstanceOf(gs, owner, e)
 	case *grammar.JKeyword:
-		return NewGoKeyword(e.Token, e.Name)
+		kwd := NewGoKeyword(e.Token, e.Name)
+		if e.Token == grammar.THIS && gs.Class() != nil {
+			kwd.vartype = &TypeData{vtype: VT_CLASS, vclass: gs.Class().name}
+			kwd.rcvr = gs.Receiver()
+		}
+
+		return kwd
 	case *grammar.JLiteral:
 		return NewGoLiteral(e.Text)
 	case *grammar.JMethodAccess:
@@ -590,9 +735,14 @@ func analyzeForVar(gs *GoState, owner GoMethodOwner, jfv *grammar.JForVar) *GoFo
 }
 
 func analyzeIfElseStmt(gs *GoState, owner GoMethodOwner,
-	ifelse *grammar.JIfElseStmt) *GoIfElse {
+	ifelse *grammar.JIfElseStmt) GoStatement {
 
-	stmts := analyzeStmt(gs, owner, ifelse.IfBlock)
+	// pattern variables are only visible in the 'if' block
+	gs2 := NewGoState(gs)
+
+	cond := analyzeExpr(gs2, owner, ifelse.Cond)
+
+	stmts := analyzeStmt(gs2, owner, ifelse.IfBlock)
 	if stmts == nil || len(stmts) == 0 {
 		panic("IfStmt body cannot be nil")
 	}
@@ -604,8 +754,7 @@ func analyzeIfElseStmt(gs *GoState, owner GoMethodOwner,
 		ifblk = &GoBlock{stmts: stmts}
 	}
 
-	ifstmt := &GoIfElse{cond: analyzeExpr(gs, owner, ifelse.Cond),
-		ifblk: ifblk}
+	ifstmt := &GoIfElse{cond: cond, ifblk: ifblk}
 
 	if ifelse.ElseBlock != nil {
 		stmts = analyzeStmt(gs, owner, ifelse.ElseBlock)
@@ -618,9 +767,55 @@ func analyzeIfElseStmt(gs *GoState, owner GoMethodOwner,
 		}
 	}
 
+	if tsw := makeTypeSwitch(ifstmt); tsw != nil {
+		return tsw
+	}
+
 	return ifstmt
 }
 
+// convert "if (x instanceof A) ... else if (x instanceof B) ..." into
+// a type switch, or return nil if 'ifstmt' doesn't test a type
+func makeTypeSwitch(ifstmt *GoIfElse) *GoTypeSwitch {
+	gio, ok := ifstmt.cond.(*GoInstanceOf)
+	if !ok || ifstmt.elseblk == nil {
+		return nil
+	}
+
+	tcase := &GoTypeCase{test: gio, body: ifstmt.ifblk}
+
+	switch e := ifstmt.elseblk.(type) {
+	case *GoTypeSwitch:
+		if gio.sameTarget(e.cases[0].test) {
+			e.cases = append([]*GoTypeCase{tcase}, e.cases...)
+			return e
+		}
+	case *GoIfElse:
+		if gio2, ok := e.cond.(*GoInstanceOf); ok && gio.sameTarget(gio2) {
+			return &GoTypeSwitch{expr: gio.expr, cases: []*GoTypeCase{tcase,
+				&GoTypeCase{test: gio2, body: e.ifblk}}, dflt: e.elseblk}
+		}
+	}
+
+	return nil
+}
+
+func analyzeInstanceOf(gs *GoState, owner GoMethodOwner,
+	inst *grammar.JInstanceOf) *GoInstanceOf {
+	gio := &GoInstanceOf{expr: analyzeExpr(gs, owner, inst.Obj),
+		typedata: gs.Program().createTypeData(inst.TypeSpec.Name,
+			inst.TypeSpec.TypeArgs, inst.TypeSpec.Dims),
+		program: gs.Program()}
+
+	if inst.Name != "" {
+		// the pattern variable is only used inside the 'if' block
+		gio.binding = gs.addVariable(inst.Name, nil, 0, inst.TypeSpec,
+			false)
+	}
+
+	return gio
+}
+
 func analyzeLabelledStmt(gs *GoState, owner GoMethodOwner,
 	ls *grammar.JLabeledStatement) *GoLabeledStmt {
 	stmts := analyzeStmt(gs, owner, ls.Stmt)
@@ -682,10 +877,24 @@ func analyzeLocalVariableInternal(gs *GoState, owner GoMethodOwner,
 	cex, ok := vardec.Init.Expr.(*grammar.JCastExpr)
 	if !ok {
 		init := analyzeExpr(gs, owner, vardec.Init.Expr)
+		if gca, ok := init.(*GoClassAlloc); ok {
+			// the variable holds the anonymous class's adapter, so
+			// calls use the adapter's methods
+			cd, ok := gca.class.(*GoClassDefinition)
+			if gvd, vok := govar.(*GoVarData); ok && vok && cd.is_adapter &&
+				cd.func_type == "" {
+				gvd.vartype = gca.VarType()
+			}
+		}
 		return NewGoLocalVarInit(govar, init)
 	}
 
-	return NewGoLocalVarCast(govar, analyzeCastExpr(gs, owner, cex))
+	cast := analyzeCastExpr(gs, owner, cex)
+	if _, ok := cast.(*GoCastType); !ok {
+		return NewGoLocalVarInit(govar, cast)
+	}
+
+	return NewGoLocalVarCast(govar, cast)
 }
 
 func analyzeMethodAccess(gs *GoState, owner GoMethodOwner,
@@ -708,8 +917,26 @@ func analyzeMethodAccess(gs *GoState, owner GoMethodOwner,
 
 	var expr GoExpr
 	if mth.NameObj != nil {
+		if kwd, ok := mth.NameObj.(*grammar.JKeyword); ok &&
+			kwd.Token == grammar.SUPER {
+			// super.method() calls the embedded superclass's method
+			if sup := analyzeSuper(gs); sup != nil {
+				mthd := findMethod(gs.Class().super, gs.Class().super,
+					mth.Method, arglist, gs.Program().verbose)
+				return &GoMethodAccessExpr{expr: sup, method: mthd,
+					args: arglist}
+			}
+		}
+
 		expr = analyzeExpr(gs, owner, mth.NameObj)
 
+		if govar, ok := expr.(GoVar); ok {
+			if macc := analyzeFuncTypeCall(gs, govar, mth.Method,
+				arglist); macc != nil {
+				return macc
+			}
+		}
+
 		var class GoMethodOwner
 		if macc, ok := expr.(*GoMethodAccess); ok {
 			class = macc.method.Class()
@@ -732,13 +959,41 @@ func analyzeMethodAccess(gs *GoState, owner GoMethodOwner,
 	var govar GoVar
 	if mth.NameType == nil {
 		class = nilMethodOwner
+
+		if macc := findOuterMethod(gs, owner, mth.Method,
+			arglist); macc != nil {
+			return macc
+		}
+
+		if macc := findInterfaceMethod(gs, owner, mth.Method,
+			arglist); macc != nil {
+			return macc
+		}
 	} else {
 		govar = gs.findVariable(mth.NameType)
 		if govar != nil {
+			if macc := analyzeFuncTypeCall(gs, govar, mth.Method,
+				arglist); macc != nil {
+				return macc
+			}
+
 			class = owner
+			if td := govar.VarType(); td != nil && td.isObject() {
+				// look for overloads in the variable's class (abstract
+				// class variables are typed by the class's interface)
+				if cd, ok := gs.findClass(owner,
+					td.vclass).(*GoClassDefinition); ok {
+					class = cd
+				}
+			}
 		} else {
 			class = gs.findClass(owner, mth.NameType.LastType())
 			if class == nil {
+				if macc := findInterfaceFunction(gs, mth.NameType,
+					mth.Method, arglist); macc != nil {
+					return macc
+				}
+
 				fcls := NewGoFakeClass(mth.NameType.String())
 				gs.Program().addClass(fcls)
 				class = fcls
@@ -758,6 +1013,14 @@ func analyzeMethodAccess(gs *GoState, owner GoMethodOwner,
 func analyzeNameDotObject(gs *GoState, ndo *grammar.JNameDotObject) GoExpr {
 	switch o := ndo.Obj.(type) {
 	case *grammar.JKeyword:
+		if o.Token == grammar.THIS {
+			if outer := analyzeOuterThis(gs, ndo.Name); outer != nil {
+				return outer
+			}
+		} else if o.Token == grammar.CLASS {
+			return &GoClassLiteral{name: ndo.Name.String()}
+		}
+
 		log.Printf("//ERR// Not converting ndoobj %T (kwd %s)\n", ndo.Obj, o.Name)
 	default:
 		log.Printf("//ERR// Not converting ndoobj %T (%T) to Expr\n", ndo, ndo.Obj)
@@ -797,6 +1060,13 @@ func analyzeObjectDotName(gs *GoState, owner GoMethodOwner,
 
 			return NewGoSelector(rvar, ref)
 		} else if o.Token == grammar.SUPER && gs.Receiver() != "" {
+			if sup := analyzeSuper(gs); sup != nil {
+				ref := gs.Class().super.findVariable(odn.Name)
+				if ref != nil {
+					return NewGoSelector(sup, ref)
+				}
+			}
+
 			log.Printf("//ERR// Not converting odnobj super\n")
 			return NewFakeVar("<<super>>", nil, 0)
 		} else {
@@ -804,11 +1074,171 @@ func analyzeObjectDotName(gs *GoState, owner GoMethodOwner,
 				odn.Obj, o.Name)
 			return NewFakeVar(fmt.Sprintf("<<%v>>", o.Name), nil, 0)
 		}
+	case *grammar.JCastExpr:
+		// ((Foo) obj).name
+		cast := analyzeCastExpr(gs, owner, o)
+		if td := cast.VarType(); td != nil && td.vtype == VT_CLASS {
+			if cd, ok := gs.findClass(owner,
+				td.vclass).(*GoClassDefinition); ok {
+				if ref := cd.findField(odn.Name.String()); ref != nil {
+					return NewGoSelector(cast, ref)
+				}
+			}
+		}
+
+		return NewObjectDotName(odn, cast, gs)
+	case *grammar.JNameDotObject:
+		if kwd, ok := o.Obj.(*grammar.JKeyword); ok &&
+			kwd.Token == grammar.THIS {
+			// Outer.this.name
+			if outer := analyzeOuterThis(gs, o.Name); outer != nil {
+				cls := gs.findClass(gs.Class(), o.Name.LastType())
+				ref := cls.(*GoClassDefinition).findField(odn.Name.String())
+				if ref != nil {
+					return NewGoSelector(outer, ref)
+				}
+			}
+		}
+
+		return NewObjectDotName(odn, analyzeExpr(gs, owner, odn.Obj), gs)
 	default:
 		return NewObjectDotName(odn, analyzeExpr(gs, owner, odn.Obj), gs)
 	}
 }
 
+// return the embedded superclass of the current receiver
+func analyzeSuper(gs *GoState) GoExpr {
+	cls := gs.Class()
+	if cls == nil || cls.super == nil {
+		return nil
+	}
+
+	rcvr := gs.findVariableString(gs.Receiver())
+	if rcvr == nil {
+		return nil
+	}
+
+	name := cls.super.Name()
+	field := &GoVarData{name: name, goname: name,
+		vartype: &TypeData{vtype: VT_CLASS, vclass: name}}
+
+	return NewGoSelector(rcvr, field)
+}
+
+// return the enclosing instance referred to by 'Outer.this'
+func analyzeOuterThis(gs *GoState, name *grammar.JTypeName) GoExpr {
+	cls, ok := gs.findClass(gs.Class(), name.LastType()).(*GoClassDefinition)
+	if !ok {
+		return nil
+	}
+
+	return gs.enclosingInstance(cls)
+}
+
+// if 'name' is a method of a class enclosing an inner class, return
+// a call to the method through the enclosing instance
+func findOuterMethod(gs *GoState, owner GoMethodOwner, name string,
+	arglist *GoMethodArguments) GoExpr {
+	cls, ok := owner.(*GoClassDefinition)
+	if !ok || cls.outer == nil || cls.FindMethod(name, arglist) != nil {
+		return nil
+	}
+
+	for outer := cls; outer.outer != nil; {
+		pcls, ok := outer.parent.(*GoClassDefinition)
+		if !ok {
+			break
+		}
+
+		if mthd := pcls.FindMethod(name, arglist); mthd != nil {
+			if mthd.MethodType() == mt_static {
+				return &GoMethodAccess{method: mthd, args: arglist}
+			}
+
+			if instance := gs.enclosingInstance(pcls); instance != nil {
+				return &GoMethodAccessExpr{expr: instance, method: mthd,
+					args: arglist}
+			}
+
+			break
+		}
+
+		outer = pcls
+	}
+
+	return nil
+}
+
+// calling the method of a function type value calls the function
+func analyzeFuncTypeCall(gs *GoState, govar GoVar, name string,
+	arglist *GoMethodArguments) GoExpr {
+	td := govar.VarType()
+	if td == nil || td.vtype != VT_INTERFACE ||
+		!gs.Program().config.isFuncType(td.vclass) {
+		return nil
+	}
+
+	var mthd GoMethod
+	if gi := gs.Program().findFuncType(td.vclass); gi != nil {
+		mthd = gi.funcTypeMethod()
+	}
+	if mthd == nil {
+		// function type is defined in another file
+		mthd = NewGoFakeMethod(nilMethodOwner, name, nil)
+	}
+
+	return &GoMethodAccessExpr{expr: govar, method: mthd, args: arglist,
+		call_value: true}
+}
+
+// an unqualified call inside a default interface method either calls
+// another method on the interface value or a static interface function
+func findInterfaceMethod(gs *GoState, owner GoMethodOwner, name string,
+	arglist *GoMethodArguments) GoExpr {
+	gi, ok := owner.(*GoInterfaceDefinition)
+	if !ok {
+		return nil
+	}
+
+	mthd := gi.FindMethod(name, arglist)
+	if mthd == nil {
+		return nil
+	}
+
+	if mthd.MethodType() == mt_static {
+		return &GoMethodAccess{method: mthd, args: arglist}
+	}
+
+	rcvr := gs.findVariableString(gs.Receiver())
+	if rcvr == nil {
+		return nil
+	}
+
+	return &GoMethodAccessVar{govar: rcvr, method: mthd, args: arglist}
+}
+
+// return a call to a static interface function such as 'Iface.name()'
+func findInterfaceFunction(gs *GoState, iname *grammar.JTypeName,
+	name string, arglist *GoMethodArguments) GoExpr {
+	var gi *GoInterfaceDefinition
+	switch iface := gs.Program().findInterface(iname).(type) {
+	case *GoInterfaceDefinition:
+		gi = iface
+	case *GoInterfaceReference:
+		gi = iface.realiface
+	}
+
+	if gi == nil {
+		return nil
+	}
+
+	if mthd := gi.findFunction(name, arglist); mthd != nil {
+		return &GoMethodAccess{method: mthd, args: arglist}
+	}
+
+	return nil
+}
+
 func analyzeReferenceType(gs *GoState, ref *grammar.JReferenceType) GoVar {
 	if ref.TypeArgs != nil && len(ref.TypeArgs) > 0 {
 		fmt.Sprintf("//ERR// Not handling reftype type_args in %v\n", ref.Name)
@@ -983,7 +1413,49 @@ func analyzeSynchronized(gs *GoState, owner GoMethodOwner,
 }
 
 func analyzeTry(gs *GoState, owner GoMethodOwner, try *grammar.JTry) *GoTry {
-	gt := &GoTry{block: analyzeBlock(NewGoState(gs), owner, try.Block)}
+	// resources are only visible inside the try block
+	blkstate := NewGoState(gs)
+
+	var resources []*GoTryResource
+	for _, r := range try.Resources {
+		for _, stmt := range analyzeLocalVariableDeclaration(blkstate, owner,
+			r) {
+			resources = append(resources, analyzeTryResource(blkstate, owner,
+				stmt))
+		}
+	}
+
+	gt := &GoTry{resources: resources,
+		block: analyzeBlock(blkstate, owner, try.Block)}
+
+	if len(resources) > 0 {
+		// a 'return' would only leave the function literal, so the
+		// value is carried out of the literal and returned after it
+		gt.block.RunTransform(func(parent GoObject, prog *GoProgram,
+			cls GoClass, object GoObject) (GoObject, bool) {
+			if rtn, ok := object.(*GoReturn); ok {
+				gt.returns = true
+				return &GoTryReturn{expr: rtn.expr, try: gt}, false
+			} else if inner, ok := object.(*GoTry); ok && inner.returns &&
+				inner.outer == nil {
+				gt.returns = true
+				gt.flagged = gt.flagged || inner.flagged
+				inner.outer = gt
+			}
+
+			return nil, true
+		}, gs.Program(), nil, gt)
+
+		if n := len(gt.block.stmts); n > 0 {
+			switch last := gt.block.stmts[n-1].(type) {
+			case *GoTryReturn:
+				gt.always = true
+			case *GoTry:
+				gt.always = last.always
+			}
+		}
+		gt.flagged = gt.flagged || (gt.returns && !gt.always)
+	}
 
 	if try.Catches != nil && len(try.Catches) > 0 {
 		gs2 := NewGoState(gs)
@@ -1017,6 +1489,23 @@ func analyzeTry(gs *GoState, owner GoMethodOwner, try *grammar.JTry) *GoTry {
 	return gt
 }
 
+func analyzeTryResource(gs *GoState, owner GoMethodOwner,
+	stmt GoStatement) *GoTryResource {
+	rsrc := &GoTryResource{stmt: stmt}
+	if lvi, ok := stmt.(*GoLocalVarInit); ok {
+		rsrc.govar = lvi.govar
+	} else {
+		panic(fmt.Sprintf("Unexpected try-with-resources statement %v<%T>",
+			stmt, stmt))
+	}
+
+	if td := rsrc.govar.VarType(); td != nil && td.vtype == VT_CLASS {
+		rsrc.class = gs.findClass(owner, td.vclass)
+	}
+
+	return rsrc
+}
+
 func analyzeUnaryExpr(gs *GoState, owner GoMethodOwner, uexpr *grammar.JUnaryExpr) *GoUnaryExpr {
 	var op token.Token
 	switch uexpr.Op {
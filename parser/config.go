@@ -12,21 +12,54 @@ import (
 
 // configuration file
 type Config struct {
+	charModel string
+	decimalModel string
+	nestedModel string
+	funcTypeMap map[string]string
+	funcTypeList []string
 	interfaceMap map[string]string
 	interfaceList []string
+	methodMap map[string]string
+	methodList []string
 	packageMap map[string]string
 	packageList []string
 	receiverMap map[string]string
 	receiverList []string
 }
 
+// keyword for choosing the Go type used for Java 'char' values
+const typeCharType = "CHARTYPE"
+// keyword for choosing the math/big type used for BigDecimal values
+const typeDecimalType = "DECIMALTYPE"
+// keyword for translating single-method interfaces into function types
+const typeFuncType = "FUNCTYPE"
 // keyword for defining Java interfaces
 const typeInterface = "INTERFACE"
+// keyword for naming a method, usually an overloaded method
+const typeMethod = "METHOD"
+// keyword for choosing how nested class names are built
+const typeNestedClass = "NESTEDCLASS"
 // keyword for mapping Java package names to Go names
 const typePackage = "PACKAGE"
 // keyword for declaring receiver names for a class
 const typeReceiver = "RECEIVER"
 
+// Java 'char' is mapped to 'rune' and strings are indexed as '[]rune(s)'
+const charModelRune = "rune"
+// Java 'char' is mapped to 'byte' and strings are indexed directly
+// (only safe for ASCII-only code)
+const charModelByte = "byte"
+
+// BigDecimal is mapped to '*big.Float' (rounded to the value's precision)
+const decimalModelFloat = "float"
+// BigDecimal is mapped to '*big.Rat' (exact, but slower)
+const decimalModelRat = "rat"
+
+// nested class Inner inside Outer is named "Outer_Inner"
+const nestedModelUnderscore = "underscore"
+// nested class Inner inside Outer is named "OuterInner"
+const nestedModelConcat = "concat"
+
 func addEntry(entryMap map[string]string, typeName string, key string,
 	val string) map[string]string {
 	if entryMap == nil {
@@ -43,11 +76,21 @@ func addEntry(entryMap map[string]string, typeName string, key string,
 	return entryMap
 }
 
+func (cfg *Config) addFuncType(name string) {
+	cfg.funcTypeMap = addEntry(cfg.funcTypeMap, typeFuncType, name, name)
+	cfg.funcTypeList = nil
+}
+
 func (cfg *Config) addInterface(name string) {
 	cfg.interfaceMap = addEntry(cfg.interfaceMap, typeInterface, name, name)
 	cfg.interfaceList = nil
 }
 
+func (cfg *Config) addMethod(name string, value string) {
+	cfg.methodMap = addEntry(cfg.methodMap, typeMethod, name, value)
+	cfg.methodList = nil
+}
+
 func (cfg *Config) addPackage(name string, value string) {
 	cfg.packageMap = addEntry(cfg.packageMap, typePackage, name, value)
 	cfg.packageList = nil
@@ -58,6 +101,33 @@ func (cfg *Config) addReceiver(name string, value string) {
 	cfg.receiverList = nil
 }
 
+func (cfg *Config) setCharModel(name string) {
+	if cfg.charModel != "" {
+		log.Printf("Overwriting %s value %s with %s\n", typeCharType,
+			cfg.charModel, name)
+	}
+
+	cfg.charModel = name
+}
+
+func (cfg *Config) setDecimalModel(name string) {
+	if cfg.decimalModel != "" {
+		log.Printf("Overwriting %s value %s with %s\n", typeDecimalType,
+			cfg.decimalModel, name)
+	}
+
+	cfg.decimalModel = name
+}
+
+func (cfg *Config) setNestedModel(name string) {
+	if cfg.nestedModel != "" {
+		log.Printf("Overwriting %s value %s with %s\n", typeNestedClass,
+			cfg.nestedModel, name)
+	}
+
+	cfg.nestedModel = name
+}
+
 func getValue(entryMap map[string]string, key string) string {
 	if entryMap != nil {
 		if val, ok := entryMap[key]; ok {
@@ -87,12 +157,62 @@ func ReadConfig(name string) *Config {
 		}
 
 		switch strings.ToUpper(flds[0]) {
+		case typeCharType:
+			if len(flds) != 2 {
+				log.Printf("Bad config line: %s\n", scan.Text())
+			} else {
+				switch strings.ToLower(flds[1]) {
+				case charModelRune, charModelByte:
+					cfg.setCharModel(strings.ToLower(flds[1]))
+				default:
+					log.Printf("Bad %s value \"%s\"\n", typeCharType,
+						flds[1])
+				}
+			}
+		case typeDecimalType:
+			if len(flds) != 2 {
+				log.Printf("Bad config line: %s\n", scan.Text())
+			} else {
+				switch strings.ToLower(flds[1]) {
+				case decimalModelFloat, decimalModelRat:
+					cfg.setDecimalModel(strings.ToLower(flds[1]))
+				default:
+					log.Printf("Bad %s value \"%s\"\n", typeDecimalType,
+						flds[1])
+				}
+			}
+		case typeFuncType:
+			if len(flds) != 2 {
+				log.Printf("Bad config line: %s\n", scan.Text())
+			} else {
+				cfg.addFuncType(flds[1])
+			}
 		case typeInterface:
 			if len(flds) != 2 {
 				log.Printf("Bad config line: %s\n", scan.Text())
 			} else {
 				cfg.addInterface(flds[1])
 			}
+		case typeMethod:
+			// parameter lists may contain spaces, e.g. "write(int, int)"
+			if len(flds) < 4 || flds[len(flds)-2] != "->" {
+				log.Printf("Bad config line: %s\n", scan.Text())
+			} else {
+				cfg.addMethod(strings.Join(flds[1:len(flds)-2], ""),
+					flds[len(flds)-1])
+			}
+		case typeNestedClass:
+			if len(flds) != 2 {
+				log.Printf("Bad config line: %s\n", scan.Text())
+			} else {
+				switch strings.ToLower(flds[1]) {
+				case nestedModelUnderscore, nestedModelConcat:
+					cfg.setNestedModel(strings.ToLower(flds[1]))
+				default:
+					log.Printf("Bad %s value \"%s\"\n", typeNestedClass,
+						flds[1])
+				}
+			}
 		case typePackage:
 			if len(flds) != 4 {
 				log.Printf("Bad config line: %s\n", scan.Text())
@@ -115,6 +235,26 @@ func ReadConfig(name string) *Config {
 func (cfg *Config) Dump(out io.Writer) {
 	need_nl := false
 
+	if cfg.charModel != "" {
+		fmt.Fprintln(out, "# Go type used for Java 'char' values")
+		fmt.Fprintf(out, "%v %v\n", typeCharType, cfg.charModel)
+		need_nl = true
+	}
+
+	if cfg.decimalModel != "" {
+		if need_nl { fmt.Fprintln(out) }
+		fmt.Fprintln(out, "# math/big type used for BigDecimal values")
+		fmt.Fprintf(out, "%v %v\n", typeDecimalType, cfg.decimalModel)
+		need_nl = true
+	}
+
+	if cfg.nestedModel != "" {
+		if need_nl { fmt.Fprintln(out) }
+		fmt.Fprintln(out, "# naming scheme for nested classes")
+		fmt.Fprintf(out, "%v %v\n", typeNestedClass, cfg.nestedModel)
+		need_nl = true
+	}
+
 	if len(cfg.packageMap) > 0 {
 		if need_nl { fmt.Fprintln(out) }
 		fmt.Fprintln(out, "# map Java packages to Go packages")
@@ -135,6 +275,26 @@ func (cfg *Config) Dump(out io.Writer) {
 		need_nl = true
 	}
 
+	if len(cfg.funcTypeMap) > 0 {
+		if need_nl { fmt.Fprintln(out) }
+		fmt.Fprintln(out, "# single-method interfaces translated as" +
+			" function types")
+		for _, k := range cfg.funcTypes() {
+			fmt.Fprintf(out, "%v %v\n", typeFuncType, k)
+		}
+		need_nl = true
+	}
+
+	if len(cfg.methodMap) > 0 {
+		if need_nl { fmt.Fprintln(out) }
+		fmt.Fprintln(out, "# Go names for Java methods")
+		for _, k := range cfg.methodKeys() {
+			fmt.Fprintf(out, "%v %v -> %v\n", typeMethod, k,
+				cfg.methodName(k))
+		}
+		need_nl = true
+	}
+
 	if len(cfg.receiverMap) > 0 {
 		if need_nl { fmt.Fprintln(out) }
 		fmt.Fprintln(out, "# receiver name to use (other than 'rcvr')")
@@ -145,6 +305,38 @@ func (cfg *Config) Dump(out io.Writer) {
 	}
 }
 
+// return the Go type used for Java 'char' values
+func (cfg *Config) charType() string {
+	if cfg == nil || cfg.charModel == "" {
+		return charModelRune
+	}
+
+	return cfg.charModel
+}
+
+// return true if Java 'char' values are mapped to 'byte'
+func (cfg *Config) isByteCharModel() bool {
+	return cfg.charType() == charModelByte
+}
+
+// return the math/big type used for BigDecimal values
+func (cfg *Config) decimalType() string {
+	if cfg == nil || cfg.decimalModel == "" {
+		return decimalModelFloat
+	}
+
+	return cfg.decimalModel
+}
+
+// return the Go name for nested class 'inner' inside class 'outer'
+func (cfg *Config) nestedClassName(outer string, inner string) string {
+	if cfg != nil && cfg.nestedModel == nestedModelConcat {
+		return outer + inner
+	}
+
+	return outer + "_" + inner
+}
+
 func (cfg *Config) findPackage(str string) string {
 	var pstr string
 	var pval string
@@ -167,6 +359,21 @@ func (cfg *Config) findPackage(str string) string {
 	return pval + pextra
 }
 
+func (cfg *Config) funcTypes() []string {
+	if cfg.funcTypeList == nil {
+		cfg.funcTypeList = make([]string, len(cfg.funcTypeMap))
+		var i int
+		for k := range cfg.funcTypeMap {
+			cfg.funcTypeList[i] = k
+			i += 1
+		}
+
+		sort.Sort(sort.StringSlice(cfg.funcTypeList))
+	}
+
+	return cfg.funcTypeList
+}
+
 func (cfg *Config) interfaces() []string {
 	if cfg.interfaceList == nil {
 		cfg.interfaceList = make([]string, len(cfg.interfaceMap))
@@ -182,11 +389,45 @@ func (cfg *Config) interfaces() []string {
 	return cfg.interfaceList
 }
 
+// return true if interface 'name' is translated as a function type
+func (cfg *Config) isFuncType(name string) bool {
+	if cfg == nil {
+		return false
+	}
+
+	_, ok := cfg.funcTypeMap[name]
+	return ok
+}
+
 func (cfg *Config) isInterface(name string) bool {
 	_, ok := cfg.interfaceMap[name]
 	return ok
 }
 
+// return the Go name for a method such as "go_pkg.Class.write(int)"
+func (cfg *Config) methodName(key string) string {
+	if cfg == nil {
+		return ""
+	}
+
+	return getValue(cfg.methodMap, key)
+}
+
+func (cfg *Config) methodKeys() []string {
+	if cfg.methodList == nil {
+		cfg.methodList = make([]string, len(cfg.methodMap))
+		var i int
+		for k := range cfg.methodMap {
+			cfg.methodList[i] = k
+			i += 1
+		}
+
+		sort.Sort(sort.StringSlice(cfg.methodList))
+	}
+
+	return cfg.methodList
+}
+
 func (cfg *Config) packageName(key string) string {
 	return getValue(cfg.packageMap, key)
 }
@@ -14,6 +14,11 @@ func fillFile(t *testing.T) string {
 		t.Fatal("Cannot create temporary file")
 	}
 
+	f.WriteString("CHARTYPE byte\n")
+	f.WriteString("DECIMALTYPE rat\n")
+	f.WriteString("NESTEDCLASS concat\n")
+	f.WriteString("FUNCTYPE Listener\n")
+	f.WriteString("METHOD a.b.Point.write(int, int) -> WriteXY\n")
 	f.WriteString("PACKAGE a.b -> ab\n")
 	f.WriteString("PACKAGE a.b.c -> abc\n")
 	f.WriteString("INTERFACE a.b.DEF\n")
@@ -32,6 +37,16 @@ func Test_Config_Empty(t *testing.T) {
 	testutil.AssertEmpty(t, pkg, "Package() returned", pkg)
 	rcvr := cfg.receiver("foo")
 	testutil.AssertEmpty(t, rcvr, "Receiver() returned", rcvr)
+	chtype := cfg.charType()
+	testutil.AssertEqual(t, chtype, "rune")
+	dectype := cfg.decimalType()
+	testutil.AssertEqual(t, dectype, "float")
+	nested := cfg.nestedClassName("Outer", "Inner")
+	testutil.AssertEqual(t, nested, "Outer_Inner")
+	is_func := cfg.isFuncType("Listener")
+	testutil.AssertFalse(t, is_func, "isFuncType() returned true")
+	mname := cfg.methodName("a.b.Point.write(int,int)")
+	testutil.AssertEmpty(t, mname, "methodName() returned", mname)
 	str := cfg.String()
 	if !strings.HasPrefix(str, "Config[") || !strings.HasSuffix(str, "]") {
 		t.Fatal("String() returned", str)
@@ -60,4 +75,19 @@ func Test_Config_Full(t *testing.T) {
 	testutil.AssertEqual(t, rcvr, "xxx")
 	rcvr = cfg.receiver("a.b.ZZZ")
 	testutil.AssertEqual(t, rcvr, "zzz")
+
+	chtype := cfg.charType()
+	testutil.AssertEqual(t, chtype, "byte")
+
+	dectype := cfg.decimalType()
+	testutil.AssertEqual(t, dectype, "rat")
+
+	nested := cfg.nestedClassName("Outer", "Inner")
+	testutil.AssertEqual(t, nested, "OuterInner")
+
+	is_func := cfg.isFuncType("Listener")
+	testutil.AssertTrue(t, is_func, "Listener is not a function type")
+
+	mname := cfg.methodName("a.b.Point.write(int,int)")
+	testutil.AssertEqual(t, mname, "WriteXY")
 }
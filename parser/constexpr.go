@@ -0,0 +1,383 @@
+package parser
+
+import (
+	"go/constant"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+// return true if 'expr' can be evaluated at compile time and can therefore
+// be used as the value of a Go 'const'
+func isConstantExpr(expr GoExpr) bool {
+	switch e := expr.(type) {
+	case *GoLiteral:
+		return true
+	case *GoConstant:
+		return true
+	case *GoVarData:
+		return e.is_const
+	case *GoUnaryExpr:
+		if e.op == token.INC || e.op == token.DEC {
+			return false
+		}
+
+		return isConstantExpr(e.x)
+	case *GoBinaryExpr:
+		if !isConstantExpr(e.x) || !isConstantExpr(e.y) {
+			return false
+		}
+
+		if isStringConcat(e) {
+			// Go can only add strings to strings, so the only
+			// non-string values allowed are literals which can be
+			// turned into strings
+			return isConstantString(e.x) && isConstantString(e.y)
+		}
+
+		return true
+	case *GoTypeConversion:
+		if !isConstantExpr(e.target) {
+			return false
+		}
+
+		return isConstantConversion(e.target.VarType(), e.convtype)
+	}
+
+	return false
+}
+
+// return true if 'expr' is a constant string or a literal which can be
+// converted to a string
+func isConstantString(expr GoExpr) bool {
+	if lit, ok := expr.(*GoLiteral); ok {
+		return lit.isString() || literalAsString(lit) != ""
+	}
+
+	return isStringType(expr.VarType()) && isConstantExpr(expr)
+}
+
+// return true if a constant of type 'from' can be converted to type 'to'
+// by Go at compile time
+func isConstantConversion(from *TypeData, to *TypeData) bool {
+	if from == nil || to == nil || !from.isPrimitive() ||
+		!to.isPrimitive() {
+		return false
+	}
+
+	switch to.vtype {
+	case VT_FLOAT32, VT_FLOAT64:
+		return isNumericType(from)
+	case VT_BYTE, VT_CHAR, VT_INT16, VT_INT, VT_INT64:
+		// Go refuses to truncate floating point constants
+		return isIntegerType(from)
+	}
+
+	return from.vtype == to.vtype
+}
+
+func isIntegerType(td *TypeData) bool {
+	if td == nil {
+		return false
+	}
+
+	switch td.vtype {
+	case VT_BYTE, VT_CHAR, VT_INT16, VT_INT, VT_INT64:
+		return true
+	}
+
+	return false
+}
+
+func isNumericType(td *TypeData) bool {
+	if td == nil {
+		return false
+	}
+
+	return isIntegerType(td) || td.vtype == VT_FLOAT32 ||
+		td.vtype == VT_FLOAT64
+}
+
+// return true if this expression appends something to a string
+func isStringConcat(bex *GoBinaryExpr) bool {
+	return bex.op == token.ADD && (isStringType(bex.x.VarType()) ||
+		isStringType(bex.y.VarType()))
+}
+
+func isStringType(td *TypeData) bool {
+	return td != nil && td.vtype == VT_STRING
+}
+
+// return the quoted string Java would produce when appending this literal
+// to a string, or "" if the literal cannot be converted
+func literalAsString(lit *GoLiteral) string {
+	text := lit.text
+	if lit.isString() {
+		return text
+	} else if text[0] == '\'' {
+		if ch, _, _, err := strconv.UnquoteChar(text[1:len(text)-1], '\''); err == nil {
+			return strconv.Quote(string(ch))
+		}
+
+		return ""
+	} else if text == "true" || text == "false" {
+		return strconv.Quote(text)
+	}
+
+	text = strings.TrimRight(text, "lL")
+	if ival, err := strconv.ParseInt(text, 0, 64); err == nil {
+		return strconv.Quote(strconv.FormatInt(ival, 10))
+	}
+
+	return ""
+}
+
+// rewrite a constant string concatenation so every operand is a string
+// and adjacent string literals are merged
+func foldConstantString(expr GoExpr) GoExpr {
+	bex, ok := expr.(*GoBinaryExpr)
+	if !ok || !isStringConcat(bex) {
+		if lit, ok := expr.(*GoLiteral); ok && !lit.isString() {
+			return NewGoLiteral(literalAsString(lit))
+		}
+
+		return expr
+	}
+
+	x := foldConstantString(bex.x)
+	y := foldConstantString(bex.y)
+
+	if ylit, ok := y.(*GoLiteral); ok {
+		if xlit, ok := x.(*GoLiteral); ok {
+			return NewGoLiteral(joinStrings(xlit.text, ylit.text))
+		}
+
+		// merge into the rightmost literal of the left-hand side
+		if xbex, ok := x.(*GoBinaryExpr); ok {
+			if xylit, ok := xbex.y.(*GoLiteral); ok {
+				merged := NewGoLiteral(joinStrings(xylit.text, ylit.text))
+				return &GoBinaryExpr{x: xbex.x, op: token.ADD, y: merged}
+			}
+		}
+	}
+
+	return &GoBinaryExpr{x: x, op: token.ADD, y: y}
+}
+
+// return true if the type of a constant must be declared because Go's
+// default type for the constant value would be wrong
+func needConstantType(gp *GoProgram, td *TypeData, expr GoExpr) bool {
+	if td == nil {
+		return false
+	}
+
+	switch td.vtype {
+	case VT_BOOL, VT_INT, VT_FLOAT64, VT_STRING:
+		break
+	case VT_CHAR:
+		if gp.config.isByteCharModel() {
+			return true
+		}
+	default:
+		return true
+	}
+
+	return !td.Equals(expr.VarType())
+}
+
+// Java integer arithmetic wraps around at the width of each operation's
+// type while Go constants are exact (and fail to compile if they don't fit
+// the declared type), so replace an integer constant whose Java value
+// differs from Go's with a literal holding the Java value
+func foldConstantInt(gp *GoProgram, td *TypeData, expr GoExpr,
+	lookup func(GoVar) GoExpr) GoExpr {
+	if !isIntegerType(td) {
+		return expr
+	}
+
+	jval, _, gval, ok := evalConstantInt(gp, expr, lookup)
+	if !ok {
+		return expr
+	}
+
+	jval = wrapConstantInt(gp, jval, td.vtype)
+	if constant.Compare(jval, token.EQL, gval) {
+		return expr
+	}
+
+	text := jval.ExactString()
+	if td.vtype == VT_INT64 {
+		text += "L"
+	}
+
+	return NewGoLiteral(text)
+}
+
+// evaluate an integer constant expression, returning the value and type
+// Java would compute along with the value Go would compute
+func evalConstantInt(gp *GoProgram, expr GoExpr,
+	lookup func(GoVar) GoExpr) (constant.Value, VarType, constant.Value, bool) {
+	switch e := expr.(type) {
+	case *GoLiteral:
+		var val constant.Value
+		if e.text[0] == '\'' {
+			val = constant.MakeFromLiteral(e.text, token.CHAR, 0)
+		} else {
+			val = constant.MakeFromLiteral(strings.TrimRight(e.text, "lL"),
+				token.INT, 0)
+		}
+
+		vtype := e.VarType().vtype
+		if val.Kind() != constant.Int || !isIntegerType(e.VarType()) {
+			return nil, VT_VOID, nil, false
+		}
+
+		return wrapConstantInt(gp, val, vtype), vtype, val, true
+	case *GoConstant:
+		if e.init == nil || e.init.expr == nil {
+			break
+		}
+
+		return evalConstantInt(gp, e.init.expr, lookup)
+	case *GoVarData:
+		if lookup == nil {
+			break
+		}
+
+		if init := lookup(e); init != nil {
+			return evalConstantInt(gp, init, lookup)
+		}
+	case *GoUnaryExpr:
+		jx, xtype, gx, ok := evalConstantInt(gp, e.x, lookup)
+		if !ok {
+			break
+		}
+
+		switch e.op {
+		case token.ADD, token.SUB, token.XOR:
+			vtype := promoteInt(xtype, xtype)
+			jval := constant.UnaryOp(e.op, jx, 0)
+			return wrapConstantInt(gp, jval, vtype), vtype,
+				constant.UnaryOp(e.op, gx, 0), true
+		}
+	case *GoBinaryExpr:
+		jx, xtype, gx, ok := evalConstantInt(gp, e.x, lookup)
+		if !ok {
+			break
+		}
+
+		jy, ytype, gy, ok := evalConstantInt(gp, e.y, lookup)
+		if !ok {
+			break
+		}
+
+		switch e.op {
+		case token.SHL:
+			// Java only uses the low bits of the shift count
+			vtype := promoteInt(xtype, xtype)
+			bits := int64(31)
+			if vtype == VT_INT64 {
+				bits = 63
+			}
+
+			jcount, _ := constant.Int64Val(jy)
+			jval := constant.Shift(jx, token.SHL, uint(jcount&bits))
+
+			gval := constant.MakeUnknown()
+			if gcount, ok := constant.Int64Val(gy); ok && gcount >= 0 &&
+				gcount < 1024 {
+				gval = constant.Shift(gx, token.SHL, uint(gcount))
+			}
+
+			return wrapConstantInt(gp, jval, vtype), vtype, gval, true
+		case token.QUO, token.REM:
+			if constant.Sign(jy) == 0 || constant.Sign(gy) == 0 {
+				break
+			}
+
+			// QUO_ASSIGN asks for integer division
+			op := e.op
+			if op == token.QUO {
+				op = token.QUO_ASSIGN
+			}
+
+			vtype := promoteInt(xtype, ytype)
+			jval := constant.BinaryOp(jx, op, jy)
+			return wrapConstantInt(gp, jval, vtype), vtype,
+				constant.BinaryOp(gx, op, gy), true
+		case token.ADD, token.SUB, token.MUL, token.AND, token.OR,
+			token.XOR:
+			vtype := promoteInt(xtype, ytype)
+			jval := constant.BinaryOp(jx, e.op, jy)
+			return wrapConstantInt(gp, jval, vtype), vtype,
+				constant.BinaryOp(gx, e.op, gy), true
+		}
+	case *GoTypeConversion:
+		if !isIntegerType(e.convtype) {
+			break
+		}
+
+		jval, _, gval, ok := evalConstantInt(gp, e.target, lookup)
+		if !ok {
+			break
+		}
+
+		// Go refuses to convert a constant which doesn't fit
+		vtype := e.convtype.vtype
+		if !constant.Compare(gval, token.EQL,
+			wrapConstantInt(gp, gval, vtype)) {
+			gval = constant.MakeUnknown()
+		}
+
+		return wrapConstantInt(gp, jval, vtype), vtype, gval, true
+	}
+
+	return nil, VT_VOID, nil, false
+}
+
+// return the type of a Java arithmetic operation on these integer types
+func promoteInt(xtype VarType, ytype VarType) VarType {
+	if xtype == VT_INT64 || ytype == VT_INT64 {
+		return VT_INT64
+	}
+
+	return VT_INT
+}
+
+// truncate 'val' to the width of the Go type used for 'vtype'
+func wrapConstantInt(gp *GoProgram, val constant.Value,
+	vtype VarType) constant.Value {
+	var bits uint
+	signed := true
+	switch vtype {
+	case VT_BYTE:
+		bits, signed = 8, false
+	case VT_CHAR:
+		bits, signed = 16, false
+		if gp.config.isByteCharModel() {
+			bits = 8
+		}
+	case VT_INT16:
+		bits = 16
+	case VT_INT:
+		bits = 32
+	case VT_INT64:
+		bits = 64
+	default:
+		return val
+	}
+
+	one := constant.MakeInt64(1)
+	limit := constant.Shift(one, token.SHL, bits)
+	mask := constant.BinaryOp(limit, token.SUB, one)
+
+	val = constant.BinaryOp(val, token.AND, mask)
+	if signed {
+		half := constant.Shift(one, token.SHL, bits-1)
+		if constant.Compare(val, token.GEQ, half) {
+			val = constant.BinaryOp(val, token.SUB, limit)
+		}
+	}
+
+	return val
+}
@@ -14,6 +14,7 @@ import (
 
 	//"runtime/debug"
 	"sort"
+	"strconv"
 	"strings"
 
 	"java2go/dumper"
@@ -40,6 +41,31 @@ func fixName(name string, modifiers *grammar.JModifiers) string {
 	return name
 }
 
+// Java methods which are renamed to follow Go conventions, e.g.
+// toString() becomes String() so it satisfies fmt.Stringer
+var conventionalMethods = []struct {
+	name    string
+	nparams int
+	goname  string
+}{
+	{"compareTo", 1, "Compare"},
+	{"equals", 1, "Equals"},
+	{"hashCode", 0, "HashCode"},
+	{"toString", 0, "String"},
+}
+
+// return the Go name for a method with a conventional Go equivalent,
+// or "" if there is none
+func conventionalName(name string, nparams int) string {
+	for _, cm := range conventionalMethods {
+		if cm.name == name && cm.nparams == nparams {
+			return cm.goname
+		}
+	}
+
+	return ""
+}
+
 func singleStatement(name string, stmts []ast.Stmt) (ast.Stmt, bool) {
 	if stmts == nil || len(stmts) == 0 {
 		return nil, true
@@ -168,6 +194,17 @@ type GoArrayAlloc struct {
 }
 
 func (aa *GoArrayAlloc) Expr() ast.Expr {
+	if aa.isMultiDim() {
+		// allocate the array inside a function literal
+		tmp := ast.NewIdent(unusedName([]string{"arr", "tmp", "buf"},
+			aa.args...))
+
+		stmts := aa.allocStmts(tmp, token.DEFINE)
+		stmts = append(stmts, &ast.ReturnStmt{Results: []ast.Expr{tmp}})
+
+		return callFuncLit(aa.VarType().Expr(), stmts...)
+	}
+
 	args := make([]ast.Expr, len(aa.args)+1)
 	args[0] = &ast.ArrayType{Elt: aa.typedata.Expr()}
 	if aa.args != nil && len(aa.args) > 0 {
@@ -179,6 +216,88 @@ func (aa *GoArrayAlloc) Expr() ast.Expr {
 	return &ast.CallExpr{Fun: ast.NewIdent("make"), Args: args}
 }
 
+// return true if more than one dimension of the array is allocated,
+// as in "new int[rows][cols]"
+func (aa *GoArrayAlloc) isMultiDim() bool {
+	return len(aa.args) > 1
+}
+
+// return statements which allocate the outer dimension of the array,
+// assign it to 'target', then loop over each element to allocate the
+// inner dimensions
+func (aa *GoArrayAlloc) allocStmts(target ast.Expr,
+	tok token.Token) []ast.Stmt {
+	// loop indexes must not hide variables used by the dimensions or by
+	// the target, as in "a[i] = new int[3][4]"
+	used := make(map[string]bool)
+	ast.Inspect(target, func(node ast.Node) bool {
+		if id, ok := node.(*ast.Ident); ok {
+			used[id.Name] = true
+		}
+		return true
+	})
+
+	var names []string
+	for round := 1; len(names) < len(aa.args)-1; round++ {
+		for _, name := range []string{"i", "j", "k", "l", "m", "n"} {
+			if round > 1 {
+				name += strconv.Itoa(round)
+			}
+
+			if !used[name] &&
+				unusedName([]string{name, ""}, aa.args...) == name {
+				names = append(names, name)
+			}
+		}
+	}
+
+	asgn := &ast.AssignStmt{Lhs: []ast.Expr{target}, Tok: tok,
+		Rhs: []ast.Expr{aa.makeDim(0)}}
+
+	return []ast.Stmt{asgn, aa.allocLoop(target, 1, names)}
+}
+
+// return "make([]...T, n)" for dimension 'level' of the array
+func (aa *GoArrayAlloc) makeDim(level int) ast.Expr {
+	atype := aa.typedata.Expr()
+	for i := level; i < len(aa.args); i++ {
+		atype = &ast.ArrayType{Elt: atype}
+	}
+
+	return &ast.CallExpr{Fun: ast.NewIdent("make"),
+		Args: []ast.Expr{atype, aa.args[level].Expr()}}
+}
+
+// return "for i := range target { target[i] = make(...) }", with nested
+// loops for any remaining dimensions
+func (aa *GoArrayAlloc) allocLoop(target ast.Expr, level int,
+	names []string) ast.Stmt {
+	idx := ast.NewIdent(names[level-1])
+	elem := &ast.IndexExpr{X: target, Index: idx}
+
+	body := []ast.Stmt{&ast.AssignStmt{Lhs: []ast.Expr{elem},
+		Tok: token.ASSIGN, Rhs: []ast.Expr{aa.makeDim(level)}}}
+	if level+1 < len(aa.args) {
+		body = append(body, aa.allocLoop(elem, level+1, names))
+	}
+
+	return &ast.RangeStmt{Key: idx, Tok: token.DEFINE, X: target,
+		Body: &ast.BlockStmt{List: body}}
+}
+
+// return the allocation if 'expr' allocates a multi-dimensional array
+func multiDimAlloc(expr GoExpr) *GoArrayAlloc {
+	if gvi, ok := expr.(*GoVarInit); ok && gvi.expr != nil {
+		expr = gvi.expr
+	}
+
+	if aa, ok := expr.(*GoArrayAlloc); ok && aa.isMultiDim() {
+		return aa
+	}
+
+	return nil
+}
+
 func (aa *GoArrayAlloc) hasVariable(govar GoVar) bool {
 	for _, a := range aa.args {
 		if a.hasVariable(govar) {
@@ -225,7 +344,13 @@ func (aa *GoArrayAlloc) String() string {
 }
 
 func (aa *GoArrayAlloc) VarType() *TypeData {
-	return aa.typedata
+	if aa.typedata.vtype == VT_ARRAY {
+		return &TypeData{vtype: VT_ARRAY, type1: aa.typedata.type1,
+			array_dims: aa.typedata.array_dims + len(aa.args)}
+	}
+
+	return &TypeData{vtype: VT_ARRAY, type1: aa.typedata,
+		array_dims: len(aa.args)}
 }
 
 type GoArrayInit struct {
@@ -460,7 +585,21 @@ func (nae *GoArrayReference) Type() ast.Expr {
 }
 
 func (nae *GoArrayReference) VarType() *TypeData {
-	return nil
+	var td *TypeData
+	if nae.obj != nil {
+		td = nae.obj.VarType()
+	} else {
+		td = nae.govar.VarType()
+	}
+
+	if td == nil {
+		return nil
+	} else if td == stringType {
+		// indexing a string returns a single character
+		return charType
+	}
+
+	return td.elementType()
 }
 
 type GoAssign struct {
@@ -493,11 +632,7 @@ func (asgn *GoAssign) hasVariable(govar GoVar) bool {
 }
 
 func (asgn *GoAssign) Init() ast.Stmt {
-	if stmt, is_nil := singleStatement("Assignment", asgn.Stmts()); !is_nil {
-		return stmt
-	}
-
-	return nil
+	return asgn.assignStmt()
 }
 
 func (asgn *GoAssign) RunTransform(xform TransformFunc, prog *GoProgram, cls GoClass, parent GoObject) (GoObject, bool) {
@@ -523,6 +658,21 @@ func (asgn *GoAssign) RunTransform(xform TransformFunc, prog *GoProgram, cls GoC
 }
 
 func (asgn *GoAssign) Stmts() []ast.Stmt {
+	if len(asgn.rhs) == 1 && asgn.tok == token.ASSIGN {
+		if aa := multiDimAlloc(asgn.rhs[0]); aa != nil {
+			return aa.allocStmts(asgn.govar.Expr(), asgn.tok)
+		}
+	}
+
+	var stmts []ast.Stmt
+	for _, r := range asgn.rhs {
+		stmts = append(stmts, hoistInstanceOf(r)...)
+	}
+
+	return append(stmts, asgn.assignStmt())
+}
+
+func (asgn *GoAssign) assignStmt() ast.Stmt {
 	lhs := make([]ast.Expr, 1)
 	lhs[0] = asgn.govar.Expr()
 
@@ -533,7 +683,7 @@ func (asgn *GoAssign) Stmts() []ast.Stmt {
 		}
 	}
 
-	return []ast.Stmt{&ast.AssignStmt{Lhs: lhs, Tok: asgn.tok, Rhs: rhs}}
+	return &ast.AssignStmt{Lhs: lhs, Tok: asgn.tok, Rhs: rhs}
 }
 
 func (asgn *GoAssign) String() string {
@@ -732,6 +882,13 @@ func (bs *GoBranchStmt) VarType() *TypeData {
 	panic("GoBranchStmt.VarType() unimplemented")
 }
 
+// variable from an enclosing scope which is captured by an anonymous class
+type GoCapture struct {
+	field GoVar // anonymous class field holding the value
+	param GoVar // constructor parameter used to initialize the field
+	outer GoVar // captured variable
+}
+
 type GoCastType struct {
 	target   GoExpr
 	casttype *TypeData
@@ -785,7 +942,7 @@ type GoClass interface {
 	GoObject
 	AddConstant(con *GoConstant)
 	AddMethod(mthd GoMethod)
-	Constants() []ast.Decl
+	Constants(gp *GoProgram) []ast.Decl
 	Decls() []ast.Decl
 	finalize(gp *GoProgram)
 	FindMethod(name string, args *GoMethodArguments) GoMethod
@@ -801,7 +958,17 @@ type GoClass interface {
 }
 
 func makeClassKey(cls GoClass) string {
-	return makeClassKeyFromParts(cls.Parent(), cls.Name())
+	return makeClassKeyFromParts(cls.Parent(), javaClassName(cls))
+}
+
+// return the Java name for a class, which differs from the Go name
+// for nested classes
+func javaClassName(owner GoMethodOwner) string {
+	if cls, ok := owner.(*GoClassDefinition); ok && cls.jname != "" {
+		return cls.jname
+	}
+
+	return owner.Name()
 }
 
 func makeClassKeyFromParts(parent GoMethodOwner, name string) string {
@@ -824,6 +991,17 @@ func (gca *GoClassAlloc) Expr() ast.Expr {
 	var funexpr ast.Expr
 	var args []ast.Expr
 
+	if cd, ok := gca.class.(*GoClassDefinition); ok && cd.is_adapter {
+		name := cd.name
+		if cd.func_type != "" {
+			name = cd.func_type
+		}
+
+		// convert the function literal to the adapter type
+		return &ast.CallExpr{Fun: ast.NewIdent(name),
+			Args: []ast.Expr{cd.adapterFunc()}}
+	}
+
 	funexpr = ast.NewIdent(gca.method.GoName())
 
 	if gca.args != nil && len(gca.args) > 0 {
@@ -836,7 +1014,8 @@ func (gca *GoClassAlloc) Expr() ast.Expr {
 
 	// ignoring class body
 
-	return &ast.CallExpr{Fun: funexpr, Args: args}
+	return &ast.CallExpr{Fun: funexpr, Args: args,
+		Ellipsis: varargsEllipsis(gca.method, gca.args)}
 }
 
 func (gca *GoClassAlloc) hasVariable(govar GoVar) bool {
@@ -925,15 +1104,37 @@ func (gca *GoClassAlloc) VarType() *TypeData {
 }
 
 type GoClassDefinition struct {
-	program    *GoProgram
-	parent     GoMethodOwner
-	super      GoClass
-	name       string
-	constants  []*GoConstant
-	statics    []*GoStatic
-	vars       []*GoVarInit
-	interfaces []GoInterface
-	methods    *classMethodMap
+	program      *GoProgram
+	parent       GoMethodOwner
+	super        GoClass
+	name         string
+	constants    []*GoConstant
+	statics      []*GoStatic
+	vars         []*GoVarInit
+	interfaces   []GoInterface
+	methods      *classMethodMap
+	static_init  *GoClassMethod
+	initializers []*GoBlock
+	is_adapter   bool
+	captures     []*GoCapture
+	// Java name of a nested class
+	jname string
+	// field which refers to the enclosing instance of an inner class
+	outer GoVar
+	// methods first declared by this class and overridden by subclasses,
+	// which are called through 'self'
+	virtuals []*GoClassMethod
+	// true if a root class needs 'self' because a subclass declares
+	// virtual methods
+	sub_virtuals bool
+	// interface name used for variables whose type is an abstract class
+	iface_name string
+	// function type which an adapter is converted to in place of
+	// declaring its own type
+	func_type string
+	// adapter whose function literal is only used directly, so its
+	// type isn't declared
+	is_inline bool
 }
 
 func NewGoClassDefinition(program *GoProgram, parent GoMethodOwner,
@@ -957,27 +1158,145 @@ func (cls *GoClassDefinition) AddNewMethod(gs *GoState, mth *grammar.JMethodDecl
 	cls.AddMethod(NewGoClassMethod(cls, gs, mth))
 }
 
-func (cls *GoClassDefinition) addVar(v *GoVarInit) {
-	if v.govar.IsFinal() && v.expr != nil {
-		if _, is_literal := v.expr.(*GoLiteral); !is_literal {
-			if cls.program.verbose {
-				log.Printf("//ERR// Ignoring non-literal constant %v for %v\n",
-					v.expr, v.govar)
-			} else {
-				log.Printf("//ERR// Ignoring non-literal constant\n")
-			}
+// add an instance initializer block to be run by every constructor
+func (cls *GoClassDefinition) addInitializer(blk *GoBlock) {
+	if cls.initializers == nil {
+		cls.initializers = make([]*GoBlock, 0)
+	}
+	cls.initializers = append(cls.initializers, blk)
+}
+
+// add a static initializer block to this class's init() function
+func (cls *GoClassDefinition) addStaticInitializer(blk *GoBlock) {
+	if cls.static_init != nil {
+		cls.static_init.body.stmts = append(cls.static_init.body.stmts,
+			blk.stmts...)
+		return
+	}
+
+	cls.static_init = &GoClassMethod{class: cls, name: "init",
+		goname: "init", rcvr: nil, method_type: mt_static, body: blk}
+	cls.program.addStaticInitializer(cls.static_init)
+}
+
+// return the anonymous class field used for a variable from an
+// enclosing scope, adding the field if necessary
+func (cls *GoClassDefinition) captureVariable(outer_gs *GoState,
+	govar GoVar) GoVar {
+	switch v := govar.(type) {
+	case *GoVarData:
+		if v.is_static || v.is_const {
+			return govar
+		} else if !v.class_field {
+			return cls.addCapture(v.name, v.goname, v.vartype, v)
+		}
+
+		// access fields of the enclosing class through 'outer'
+		outer := cls.outerField(outer_gs)
+		if outer == nil {
+			return govar
+		}
+
+		return NewGoSelector(outer, v)
+	case *GoClassAttribute:
+		inner := cls.captureVariable(outer_gs, v.govar)
+		if inner != v.govar {
+			return &GoClassAttribute{govar: inner, suffix: v.suffix,
+				program: v.program}
+		}
+	}
+
+	return govar
+}
+
+// return the field which refers to the enclosing instance, adding it if
+// necessary (or nil if there is no enclosing instance)
+func (cls *GoClassDefinition) outerField(outer_gs *GoState) GoVar {
+	if cls.outer == nil {
+		rcvr := outer_gs.findVariableString(outer_gs.Receiver())
+		if rcvr == nil {
+			return nil
+		}
+
+		cls.addOuter(outer_gs.ClassName(), rcvr)
+	}
+
+	return cls.outer
+}
+
+// add the field which refers to the enclosing instance
+func (cls *GoClassDefinition) addOuter(outer_class string, rcvr GoVar) {
+	otype := &TypeData{vtype: VT_CLASS, vclass: outer_class}
+	cls.outer = cls.addCapture("outer", "outer", otype, rcvr)
+}
+
+// return the constructor parameter used for the enclosing instance
+func (cls *GoClassDefinition) outerParam() GoVar {
+	for _, c := range cls.captures {
+		if c.field == cls.outer {
+			return c.param
+		}
+	}
+
+	return nil
+}
+
+func (cls *GoClassDefinition) addCapture(name string, goname string,
+	vartype *TypeData, outer GoVar) GoVar {
+	for _, c := range cls.captures {
+		if outer != nil && c.outer == outer {
+			return c.field
+		}
+	}
+
+	field := &GoVarData{rcvr: cls.program.Receiver(cls.name), name: name,
+		goname: goname, vartype: vartype, class_field: true}
+	param := &GoVarData{name: name, goname: goname, vartype: vartype}
+
+	if cls.captures == nil {
+		cls.captures = make([]*GoCapture, 0)
+	}
+	cls.captures = append(cls.captures,
+		&GoCapture{field: field, param: param, outer: outer})
+
+	return field
+}
+
+func (cls *GoClassDefinition) addVar(gp *GoProgram, v *GoVarInit) {
+	if v.govar.IsFinal() && v.expr != nil && isConstantExpr(v.expr) {
+		if isStringType(v.govar.VarType()) {
+			v.expr = foldConstantString(v.expr)
 		} else {
-			con := &GoConstant{name: v.govar.GoName(),
-				typedata: v.govar.VarType(), init: v}
-			if cls.constants == nil {
-				cls.constants = make([]*GoConstant, 0)
-			}
-			cls.constants = append(cls.constants, con)
-			return
+			v.expr = foldConstantInt(gp, v.govar.VarType(), v.expr,
+				cls.constantInit)
+		}
+
+		// later references can use this value in other constants
+		if gvd, ok := v.govar.(*GoVarData); ok {
+			gvd.is_const = true
 		}
+
+		con := &GoConstant{name: v.govar.GoName(),
+			typedata: v.govar.VarType(), init: v}
+		if cls.constants == nil {
+			cls.constants = make([]*GoConstant, 0)
+		}
+		cls.constants = append(cls.constants, con)
+		return
 	}
 
 	if v.govar.IsStatic() {
+		if cls.static_init != nil &&
+			(v.expr != nil || len(v.elements) > 0) {
+			// Java runs static initializers in source order, so a field
+			// initialized after a static block is set at the end of init()
+			asgn := &GoAssign{govar: v.govar, tok: token.ASSIGN,
+				rhs: []GoExpr{v}}
+			cls.static_init.body.stmts = append(cls.static_init.body.stmts,
+				asgn)
+			v = &GoVarInit{govar: v.govar}
+		}
+
 		stat := &GoStatic{init: v}
 		if cls.statics == nil {
 			cls.statics = make([]*GoStatic, 0)
@@ -992,14 +1311,27 @@ func (cls *GoClassDefinition) addVar(v *GoVarInit) {
 	cls.vars = append(cls.vars, v)
 }
 
-func (cls *GoClassDefinition) Constants() []ast.Decl {
+// return the value of the constant stored in 'govar', or nil if it isn't
+// one of this class's constants
+func (cls *GoClassDefinition) constantInit(govar GoVar) GoExpr {
+	for _, c := range cls.constants {
+		if c.init != nil && c.init.govar != nil &&
+			c.init.govar.Equals(govar) {
+			return c.init.expr
+		}
+	}
+
+	return nil
+}
+
+func (cls *GoClassDefinition) Constants(gp *GoProgram) []ast.Decl {
 	if cls.constants == nil || len(cls.constants) == 0 {
 		return nil
 	}
 
 	decls := make([]ast.Decl, len(cls.constants))
 	for i, con := range cls.constants {
-		decls[i] = con.Decl()
+		decls[i] = con.Decl(gp)
 	}
 
 	return decls
@@ -1015,10 +1347,20 @@ func (cls *GoClassDefinition) createConstructor(gp *GoProgram) *GoClassMethod {
 	rhs := make([]GoExpr, 1)
 	rhs[0] = &GoReference{cls: cls}
 
+	// captured variables are passed to the constructor
+	var params []GoVar
+	for _, c := range cls.captures {
+		params = append(params, c.param)
+	}
+
 	// create receiver assignment statement and final 'return'
-	stmts := make([]GoStatement, 2)
+	stmts := make([]GoStatement, 1)
 	stmts[0] = &GoAssign{govar: rcvr, tok: token.ASSIGN, rhs: rhs}
-	if cls.super != nil {
+	if sup, ok := cls.super.(*GoClassDefinition); ok {
+		// initialize the embedded superclass
+		stmts = append(stmts, NewGoNewStruct(rcvr, sup, true,
+			&GoMethodArguments{}))
+	} else if cls.super != nil {
 		if cls.program.verbose {
 			log.Printf("//ERR// Not creating %v superclass %v initializer\n",
 				cls.name, cls.super.Name())
@@ -1026,14 +1368,14 @@ func (cls *GoClassDefinition) createConstructor(gp *GoProgram) *GoClassMethod {
 			log.Printf("//ERR// Not creating superclass initializer\n")
 		}
 	}
-	stmts[1] = &GoReturn{}
+	stmts = append(stmts, &GoReturn{})
 
 	// create the constructor body
 	body := &GoBlock{stmts: stmts}
 
 	// create the constructor method
 	m := &GoClassMethod{class: cls, name: cls.name, goname: "New" + cls.name,
-		rcvr: rcvr, method_type: mt_constructor, body: body}
+		rcvr: rcvr, method_type: mt_constructor, params: params, body: body}
 
 	// add new constructor
 	cls.AddMethod(m)
@@ -1042,12 +1384,24 @@ func (cls *GoClassDefinition) createConstructor(gp *GoProgram) *GoClassMethod {
 }
 
 func (cls *GoClassDefinition) Decls() []ast.Decl {
+	if cls.func_type != "" || cls.is_inline {
+		// the function literal is converted to an existing type
+		// or used directly
+		return nil
+	} else if cls.is_adapter {
+		return cls.adapterDecls()
+	}
+
 	specs := make([]ast.Spec, 1)
 	specs[0] = &ast.TypeSpec{Name: ast.NewIdent(cls.name),
 		Type: cls.struct_type()}
 
-	decls := make([]ast.Decl, 1)
-	decls[0] = &ast.GenDecl{Tok: token.TYPE, Specs: specs}
+	decls := make([]ast.Decl, 0)
+	if cls.hasSelf() || len(cls.virtuals) > 0 {
+		decls = append(decls, cls.virtualDecl())
+	}
+	decls = append(decls, &ast.GenDecl{Tok: token.TYPE, Specs: specs})
+	decls = append(decls, cls.interfaceAssertions()...)
 
 	for _, key := range cls.methods.SortedKeys() {
 		for _, m := range cls.methods.MethodList(key) {
@@ -1061,118 +1415,635 @@ func (cls *GoClassDefinition) Decls() []ast.Decl {
 	return decls
 }
 
-func (cls *GoClassDefinition) finalize(gp *GoProgram) {
-	// move variable initialization code inside constructors
-	cls.internalizeVarInits(gp)
-	// renumber duplicate methods
-	cls.renumberDuplicateMethods(gp)
+// return the method implemented by a function adapter
+func (cls *GoClassDefinition) adapterMethod() *GoClassMethod {
+	for _, key := range cls.methods.SortedKeys() {
+		for _, m := range cls.methods.MethodList(key) {
+			if cm, ok := m.(*GoClassMethod); ok && cm.method_type == mt_method {
+				return cm
+			}
+		}
+	}
+
+	panic(fmt.Sprintf("Adapter %v has no method", cls.name))
 }
 
-func findAssigned(unasgned_vars []*GoVarInit, gv GoVar) int {
-	for i, v := range unasgned_vars {
-		if v == nil {
-			continue
-		}
+// declare a function type and a method which calls the function
+func (cls *GoClassDefinition) adapterDecls() []ast.Decl {
+	m := cls.adapterMethod()
 
-		if v.govar.Equals(gv) {
-			unasgned_vars[i] = nil
-			return i
-		}
+	ftype := &ast.FuncType{Params: m.paramList(), Results: m.results()}
+
+	specs := make([]ast.Spec, 1)
+	specs[0] = &ast.TypeSpec{Name: ast.NewIdent(cls.name), Type: ftype}
+
+	fn := ast.NewIdent("fn")
+
+	args := make([]ast.Expr, len(m.params))
+	for i, p := range m.params {
+		args[i] = p.Ident()
 	}
 
-	return -1
+	call := &ast.CallExpr{Fun: fn, Args: args}
+
+	var stmt ast.Stmt
+	if ftype.Results == nil {
+		stmt = &ast.ExprStmt{X: call}
+	} else {
+		stmt = &ast.ReturnStmt{Results: []ast.Expr{call}}
+	}
+
+	recv := &ast.FieldList{List: []*ast.Field{makeField(fn.Name,
+		ast.NewIdent(cls.name))}}
+
+	decls := []ast.Decl{
+		&ast.GenDecl{Tok: token.TYPE, Specs: specs},
+		&ast.FuncDecl{Name: ast.NewIdent(m.goname), Recv: recv, Type: ftype,
+			Body: &ast.BlockStmt{List: []ast.Stmt{stmt}}},
+	}
+
+	return append(decls, cls.interfaceAssertions()...)
 }
 
-func (cls *GoClassDefinition) FindMethod(name string,
-	args *GoMethodArguments) GoMethod {
-	return cls.methods.FindMethod(name, args)
+// return the function literal passed to a function adapter
+func (cls *GoClassDefinition) adapterFunc() *ast.FuncLit {
+	m := cls.adapterMethod()
+
+	return &ast.FuncLit{Type: &ast.FuncType{Params: m.paramList(),
+		Results: m.results()}, Body: m.body.BlockStmt()}
 }
 
-func (cls *GoClassDefinition) findVariable(typename *grammar.JTypeName) GoVar {
-	for _, c := range cls.constants {
-		if c.name == typename.String() {
-			return c
+// return the top of this class's hierarchy
+func (cls *GoClassDefinition) rootClass() *GoClassDefinition {
+	root := cls
+	for {
+		sup, ok := root.super.(*GoClassDefinition)
+		if !ok {
+			return root
 		}
+		root = sup
 	}
+}
 
-	for _, i := range cls.interfaces {
-		govar := i.findVariable(typename)
-		if govar != nil {
-			return govar
+// add a method forwarding to each default interface method which isn't
+// implemented by this class or one of its superclasses
+func (cls *GoClassDefinition) addDefaultMethods(gp *GoProgram) {
+	for _, gi := range cls.definedInterfaces() {
+		for _, dflt := range gi.defaults {
+			if !cls.implementsDefault(dflt) {
+				cls.AddMethod(cls.forwardDefault(gp, dflt))
+			}
 		}
 	}
+}
 
-	if cls.super != nil {
-		return cls.super.findVariable(typename)
+// return the interfaces implemented by this class which are defined in
+// this program
+func (cls *GoClassDefinition) definedInterfaces() []*GoInterfaceDefinition {
+	ilist := make([]*GoInterfaceDefinition, 0)
+	for _, iface := range cls.interfaces {
+		switch i := iface.(type) {
+		case *GoInterfaceDefinition:
+			ilist = append(ilist, i)
+		case *GoInterfaceReference:
+			if i.realiface != nil {
+				ilist = append(ilist, i.realiface)
+			}
+		}
 	}
 
-	return nil
+	return ilist
 }
 
-func (cls *GoClassDefinition) internalizeVarInits(gp *GoProgram) {
-	// build list of constructors
-	ctors := make([]GoMethod, 0)
-	for _, key := range cls.methods.SortedKeys() {
-		for _, m := range cls.methods.MethodList(key) {
-			if m.MethodType() == mt_constructor {
-				ctors = append(ctors, m)
-			}
+// return the Compare() method of a class implementing Comparable, or nil
+func (cls *GoClassDefinition) compareMethod() *GoClassMethod {
+	comparable := false
+	for _, iface := range cls.interfaces {
+		if iface.Name() == "Comparable" {
+			comparable = true
+			break
 		}
 	}
 
-	// fix this()/super() in all ctors
-	for _, m := range ctors {
-		stmts := m.Body().stmts
+	if !comparable {
+		return nil
+	}
 
-		sawCTOR := false
-		for _, s := range stmts {
-			if _, nok := s.(*GoNewStruct); nok {
-				sawCTOR = true
-			}
+	for _, m := range cls.methods.MethodList("compareTo") {
+		if cm, ok := m.(*GoClassMethod); ok && cm.goname == "Compare" {
+			return cm
 		}
+	}
 
-		if cls.super != nil && !sawCTOR {
-			if cls.program.verbose {
-				log.Printf("//ERR// Not adding %v superclass %v initializer\n",
-					cls.name, cls.super.Name())
-			} else {
-				log.Printf("//ERR// Not adding superclass initializer\n")
-			}
-		}
+	return nil
+}
 
-		m.Body().stmts = stmts
+// return the Equals() method of a class, or nil
+func (cls *GoClassDefinition) equalsMethod() *GoClassMethod {
+	for _, m := range cls.methods.MethodList("equals") {
+		if cm, ok := m.(*GoClassMethod); ok && cm.goname == "Equals" {
+			return cm
+		}
 	}
 
-	// if there are no constructors, create one
-	if len(ctors) == 0 {
-		m := cls.createConstructor(gp)
-		ctors = append(ctors, m)
-	}
+	return nil
+}
 
-	// build list of initialized variables
-	init_vars := make([]*GoVarInit, 0)
-	for _, v := range cls.vars {
-		if v.expr != nil {
-			init_vars = append(init_vars, v)
+// return the compare() method of a Comparator class, or nil
+func (cls *GoClassDefinition) comparatorMethod() *GoClassMethod {
+	for _, m := range cls.methods.MethodList("compare") {
+		if cm, ok := m.(*GoClassMethod); ok && len(cm.params) == 2 &&
+			cm.method_type == mt_method {
+			return cm
 		}
 	}
 
-	// if nothing is initialized, we're done
-	if len(init_vars) > 0 {
-		// add initializers to all constructors
-		for i, m := range ctors {
-			if gp.verbose {
-				log.Printf("//ERR// ## CTOR#%d: %v\n", i, m)
-			}
+	return nil
+}
 
-			// initially assume all variables need to be initialized
-			num_unasgned := len(init_vars)
-			unasgned_vars := make([]*GoVarInit, num_unasgned)
-			for i, v := range init_vars {
-				unasgned_vars[i] = v
-			}
+// declare 'var _ Iface = (*Class)(nil)' so the Go compiler verifies
+// this class implements each of its interfaces
+func (cls *GoClassDefinition) interfaceAssertions() []ast.Decl {
+	if cls.iface_name != "" {
+		// abstract base structs don't implement abstract methods
+		return nil
+	}
 
-			for _, s := range m.Body().stmts {
+	decls := make([]ast.Decl, 0)
+	for _, gi := range cls.definedInterfaces() {
+		if gi.is_functype {
+			continue
+		}
+
+		ptr := &ast.ParenExpr{X: &ast.StarExpr{X: ast.NewIdent(cls.name)}}
+		val := &ast.CallExpr{Fun: ptr, Args: []ast.Expr{ast.NewIdent("nil")}}
+
+		spec := &ast.ValueSpec{Names: []*ast.Ident{ast.NewIdent("_")},
+			Type: ast.NewIdent(gi.name), Values: []ast.Expr{val}}
+		decls = append(decls, &ast.GenDecl{Tok: token.VAR,
+			Specs: []ast.Spec{spec}})
+	}
+
+	return decls
+}
+
+// warn about interface methods which this class doesn't implement with
+// the same Go name and signature
+func (cls *GoClassDefinition) checkInterfaces(gp *GoProgram) {
+	if cls.iface_name != "" || cls.func_type != "" {
+		return
+	}
+
+	ilist := cls.definedInterfaces()
+	sort.SliceStable(ilist, func(i, j int) bool {
+		return ilist[i].name < ilist[j].name
+	})
+
+	for _, gi := range ilist {
+		if gi.is_functype {
+			if gp.verbose {
+				log.Printf("//ERR// %s cannot implement function type %s\n",
+					cls.name, gi.name)
+			} else {
+				log.Printf("//ERR// Class cannot implement function type\n")
+			}
+
+			continue
+		}
+
+		for _, key := range gi.methods.SortedKeys() {
+			for _, m := range gi.methods.MethodList(key) {
+				if im, ok := m.(*GoIfaceMethod); ok {
+					cls.checkInterfaceMethod(gp, gi, im)
+				}
+			}
+		}
+	}
+}
+
+func (cls *GoClassDefinition) checkInterfaceMethod(gp *GoProgram,
+	gi *GoInterfaceDefinition, im *GoIfaceMethod) {
+	// prefer the closest overload so the warning describes the method
+	// which was meant to implement 'im', whatever order overloads were
+	// declared in
+	var found *GoClassMethod
+	for c := cls; c != nil; c, _ = c.super.(*GoClassDefinition) {
+		for _, m := range c.methods.MethodList(im.name) {
+			if cm, ok := m.(*GoClassMethod); ok &&
+				cm.method_type == mt_method &&
+				len(cm.params) == len(im.param_list) {
+				if found == nil || (!im.sameParams(found) &&
+					im.sameParams(cm)) {
+					found = cm
+				}
+			}
+		}
+	}
+
+	var problem string
+	if found == nil {
+		problem = "is missing"
+	} else if !im.sameParams(found) {
+		for i, p := range found.params {
+			if !sameType(p.VarType(), im.param_list[i].VarType()) {
+				problem = "has a different type for parameter " + p.Name()
+				break
+			}
+		}
+	} else if found.goname != im.goname {
+		problem = "is named " + found.goname
+	} else if !sameType(found.typedata, im.result_type) {
+		problem = "has a different result type"
+	}
+
+	if problem != "" {
+		if gp.verbose {
+			log.Printf("//ERR// %s method %s.%s %s\n", cls.name,
+				gi.name, im.goname, problem)
+		} else {
+			log.Printf("//ERR// Interface method %s\n", problem)
+		}
+	}
+}
+
+// return true if 'cm' has the same parameter types as this method
+func (im *GoIfaceMethod) sameParams(cm *GoClassMethod) bool {
+	for i, p := range cm.params {
+		if !sameType(p.VarType(), im.param_list[i].VarType()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// return true if this class or a superclass has an instance method
+// which overrides a default interface method
+func (cls *GoClassDefinition) implementsDefault(dflt *GoClassMethod) bool {
+	for c := cls; c != nil; c, _ = c.super.(*GoClassDefinition) {
+		for _, m := range c.methods.MethodList(dflt.name) {
+			if m.MethodType() == mt_method &&
+				m.NumParameters() == dflt.NumParameters()-1 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// build a method which passes the receiver to a default interface method
+func (cls *GoClassDefinition) forwardDefault(gp *GoProgram,
+	dflt *GoClassMethod) *GoClassMethod {
+	gs := &GoState{program: gp, class: cls}
+	rcvr := gs.addVariable(gs.Receiver(), nil, 0, nil, false)
+
+	params := dflt.params[1:]
+
+	args := []GoExpr{rcvr}
+	for _, p := range params {
+		args = append(args, p)
+	}
+
+	call := &GoMethodAccess{method: dflt, args: &GoMethodArguments{args}}
+
+	var stmt GoStatement
+	if dflt.typedata == nil || dflt.typedata.vtype == VT_VOID {
+		stmt = &GoExprStmt{x: call}
+	} else {
+		stmt = &GoReturn{expr: call}
+	}
+
+	goname := strings.ToUpper(dflt.name[:1]) + dflt.name[1:]
+
+	return &GoClassMethod{class: cls, name: dflt.name, goname: goname,
+		typedata: dflt.typedata, rcvr: rcvr, method_type: mt_method,
+		params: params, body: &GoBlock{stmts: []GoStatement{stmt}}}
+}
+
+// mark methods of the root class which are overridden by this class,
+// along with every override between the root and this class
+func (cls *GoClassDefinition) findOverrides() {
+	root := cls.rootClass()
+	if root == cls {
+		if cls.iface_name != "" {
+			// abstract methods are always implemented by a subclass
+			for _, m := range cls.instanceMethods() {
+				if m.is_abstract {
+					m.is_virtual = true
+				}
+			}
+		}
+
+		return
+	}
+
+	for _, key := range cls.methods.SortedKeys() {
+		for _, m := range cls.methods.MethodList(key) {
+			cm, ok := m.(*GoClassMethod)
+			if !ok || cm.method_type != mt_method {
+				continue
+			}
+
+			decl, dm := cls.declaringClass(cm)
+			if decl == cls {
+				continue
+			}
+
+			if !dm.is_virtual {
+				dm.is_virtual = true
+				decl.virtuals = append(decl.virtuals, dm)
+				if decl != root {
+					root.sub_virtuals = true
+				}
+			}
+
+			for c := cls; c != decl; c = c.super.(*GoClassDefinition) {
+				if om := c.findOverridden(cm); om != nil {
+					om.is_virtual = true
+				}
+			}
+		}
+	}
+}
+
+// return the highest class in the hierarchy which declares 'mthd' (or this
+// class if no superclass does) along with its version of the method
+func (cls *GoClassDefinition) declaringClass(
+	mthd *GoClassMethod) (*GoClassDefinition, *GoClassMethod) {
+	decl, dm := cls, mthd
+	c, _ := cls.super.(*GoClassDefinition)
+	for ; c != nil; c, _ = c.super.(*GoClassDefinition) {
+		if om := c.findOverridden(mthd); om != nil {
+			decl, dm = c, om
+		}
+	}
+
+	return decl, dm
+}
+
+// return this class's version of a method overridden by a subclass
+func (cls *GoClassDefinition) findOverridden(mthd *GoClassMethod) *GoClassMethod {
+	for _, m := range cls.methods.MethodList(mthd.name) {
+		if cm, ok := m.(*GoClassMethod); ok &&
+			cm.method_type == mt_method && cm.IsMethod(mthd) {
+			return cm
+		}
+	}
+
+	return nil
+}
+
+// set 'self' in every constructor of a class with virtual methods
+func (cls *GoClassDefinition) initializeSelf() {
+	root := cls.rootClass()
+	if !root.hasSelf() || cls.iface_name != "" {
+		// abstract classes don't implement the interface, so 'self'
+		// is set by the subclass
+		return
+	}
+
+	for _, key := range cls.methods.SortedKeys() {
+		for _, m := range cls.methods.MethodList(key) {
+			if m.MethodType() != mt_constructor || m.Body() == nil {
+				continue
+			}
+
+			stmts := m.Body().stmts
+
+			idx := -1
+			for i, s := range stmts {
+				if ns, ok := s.(*GoNewStruct); ok {
+					if !ns.is_super {
+						// delegated constructor sets 'self'
+						idx = -1
+						break
+					}
+
+					idx = i
+				} else if asgn, ok := s.(*GoAssign); ok && cls == root &&
+					asgn.govar == m.Receiver() && asgn.tok == token.ASSIGN {
+					idx = i
+				}
+			}
+
+			if idx < 0 {
+				continue
+			}
+
+			self := &GoVarData{name: "self", goname: "self",
+				vartype: &TypeData{vtype: VT_INTERFACE,
+					vclass: root.virtualName()}}
+			asgn := &GoAssign{govar: NewGoSelector(m.Receiver(), self),
+				tok: token.ASSIGN, rhs: []GoExpr{m.Receiver()}}
+
+			tmp := append(make([]GoStatement, 0), stmts[:idx+1]...)
+			tmp = append(tmp, asgn)
+			m.Body().stmts = append(tmp, stmts[idx+1:]...)
+		}
+	}
+}
+
+// return true if this class has a 'self' field used to call virtual
+// methods
+func (cls *GoClassDefinition) hasSelf() bool {
+	if _, ok := cls.super.(*GoClassDefinition); ok {
+		return false
+	}
+
+	return cls.iface_name != "" || len(cls.virtuals) > 0 || cls.sub_virtuals
+}
+
+// return all non-static methods defined by this class
+func (cls *GoClassDefinition) instanceMethods() []*GoClassMethod {
+	mlist := make([]*GoClassMethod, 0)
+	for _, key := range cls.methods.SortedKeys() {
+		for _, m := range cls.methods.MethodList(key) {
+			if cm, ok := m.(*GoClassMethod); ok &&
+				cm.method_type == mt_method {
+				mlist = append(mlist, cm)
+			}
+		}
+	}
+
+	return mlist
+}
+
+// return the name of the interface used to call virtual methods
+func (cls *GoClassDefinition) virtualName() string {
+	if cls.iface_name != "" {
+		return cls.iface_name
+	}
+
+	return cls.name + "Interface"
+}
+
+// declare the interface used to call virtual methods (for abstract
+// classes, this includes all methods so it can be used as a variable type)
+func (cls *GoClassDefinition) virtualDecl() ast.Decl {
+	mlist := cls.virtuals
+	if cls.iface_name != "" {
+		mlist = cls.instanceMethods()
+	}
+
+	flds := make([]*ast.Field, len(mlist))
+	for i, m := range mlist {
+		flds[i] = makeField(m.goname, &ast.FuncType{Params: m.paramList(),
+			Results: m.results()})
+	}
+
+	specs := []ast.Spec{&ast.TypeSpec{Name: ast.NewIdent(cls.virtualName()),
+		Type: &ast.InterfaceType{Methods: &ast.FieldList{List: flds}}}}
+
+	return &ast.GenDecl{Tok: token.TYPE, Specs: specs}
+}
+
+func (cls *GoClassDefinition) finalize(gp *GoProgram) {
+	// bind method references to the best overload
+	cls.methods.resolveReferences(gp, cls)
+
+	if cls.is_adapter {
+		// function adapters don't have constructors or fields
+		cls.renameOverloads(gp)
+		return
+	}
+
+	// move variable initialization code inside constructors
+	cls.internalizeVarInits(gp)
+	// point 'self' at the most-derived object
+	cls.initializeSelf()
+	// give overloaded methods distinct names
+	cls.renameOverloads(gp)
+}
+
+func findAssigned(unasgned_vars []*GoVarInit, gv GoVar) int {
+	for i, v := range unasgned_vars {
+		if v == nil {
+			continue
+		}
+
+		if v.govar.Equals(gv) {
+			unasgned_vars[i] = nil
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (cls *GoClassDefinition) FindMethod(name string,
+	args *GoMethodArguments) GoMethod {
+	return cls.methods.FindMethod(name, args)
+}
+
+// return the instance or static field named 'name'
+func (cls *GoClassDefinition) findField(name string) GoVar {
+	for _, v := range cls.vars {
+		if v.govar.Name() == name {
+			return v.govar
+		}
+	}
+
+	for _, stat := range cls.statics {
+		if stat.init.govar.Name() == name {
+			return stat.init.govar
+		}
+	}
+
+	return nil
+}
+
+func (cls *GoClassDefinition) findVariable(typename *grammar.JTypeName) GoVar {
+	if !typename.IsDotted() {
+		if govar := cls.findField(typename.String()); govar != nil {
+			return govar
+		}
+	}
+
+	for _, c := range cls.constants {
+		if c.name == typename.String() {
+			return c
+		}
+	}
+
+	for _, i := range cls.interfaces {
+		govar := i.findVariable(typename)
+		if govar != nil {
+			return govar
+		}
+	}
+
+	if cls.super != nil {
+		return cls.super.findVariable(typename)
+	}
+
+	return nil
+}
+
+func (cls *GoClassDefinition) internalizeVarInits(gp *GoProgram) {
+	// build list of constructors
+	ctors := make([]GoMethod, 0)
+	for _, key := range cls.methods.SortedKeys() {
+		for _, m := range cls.methods.MethodList(key) {
+			if m.MethodType() == mt_constructor {
+				ctors = append(ctors, m)
+			}
+		}
+	}
+
+	// fix this()/super() in all ctors
+	for _, m := range ctors {
+		stmts := m.Body().stmts
+
+		sawCTOR := false
+		for _, s := range stmts {
+			if _, nok := s.(*GoNewStruct); nok {
+				sawCTOR = true
+			}
+		}
+
+		if cls.super != nil && !sawCTOR {
+			if cls.program.verbose {
+				log.Printf("//ERR// Not adding %v superclass %v initializer\n",
+					cls.name, cls.super.Name())
+			} else {
+				log.Printf("//ERR// Not adding superclass initializer\n")
+			}
+		}
+
+		m.Body().stmts = stmts
+	}
+
+	// if there are no constructors, create one
+	if len(ctors) == 0 {
+		m := cls.createConstructor(gp)
+		ctors = append(ctors, m)
+	}
+
+	// build list of initialized variables
+	init_vars := make([]*GoVarInit, 0)
+	for _, v := range cls.vars {
+		if v.expr != nil {
+			init_vars = append(init_vars, v)
+		}
+	}
+
+	// if nothing is initialized, we're done
+	if len(init_vars) > 0 || len(cls.initializers) > 0 {
+		// add initializers to all constructors
+		for i, m := range ctors {
+			if gp.verbose {
+				log.Printf("//ERR// ## CTOR#%d: %v\n", i, m)
+			}
+
+			// initially assume all variables need to be initialized
+			num_unasgned := len(init_vars)
+			unasgned_vars := make([]*GoVarInit, num_unasgned)
+			for i, v := range init_vars {
+				unasgned_vars[i] = v
+			}
+
+			for _, s := range m.Body().stmts {
 				if asgn, aok := s.(*GoAssign); aok {
 					// ignore non-assignments
 					if asgn.tok != token.ASSIGN {
@@ -1190,7 +2061,7 @@ func (cls *GoClassDefinition) internalizeVarInits(gp *GoProgram) {
 				}
 			}
 
-			if num_unasgned == 0 {
+			if num_unasgned == 0 && len(cls.initializers) == 0 {
 				continue
 			}
 
@@ -1209,6 +2080,12 @@ func (cls *GoClassDefinition) internalizeVarInits(gp *GoProgram) {
 							}
 						}
 
+						// instance initializer blocks run after all
+						// variables have been initialized
+						for _, blk := range cls.initializers {
+							tmp = append(tmp, blk)
+						}
+
 						// append remaining statements
 						tmp = append(tmp, m.Body().stmts[i+1:]...)
 
@@ -1239,8 +2116,29 @@ func (cls *GoClassDefinition) Parent() GoMethodOwner {
 	return cls.parent
 }
 
-func (cls *GoClassDefinition) renumberDuplicateMethods(gp *GoProgram) {
-	cls.methods.renumberDuplicateMethods(gp)
+func (cls *GoClassDefinition) renameOverloads(gp *GoProgram) {
+	root := cls.rootClass()
+
+	// overridden methods need the same name throughout the hierarchy
+	inherited := func(name string) bool {
+		sigs := make(map[string]bool)
+		for _, c := range gp.classes {
+			cd, ok := c.(*GoClassDefinition)
+			if !ok || cd.rootClass() != root {
+				continue
+			}
+
+			for _, m := range cd.methods.overloads(name) {
+				if m.MethodType() == mt_method {
+					sigs[methodKey(gp, root, m)] = true
+				}
+			}
+		}
+
+		return len(sigs) > 1
+	}
+
+	cls.methods.renameOverloads(gp, cls, inherited)
 }
 
 func (cd *GoClassDefinition) RunTransform(xform TransformFunc, prog *GoProgram, cls GoClass, parent GoObject) (GoObject, bool) {
@@ -1289,6 +2187,12 @@ func (cd *GoClassDefinition) RunTransform(xform TransformFunc, prog *GoProgram,
 		}
 	}
 
+	if cd.static_init != nil {
+		// the replacement is ignored since init() is also referenced
+		// by the program's list of static initializers
+		cd.static_init.RunTransform(xform, prog, cd, cd)
+	}
+
 	return xform(parent, prog, cd, cd)
 }
 
@@ -1318,6 +2222,13 @@ func (cls *GoClassDefinition) struct_type() *ast.StructType {
 	for _, v := range cls.vars {
 		flds = append(flds, makeField(v.govar.GoName(), v.govar.Type()))
 	}
+	for _, c := range cls.captures {
+		flds = append(flds, makeField(c.field.GoName(), c.field.Type()))
+	}
+	if cls.hasSelf() {
+		flds = append(flds, makeField("self",
+			ast.NewIdent(cls.virtualName())))
+	}
 
 	return &ast.StructType{Fields: &ast.FieldList{List: flds}}
 }
@@ -1356,13 +2267,19 @@ type GoClassMethod struct {
 	method_type methodType
 	params      []GoVar
 	body        *GoBlock
+	// true if this method is overridden and must be called through 'self'
+	is_virtual bool
+	// true for abstract methods, which have no body
+	is_abstract bool
+	// true if the last parameter accepts a variable number of arguments
+	is_varargs bool
 }
 
 func NewGoClassMethod(class GoMethodOwner, gs *GoState, jmth *grammar.JMethodDecl) *GoClassMethod {
 	var mtype methodType
 	if jmth.Modifiers.HasAnnotation("Test") {
 		mtype = mt_test
-	} else if jmth.Name == class.Name() {
+	} else if jmth.Name == javaClassName(class) {
 		mtype = mt_constructor
 	} else if jmth.Modifiers.IsSet(grammar.ModStatic) {
 		mtype = mt_static
@@ -1373,16 +2290,23 @@ func NewGoClassMethod(class GoMethodOwner, gs *GoState, jmth *grammar.JMethodDec
 	name := jmth.Name
 	goname := fixName(jmth.Name, jmth.Modifiers)
 	if mtype == mt_constructor {
-		name = "New" + name
-		goname = "New" + goname
+		// nested class constructors use the class's Go name
+		name = "New" + class.Name()
+		goname = "New" + fixName(class.Name(), jmth.Modifiers)
 	} else if mtype == mt_static && goname == "Main" {
 		goname = "main"
 		mtype = mt_main
+	} else if mtype == mt_method {
+		if gname := conventionalName(jmth.Name,
+			len(jmth.FormalParams)); gname != "" {
+			goname = gname
+		}
 	}
 
 	gs2 := NewGoState(gs)
 
 	var params []GoVar
+	var is_varargs bool
 	if mtype == mt_test {
 		if jmth.FormalParams != nil && len(jmth.FormalParams) > 0 {
 			if gs.Program().verbose {
@@ -1397,23 +2321,15 @@ func NewGoClassMethod(class GoMethodOwner, gs *GoState, jmth *grammar.JMethodDec
 
 		for i, fp := range jmth.FormalParams {
 			if fp.TypeSpec != nil {
-				if fp.Dims != 0 {
-					if gs.Program().verbose {
-						log.Printf("//ERR// Ignoring %s dims=%d for %s.%s\n",
-							fp.Name, fp.Dims, class.Name(), jmth.Name)
-					} else {
-						log.Printf("//ERR// Ignoring mthd param dims\n")
-					}
-				} else if fp.DotDotDot {
-					if gs.Program().verbose {
-						log.Printf("//ERR// Ignoring %s DotDotDot=true for %s.%s\n",
-							fp.Name, class.Name(), jmth.Name)
-					} else {
-						log.Printf("//ERR// Ignoring mthd param...\n")
-					}
+				// C-style "int x[]" dims are added to the type's dims,
+				// and varargs parameters are passed as a slice
+				dims := fp.Dims
+				if fp.DotDotDot {
+					is_varargs = true
+					dims++
 				}
 
-				govar := gs2.addVariable(fp.Name, fp.Modifiers, fp.Dims,
+				govar := gs2.addVariable(fp.Name, fp.Modifiers, dims,
 					fp.TypeSpec, false)
 
 				params[i] = govar
@@ -1426,6 +2342,14 @@ func NewGoClassMethod(class GoMethodOwner, gs *GoState, jmth *grammar.JMethodDec
 		rvar = gs2.addVariable(gs.Receiver(), nil, 0, nil, false)
 	}
 
+	// inner class constructors are passed the enclosing instance
+	var outer GoVar
+	if cls, ok := class.(*GoClassDefinition); ok && mtype == mt_constructor {
+		if outer = cls.outerParam(); outer != nil {
+			params = append([]GoVar{outer}, params...)
+		}
+	}
+
 	var typedata *TypeData
 	if jmth.TypeSpec != nil &&
 		(mtype == mt_method || mtype == mt_static) {
@@ -1434,10 +2358,27 @@ func NewGoClassMethod(class GoMethodOwner, gs *GoState, jmth *grammar.JMethodDec
 	}
 
 	body := analyzeBlock(gs2, class, jmth.Block)
+	if body != nil && typedata != nil && typedata.vtype != VT_VOID {
+		// values returned inside try-with-resources blocks are saved in
+		// a variable of the method's result type
+		body.RunTransform(func(parent GoObject, prog *GoProgram,
+			cls GoClass, object GoObject) (GoObject, bool) {
+			if try, ok := object.(*GoTry); ok && try.returns {
+				try.rtype = typedata
+			}
+
+			return nil, true
+		}, gs.Program(), nil, nil)
+	}
 
 	mthd := &GoClassMethod{class: class, name: name, goname: goname,
 		typedata: typedata, rcvr: rvar, method_type: mtype, params: params,
-		body: body}
+		body: body, is_varargs: is_varargs}
+
+	if mtype == mt_method && jmth.Modifiers != nil &&
+		jmth.Modifiers.IsSet(grammar.ModAbstract) {
+		mthd.is_abstract = true
+	}
 
 	if mtype == mt_test {
 		// make sure program imports 'testing' package
@@ -1447,18 +2388,26 @@ func NewGoClassMethod(class GoMethodOwner, gs *GoState, jmth *grammar.JMethodDec
 	if mtype == mt_constructor {
 		// fix this()/super()
 		has_this := false
+		has_super := false
 		if len(mthd.body.stmts) >= 1 {
 			if xstmt, xok := mthd.body.stmts[0].(*GoExprStmt); xok {
 				if macc, mok := xstmt.x.(*GoMethodAccessKeyword); mok {
 					if !macc.is_super {
+						args := macc.args
+						if outer != nil {
+							args = &GoMethodArguments{append(
+								[]GoExpr{outer}, args.args...)}
+						}
+
 						newstmt := NewGoNewStruct(rvar, class,
-							false, macc.args)
+							false, args)
 						mthd.body.stmts[0] = newstmt
 						has_this = true
 					} else if class.Super() != nil {
 						newstmt := NewGoNewStruct(rvar, class.Super(),
 							true, macc.args)
 						mthd.body.stmts[0] = newstmt
+						has_super = true
 					} else {
 						// super() called on class without a superclass!
 						mthd.body.stmts[0] = assignNewStruct(rvar, class)
@@ -1471,6 +2420,12 @@ func NewGoClassMethod(class GoMethodOwner, gs *GoState, jmth *grammar.JMethodDec
 		if !has_this {
 			list := []GoStatement{assignNewStruct(rvar, class)}
 
+			// Java implicitly calls the superclass's no-arg constructor
+			if sup, ok := class.Super().(*GoClassDefinition); ok && !has_super {
+				list = append(list, NewGoNewStruct(rvar, sup, true,
+					&GoMethodArguments{}))
+			}
+
 			if mthd.body == nil {
 				mthd.body = &GoBlock{stmts: list}
 			} else {
@@ -1498,6 +2453,11 @@ func (mthd *GoClassMethod) Class() GoMethodOwner {
 }
 
 func (mthd *GoClassMethod) Decl() ast.Decl {
+	if mthd.is_abstract {
+		// abstract methods are only declared in the class's interface
+		return nil
+	}
+
 	mtype := &ast.FuncType{Params: mthd.paramList(), Results: mthd.results()}
 
 	return &ast.FuncDecl{Name: ast.NewIdent(mthd.goname),
@@ -1560,7 +2520,14 @@ func (mthd *GoClassMethod) paramList() *ast.FieldList {
 		flist := make([]*ast.Field, len(mthd.params))
 
 		for i, fp := range mthd.params {
-			flist[i] = makeField(fp.Name(), fp.Type())
+			ptype := fp.Type()
+			if mthd.is_varargs && i == len(mthd.params)-1 {
+				if atype, ok := ptype.(*ast.ArrayType); ok {
+					ptype = &ast.Ellipsis{Elt: atype.Elt}
+				}
+			}
+
+			flist[i] = makeField(fp.Name(), ptype)
 		}
 
 		return &ast.FieldList{List: flist}
@@ -1599,7 +2566,7 @@ func (mthd *GoClassMethod) results() *ast.FieldList {
 		fallthrough
 	case mt_method:
 		if mthd.typedata != nil {
-			if typename, is_nil := mthd.typedata.TypeName(); !is_nil {
+			if typename := mthd.typedata.Expr(); typename != nil {
 				rlist := make([]*ast.Field, 1)
 				rlist[0] = makeField("", typename)
 				return &ast.FieldList{List: rlist}
@@ -1689,6 +2656,45 @@ func (mthd *GoClassMethod) WriteString(out io.Writer) {
 	io.WriteString(out, "]")
 }
 
+// Java class literal such as "Foo.class"; Go has no class objects, so this
+// is translated to the class name
+type GoClassLiteral struct {
+	name string
+}
+
+func (lit *GoClassLiteral) Expr() ast.Expr {
+	return &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(lit.name)}
+}
+
+func (lit *GoClassLiteral) hasVariable(govar GoVar) bool {
+	return false
+}
+
+func (lit *GoClassLiteral) Init() ast.Stmt {
+	return nil
+}
+
+func (lit *GoClassLiteral) RunTransform(xform TransformFunc, prog *GoProgram, cls GoClass, parent GoObject) (GoObject, bool) {
+	return xform(parent, prog, cls, lit)
+}
+
+// return the class name without any package
+func (lit *GoClassLiteral) SimpleName() string {
+	if idx := strings.LastIndex(lit.name, "."); idx >= 0 {
+		return lit.name[idx+1:]
+	}
+
+	return lit.name
+}
+
+func (lit *GoClassLiteral) String() string {
+	return "GoClassLiteral[" + lit.name + "]"
+}
+
+func (lit *GoClassLiteral) VarType() *TypeData {
+	return stringType
+}
+
 type GoClassReference struct {
 	name   string
 	parent GoMethodOwner
@@ -1711,12 +2717,12 @@ func (cref *GoClassReference) AddMethod(mthd GoMethod) {
 	cref.parent.AddMethod(mthd)
 }
 
-func (cref *GoClassReference) Constants() []ast.Decl {
+func (cref *GoClassReference) Constants(gp *GoProgram) []ast.Decl {
 	if cref.parent == nil {
 		panic(fmt.Sprintf("ClassReference %v has no parent", cref.name))
 	}
 
-	return cref.parent.Constants()
+	return cref.parent.Constants(gp)
 }
 
 func (cref *GoClassReference) Decls() []ast.Decl {
@@ -1802,10 +2808,16 @@ type GoConstant struct {
 	init     *GoVarInit
 }
 
-func (con *GoConstant) Decl() ast.Decl {
+func (con *GoConstant) Decl(gp *GoProgram) ast.Decl {
 	vals := []ast.Expr{con.init.Expr()}
+
+	var vtype ast.Expr
+	if con.init.expr != nil && needConstantType(gp, con.typedata, con.init.expr) {
+		vtype = con.typedata.Expr()
+	}
+
 	vspec := &ast.ValueSpec{Names: []*ast.Ident{ast.NewIdent(con.name)},
-		Values: vals}
+		Type: vtype, Values: vals}
 	return &ast.GenDecl{Tok: token.CONST, Specs: []ast.Spec{vspec}}
 }
 
@@ -1905,11 +2917,20 @@ type GoNewStruct struct {
 	cls      GoMethodOwner
 	is_super bool
 	args     *GoMethodArguments
+	method   GoMethod
 }
 
 func NewGoNewStruct(rcvr GoVar, cls GoMethodOwner, is_super bool,
 	args *GoMethodArguments) *GoNewStruct {
-	return &GoNewStruct{rcvr: rcvr, cls: cls, is_super: is_super, args: args}
+	// find the constructor so overloaded constructors are called by
+	// their final names
+	var mthd GoMethod
+	if cd, ok := cls.(*GoClassDefinition); ok {
+		mthd = findMethod(cd, cd, "New"+cd.name, args, cd.program.verbose)
+	}
+
+	return &GoNewStruct{rcvr: rcvr, cls: cls, is_super: is_super, args: args,
+		method: mthd}
 }
 
 func (gsc *GoNewStruct) hasVariable(govar GoVar) bool {
@@ -1948,9 +2969,15 @@ func (gsc *GoNewStruct) Stmts() []ast.Stmt {
 		args = gsc.args.ExprList()
 	}
 
+	var ctor string
+	if gsc.method != nil {
+		ctor = gsc.method.GoName()
+	} else {
+		ctor = "New" + gsc.cls.Name()
+	}
+
 	rhs := make([]ast.Expr, 1)
-	rhs[0] = &ast.CallExpr{Fun: ast.NewIdent("New" + gsc.cls.Name()),
-		Args: args}
+	rhs[0] = &ast.CallExpr{Fun: ast.NewIdent(ctor), Args: args}
 
 	return []ast.Stmt{&ast.AssignStmt{Lhs: lhs, Tok: token.ASSIGN,
 		Rhs: rhs}}
@@ -2092,7 +3119,8 @@ func (exst *GoExprStmt) RunTransform(xform TransformFunc, prog *GoProgram, cls G
 }
 
 func (exst *GoExprStmt) Stmts() []ast.Stmt {
-	return []ast.Stmt{&ast.ExprStmt{X: exst.x.Expr()}}
+	stmts := hoistInstanceOf(exst.x)
+	return append(stmts, &ast.ExprStmt{X: exst.x.Expr()})
 }
 
 func (exst *GoExprStmt) String() string {
@@ -2133,7 +3161,7 @@ func (gfc *GoFakeClass) AddMethod(mthd GoMethod) {
 	gfc.methods[mthd.Name()] = mthd
 }
 
-func (gfc *GoFakeClass) Constants() []ast.Decl {
+func (gfc *GoFakeClass) Constants(gp *GoProgram) []ast.Decl {
 	return nil
 }
 
@@ -2718,28 +3746,38 @@ type GoIfaceMethod struct {
 
 func NewGoInterfaceMethod(gp *GoProgram, iface_name string,
 	imth *grammar.JInterfaceMethodDecl) *GoIfaceMethod {
+	return newGoIfaceMethod(gp, iface_name, imth.Name, imth.FormalParams,
+		imth.TypeSpec)
+}
+
+func newGoIfaceMethod(gp *GoProgram, iface_name string, name string,
+	fparams []*grammar.JFormalParameter,
+	typespec *grammar.JReferenceType) *GoIfaceMethod {
 	gm := &GoIfaceMethod{}
 
-	gm.name = imth.Name
-	gm.goname = strings.ToUpper(imth.Name[:1]) + imth.Name[1:]
+	gm.name = name
+	gm.goname = conventionalName(name, len(fparams))
+	if gm.goname == "" {
+		gm.goname = strings.ToUpper(name[:1]) + name[1:]
+	}
 
 	var gs *GoState
 
-	if imth.FormalParams != nil && len(imth.FormalParams) > 0 {
-		gm.param_list = make([]GoVar, len(imth.FormalParams))
-		for i, fp := range imth.FormalParams {
+	if fparams != nil && len(fparams) > 0 {
+		gm.param_list = make([]GoVar, len(fparams))
+		for i, fp := range fparams {
 			if fp.TypeSpec != nil {
 				if fp.Dims != 0 {
 					if gp.verbose {
 						log.Printf("//ERR// Ignoring %s dims=%d for %s.%s\n",
-							fp.Name, fp.Dims, iface_name, imth.Name)
+							fp.Name, fp.Dims, iface_name, name)
 					} else {
 						log.Printf("//ERR// Ignoring non-zero interface dims\n")
 					}
 				} else if fp.DotDotDot {
 					if gp.verbose {
 						log.Printf("//ERR// Ignoring %s DotDotDot=true for %s.%s\n",
-							fp.Name, iface_name, imth.Name)
+							fp.Name, iface_name, name)
 					} else {
 						log.Printf("//ERR// Ignoring interface DotDotDot\n")
 					}
@@ -2757,9 +3795,9 @@ func NewGoInterfaceMethod(gp *GoProgram, iface_name string,
 		}
 	}
 
-	if imth.TypeSpec != nil {
-		gm.result_type = gp.createTypeData(imth.TypeSpec.Name,
-			imth.TypeSpec.TypeArgs, imth.TypeSpec.Dims)
+	if typespec != nil {
+		gm.result_type = gp.createTypeData(typespec.Name,
+			typespec.TypeArgs, typespec.Dims)
 	}
 
 	return gm
@@ -2795,7 +3833,17 @@ func (gm *GoIfaceMethod) GoName() string {
 }
 
 func (gm *GoIfaceMethod) HasArguments(args *GoMethodArguments) bool {
-	panic("Unimplemented")
+	if len(gm.param_list) != args.Length() {
+		return false
+	}
+
+	for i, arg := range gm.param_list {
+		if !arg.VarType().Equals(args.args[i].VarType()) {
+			return false
+		}
+	}
+
+	return true
 }
 
 func (gm *GoIfaceMethod) IsMethod(mthd GoMethod) bool {
@@ -2833,7 +3881,7 @@ func (gm *GoIfaceMethod) Receiver() GoVar {
 
 func (gm *GoIfaceMethod) results() *ast.FieldList {
 	if gm.result_type != nil {
-		if typename, is_nil := gm.result_type.TypeName(); !is_nil {
+		if typename := gm.result_type.Expr(); typename != nil {
 			rlist := make([]*ast.Field, 1)
 			rlist[0] = &ast.Field{Names: make([]*ast.Ident, 0), Type: typename}
 
@@ -2909,9 +3957,63 @@ func (gie *GoIfElse) hasVariable(govar GoVar) bool {
 	return false
 }
 
+// return the statement and expression used as the 'if' condition,
+// turning "x instanceof Foo" into "_, ok := x.(*Foo); ok"
+func (gie *GoIfElse) condition() (ast.Stmt, ast.Expr) {
+	if gio, ok := gie.cond.(*GoInstanceOf); ok {
+		name := "_"
+		if gio.binding != nil && gie.ifblk.hasVariable(gio.binding) {
+			name = gio.binding.Name()
+		}
+
+		return gio.assign(name), ast.NewIdent("ok")
+	}
+
+	if uex, ok := gie.cond.(*GoUnaryExpr); ok && uex.op == token.NOT {
+		if gio, ok := uex.x.(*GoInstanceOf); ok {
+			return gio.assign("_"),
+				&ast.UnaryExpr{Op: token.NOT, X: ast.NewIdent("ok")}
+		}
+	}
+
+	// "x instanceof Foo f && ..." binds 'f' for the rest of the condition
+	rest := make([]GoExpr, 0)
+	left := gie.cond
+	for {
+		bex, ok := left.(*GoBinaryExpr)
+		if !ok || bex.op != token.LAND {
+			break
+		}
+		rest = append(rest, bex.y)
+		left = bex.x
+	}
+	if gio, ok := left.(*GoInstanceOf); ok && len(rest) > 0 {
+		name := "_"
+		if gio.binding != nil {
+			used := gie.ifblk.hasVariable(gio.binding)
+			for _, r := range rest {
+				used = used || r.hasVariable(gio.binding)
+			}
+			if used {
+				name = gio.binding.Name()
+			}
+		}
+
+		gio.hoisted = "ok"
+		return gio.assign(name), gie.cond.Expr()
+	}
+
+	if tests := findInstanceOf(gie.cond); len(tests) == 1 &&
+		gie.cond.Init() == nil {
+		tests[0].hoisted = "ok"
+		return tests[0].assign("_"), gie.cond.Expr()
+	}
+
+	return gie.cond.Init(), gie.cond.Expr()
+}
+
 func (gie *GoIfElse) IfStmt() *ast.IfStmt {
-	init := gie.cond.Init()
-	cond := gie.cond.Expr()
+	init, cond := gie.condition()
 
 	ifblk := gie.ifblk.Stmts()
 	if ifblk == nil {
@@ -2934,7 +4036,7 @@ func (gie *GoIfElse) IfStmt() *ast.IfStmt {
 		if stmts == nil {
 			panic("Else-block cannot return nil")
 		} else if len(stmts) > 1 {
-			panic("Else-block cannot return multiple statements")
+			ifstmt.Else = &ast.BlockStmt{List: stmts}
 		} else if len(stmts) == 1 {
 			ifstmt.Else = stmts[0]
 		}
@@ -3026,16 +4128,49 @@ func (gic *GoImportClass) FullName() string {
 }
 
 type GoInstanceOf struct {
-	expr    GoExpr
-	vartype GoVar
+	expr     GoExpr
+	typedata *TypeData
+	// pattern variable bound to the converted value, or nil
+	binding GoVar
+	// program which names hoisted results
+	program *GoProgram
+	// variable holding the result of a hoisted type assertion, or ""
+	hoisted string
+}
+
+// return "name, ok := expr.(type)"
+func (gio *GoInstanceOf) assign(name string) ast.Stmt {
+	lhs := []ast.Expr{ast.NewIdent(name), ast.NewIdent("ok")}
+
+	return &ast.AssignStmt{Lhs: lhs, Tok: token.DEFINE,
+		Rhs: []ast.Expr{gio.TypeExpr()}}
 }
 
+// Go has no expression which tests a value's type, so a type assertion
+// which cannot be hoisted into a statement is wrapped in a function
+// literal
 func (gio *GoInstanceOf) Expr() ast.Expr {
-	return ast.NewIdent("ok")
+	if gio.hoisted != "" {
+		return ast.NewIdent(gio.hoisted)
+	}
+
+	if gio.binding != nil {
+		log.Printf("//ERR// Cannot bind pattern variable %s outside an"+
+			" if statement\n", gio.binding.Name())
+	}
+
+	ftype := &ast.FuncType{Params: &ast.FieldList{},
+		Results: &ast.FieldList{List: []*ast.Field{
+			&ast.Field{Type: ast.NewIdent("bool")}}}}
+
+	rtn := &ast.ReturnStmt{Results: []ast.Expr{ast.NewIdent("ok")}}
+	body := &ast.BlockStmt{List: []ast.Stmt{gio.assign("_"), rtn}}
+
+	return &ast.CallExpr{Fun: &ast.FuncLit{Type: ftype, Body: body}}
 }
 
 func (gio *GoInstanceOf) hasVariable(govar GoVar) bool {
-	if gio.vartype != nil && gio.vartype.Equals(govar) {
+	if gio.binding != nil && gio.binding.Equals(govar) {
 		return true
 	}
 
@@ -3047,11 +4182,7 @@ func (gio *GoInstanceOf) hasVariable(govar GoVar) bool {
 }
 
 func (gio *GoInstanceOf) Init() ast.Stmt {
-	lhs := []ast.Expr{ast.NewIdent("_"), ast.NewIdent("ok")}
-	asrt := &ast.TypeAssertExpr{X: gio.expr.Expr(), Type: gio.vartype.Ident()}
-
-	return &ast.AssignStmt{Lhs: lhs, Tok: token.DEFINE,
-		Rhs: []ast.Expr{asrt}}
+	return nil
 }
 
 func (gio *GoInstanceOf) RunTransform(xform TransformFunc, prog *GoProgram, cls GoClass, parent GoObject) (GoObject, bool) {
@@ -3065,17 +4196,90 @@ func (gio *GoInstanceOf) RunTransform(xform TransformFunc, prog *GoProgram, cls
 		}
 	}
 
-	if gio.vartype != nil {
-		obj, is_nil := gio.vartype.RunTransform(xform, prog, cls, gio)
-		if !is_nil {
-			var err error
-			if gio.vartype, err = convertToVar(obj); err != nil {
-				panic(err)
+	return xform(parent, prog, cls, gio)
+}
+
+// return the statements which assign the results of the type tests in
+// 'expr' to variables, so the expression can use the variables instead
+// (tests which are not always evaluated are left in place)
+func hoistInstanceOf(expr GoExpr) []ast.Stmt {
+	tests := findInstanceOf(expr)
+	if len(tests) == 0 {
+		return nil
+	}
+
+	stmts := make([]ast.Stmt, len(tests))
+	for i, gio := range tests {
+		if gio.hoisted == "" {
+			gio.hoisted = gio.program.okName()
+		}
+
+		stmts[i] = &ast.AssignStmt{Lhs: []ast.Expr{ast.NewIdent("_"),
+			ast.NewIdent(gio.hoisted)}, Tok: token.DEFINE,
+			Rhs: []ast.Expr{gio.TypeExpr()}}
+	}
+
+	return stmts
+}
+
+// return the unbound type tests in 'expr' which are always evaluated
+func findInstanceOf(expr GoExpr) []*GoInstanceOf {
+	if expr == nil {
+		return nil
+	}
+
+	tests := make([]*GoInstanceOf, 0)
+	skip := make(map[*GoInstanceOf]bool)
+	expr.RunTransform(func(parent GoObject, prog *GoProgram,
+		cls GoClass, object GoObject) (GoObject, bool) {
+		switch o := object.(type) {
+		case *GoInstanceOf:
+			if o.binding == nil && o.program != nil {
+				tests = append(tests, o)
+			}
+		case *GoBinaryExpr:
+			if o.op == token.LAND || o.op == token.LOR {
+				// the right operand may not be evaluated
+				for _, t := range findInstanceOf(o.y) {
+					skip[t] = true
+				}
+			}
+		case *GoClassAlloc:
+			// statements in the body hoist their own type tests
+			for _, b := range o.body {
+				b.RunTransform(func(parent GoObject, prog *GoProgram,
+					cls GoClass, object GoObject) (GoObject, bool) {
+					if t, ok := object.(*GoInstanceOf); ok {
+						skip[t] = true
+					}
+					return nil, true
+				}, nil, nil, nil)
 			}
 		}
+
+		return nil, true
+	}, nil, nil, nil)
+
+	found := tests[:0]
+	for _, t := range tests {
+		if !skip[t] {
+			found = append(found, t)
+		}
 	}
 
-	return xform(parent, prog, cls, gio)
+	return found
+}
+
+// return true if 'other' tests the same variable
+func (gio *GoInstanceOf) sameTarget(other *GoInstanceOf) bool {
+	govar, ok := gio.expr.(GoVar)
+	if !ok {
+		return false
+	}
+
+	ovar, ok := other.expr.(GoVar)
+
+	return ok && govar.Equals(ovar)
 }
 
 func (gio *GoInstanceOf) String() string {
@@ -3084,12 +4288,16 @@ func (gio *GoInstanceOf) String() string {
 		estr = gio.expr.String()
 	}
 
-	var vstr string
-	if gio.vartype != nil {
-		vstr = gio.vartype.String()
+	var bstr string
+	if gio.binding != nil {
+		bstr = "|" + gio.binding.String()
 	}
 
-	return "GoInstanceOf[" + estr + "|" + vstr + "]"
+	return "GoInstanceOf[" + estr + "|" + gio.typedata.String() + bstr + "]"
+}
+
+func (gio *GoInstanceOf) TypeExpr() *ast.TypeAssertExpr {
+	return &ast.TypeAssertExpr{X: gio.expr.Expr(), Type: gio.typedata.Expr()}
 }
 
 func (gio *GoInstanceOf) VarType() *TypeData {
@@ -3097,13 +4305,14 @@ func (gio *GoInstanceOf) VarType() *TypeData {
 }
 
 type GoInterface interface {
-	Constants() []ast.Decl
+	Constants(*GoProgram) []ast.Decl
 	Decl() ast.Decl
 	finalize(*GoProgram)
 	findVariable(*grammar.JTypeName) GoVar
 	IsInterface() bool
 	Matches(*grammar.JTypeName) bool
 	Name() string
+	Statics() []ast.Decl
 	String() string
 	WriteString(io.Writer, bool)
 }
@@ -3120,6 +4329,10 @@ type GoInterfaceDefinition struct {
 
 	methods   *interfaceMethodMap
 	constants []*GoConstant
+	funcs     []*GoClassMethod
+	defaults  []*GoClassMethod
+
+	is_functype bool
 }
 
 func NewGoInterfaceDefinition(name string) *GoInterfaceDefinition {
@@ -3133,11 +4346,33 @@ func (gi *GoInterfaceDefinition) AddConstant(con *GoConstant) {
 	gi.constants = append(gi.constants, con)
 }
 
+// translate a static or default interface method into a package-level
+// function, passing the interface value to default methods as the
+// first parameter
+func (gi *GoInterfaceDefinition) addFunction(gp *GoProgram,
+	jmth *grammar.JMethodDecl) {
+	mthd := NewGoClassMethod(gi, &GoState{program: gp}, jmth)
+	mthd.goname = gi.name + "_" + jmth.Name
+
+	if mthd.method_type == mt_method {
+		rcvr := mthd.rcvr.(*GoVarData)
+		rcvr.vartype = &TypeData{vtype: VT_INTERFACE, vclass: gi.name}
+
+		mthd.params = append([]GoVar{rcvr}, mthd.params...)
+		mthd.rcvr = nil
+		mthd.method_type = mt_static
+
+		gi.defaults = append(gi.defaults, mthd)
+	} else {
+		gi.funcs = append(gi.funcs, mthd)
+	}
+}
+
 func (gi *GoInterfaceDefinition) AddMethod(newmthd GoMethod) {
 	gi.methods.AddMethod(newmthd, gi.methods)
 }
 
-func (gi *GoInterfaceDefinition) Constants() []ast.Decl {
+func (gi *GoInterfaceDefinition) Constants(gp *GoProgram) []ast.Decl {
 	if gi.constants == nil || len(gi.constants) == 0 {
 		return nil
 	}
@@ -3160,6 +4395,17 @@ func (gi *GoInterfaceDefinition) Constants() []ast.Decl {
 }
 
 func (gi *GoInterfaceDefinition) Decl() ast.Decl {
+	if gi.is_functype {
+		m := gi.funcTypeMethod()
+
+		ftype := &ast.FuncType{Params: m.params(), Results: m.results()}
+
+		specs := []ast.Spec{&ast.TypeSpec{Name: ast.NewIdent(gi.name),
+			Type: ftype}}
+
+		return &ast.GenDecl{Tok: token.TYPE, Specs: specs}
+	}
+
 	list := make([]*ast.Field, 0)
 	for _, key := range gi.methods.SortedKeys() {
 		for _, m := range gi.methods.MethodList(key) {
@@ -3180,8 +4426,45 @@ func (gi *GoInterfaceDefinition) Decl() ast.Decl {
 }
 
 func (gi *GoInterfaceDefinition) finalize(gp *GoProgram) {
-	// renumber duplicate methods
-	gi.renumberDuplicateMethods(gp)
+	// give overloaded methods distinct names
+	gi.methods.renameOverloads(gp, gi,
+		func(name string) bool { return false })
+}
+
+// return the only method of an interface, or nil if there are zero or
+// multiple methods
+func (gi *GoInterfaceDefinition) funcTypeMethod() *GoIfaceMethod {
+	var found *GoIfaceMethod
+	for _, key := range gi.methods.SortedKeys() {
+		for _, m := range gi.methods.MethodList(key) {
+			im, ok := m.(*GoIfaceMethod)
+			if !ok || found != nil {
+				return nil
+			}
+
+			found = im
+		}
+	}
+
+	return found
+}
+
+// translate this interface as a function type if it only has a single
+// abstract method
+func (gi *GoInterfaceDefinition) setFuncType(gp *GoProgram) {
+	if gi.funcTypeMethod() == nil || len(gi.defaults) > 0 ||
+		len(gi.funcs) > 0 {
+		if gp.verbose {
+			log.Printf("//ERR// Interface %s is not a function type\n",
+				gi.name)
+		} else {
+			log.Printf("//ERR// Interface is not a function type\n")
+		}
+
+		return
+	}
+
+	gi.is_functype = true
 }
 
 func (gi *GoInterfaceDefinition) findVariable(name *grammar.JTypeName) GoVar {
@@ -3196,7 +4479,27 @@ func (gi *GoInterfaceDefinition) findVariable(name *grammar.JTypeName) GoVar {
 
 func (gi *GoInterfaceDefinition) FindMethod(name string,
 	args *GoMethodArguments) GoMethod {
-	return gi.methods.FindMethod(name, args)
+	if mthd := gi.methods.FindMethod(name, args); mthd != nil {
+		return mthd
+	}
+
+	if mthd := gi.findFunction(name, args); mthd != nil {
+		return mthd
+	}
+
+	return nil
+}
+
+// return the static interface function named 'name'
+func (gi *GoInterfaceDefinition) findFunction(name string,
+	args *GoMethodArguments) *GoClassMethod {
+	for _, f := range gi.funcs {
+		if f.name == name && f.HasArguments(args) {
+			return f
+		}
+	}
+
+	return nil
 }
 
 func (gi *GoInterfaceDefinition) IsInterface() bool {
@@ -3215,12 +4518,20 @@ func (gi *GoInterfaceDefinition) Name() string {
 	return gi.name
 }
 
-func (gi *GoInterfaceDefinition) renumberDuplicateMethods(gp *GoProgram) {
-	gi.methods.renumberDuplicateMethods(gp)
-}
-
 func (gi *GoInterfaceDefinition) Statics() []ast.Decl {
-	return nil
+	if len(gi.funcs) == 0 && len(gi.defaults) == 0 {
+		return nil
+	}
+
+	decls := make([]ast.Decl, 0)
+	for _, f := range gi.funcs {
+		decls = append(decls, f.Decl())
+	}
+	for _, f := range gi.defaults {
+		decls = append(decls, f.Decl())
+	}
+
+	return decls
 }
 
 func (gi *GoInterfaceDefinition) String() string {
@@ -3261,9 +4572,9 @@ type GoInterfaceReference struct {
 	realiface *GoInterfaceDefinition
 }
 
-func (ref *GoInterfaceReference) Constants() []ast.Decl {
+func (ref *GoInterfaceReference) Constants(gp *GoProgram) []ast.Decl {
 	if ref.realiface != nil {
-		return ref.realiface.Constants()
+		return ref.realiface.Constants(gp)
 	}
 
 	return nil
@@ -3311,6 +4622,14 @@ func (ref *GoInterfaceReference) Name() string {
 	return ref.name.String()
 }
 
+func (ref *GoInterfaceReference) Statics() []ast.Decl {
+	if ref.realiface != nil {
+		return ref.realiface.Statics()
+	}
+
+	return nil
+}
+
 func (ref *GoInterfaceReference) String() string {
 	if ref.realiface != nil {
 		return ref.realiface.String()
@@ -3377,6 +4696,9 @@ func (j2l *GoJumpToLabel) String() string {
 type GoKeyword struct {
 	token int
 	name  string
+	// class and receiver name of 'this'
+	vartype *TypeData
+	rcvr    string
 }
 
 func NewGoKeyword(token int, name string) *GoKeyword {
@@ -3401,6 +4723,10 @@ func (key *GoKeyword) Ident() *ast.Ident {
 	} else if key.name == "true" || key.name == "false" {
 		return ast.NewIdent(key.name)
 	} else if key.name == "this" {
+		if key.rcvr != "" {
+			return ast.NewIdent(key.rcvr)
+		}
+
 		return ast.NewIdent("this")
 	}
 	log.Printf("//ERR// Not converting keyword %s\n", key.name)
@@ -3420,7 +4746,9 @@ func (key *GoKeyword) String() string {
 }
 
 func (key *GoKeyword) VarType() *TypeData {
-	if key.name == "null" {
+	if key.vartype != nil {
+		return key.vartype
+	} else if key.name == "null" {
 		return voidType
 	} else if key.name == "true" || key.name == "false" {
 		return boolType
@@ -3530,8 +4858,12 @@ func (gl *GoLiteral) VarType() *TypeData {
 		return stringType
 	} else if gl.text[0] == '\'' {
 		return charType
+	} else if gl.text == "true" || gl.text == "false" {
+		return boolType
 	} else if strings.Contains(gl.text, ".") {
 		return doubleType
+	} else if strings.HasSuffix(gl.text, "L") {
+		return longType
 	}
 
 	return intType
@@ -3624,6 +4956,19 @@ func (glv *GoLocalVarInit) RunTransform(xform TransformFunc, prog *GoProgram, cl
 }
 
 func (glv *GoLocalVarInit) Stmts() []ast.Stmt {
+	if gio, ok := glv.init.(*GoInstanceOf); ok && gio.binding == nil {
+		// "_, name := x.(*Foo)"
+		lhs := []ast.Expr{ast.NewIdent("_"), ast.NewIdent(glv.govar.Name())}
+		return []ast.Stmt{&ast.AssignStmt{Lhs: lhs, Tok: token.DEFINE,
+			Rhs: []ast.Expr{gio.TypeExpr()}}}
+	}
+
+	if aa := multiDimAlloc(glv.init); aa != nil {
+		return aa.allocStmts(ast.NewIdent(glv.govar.Name()), token.DEFINE)
+	}
+
+	stmts := hoistInstanceOf(glv.init)
+
 	rhs := make([]ast.Expr, 1)
 	rhs[0] = glv.init.Expr()
 
@@ -3632,7 +4977,7 @@ func (glv *GoLocalVarInit) Stmts() []ast.Stmt {
 
 	tok := token.DEFINE
 
-	return []ast.Stmt{&ast.AssignStmt{Lhs: lhs, Tok: tok, Rhs: rhs}}
+	return append(stmts, &ast.AssignStmt{Lhs: lhs, Tok: tok, Rhs: rhs})
 }
 
 func (glv *GoLocalVarInit) String() string {
@@ -3679,44 +5024,18 @@ func (glv *GoLocalVarCast) RunTransform(xform TransformFunc, prog *GoProgram, cl
 			if glv.cast, err = convertToExpr(obj); err != nil {
 				panic(err)
 			}
-		}
-	}
-
-	return xform(parent, prog, cls, glv)
-}
-
-func (glv *GoLocalVarCast) Stmts() []ast.Stmt {
-	blklist := make([]ast.Stmt, 2)
-
-	rhs := make([]ast.Expr, 1)
-	rhs[0] = glv.cast.Expr()
-
-	ok_ident := ast.NewIdent("ok")
-
-	lhs := make([]ast.Expr, 2)
-	lhs[0] = ast.NewIdent(glv.govar.Name())
-	lhs[1] = ok_ident
-
-	tok := token.DEFINE
-
-	blklist[0] = &ast.AssignStmt{Lhs: lhs, Tok: tok, Rhs: rhs}
-
-	cond := &ast.UnaryExpr{Op: token.NOT, X: ok_ident}
-
-	args := make([]ast.Expr, 1)
-	args[0] = &ast.BasicLit{Kind: token.STRING,
-		//Value: fmt.Sprintf("\"Cannot cast %v to %v\"", glv.cast.target,
-		//	glv.cast.vartype)}
-		Value: fmt.Sprintf("\"XXX Cast fail for %T\"", glv.cast)}
-
-	panic_expr := &ast.CallExpr{Fun: ast.NewIdent("panic"), Args: args}
+		}
+	}
 
-	list := make([]ast.Stmt, 1)
-	list[0] = &ast.ExprStmt{X: panic_expr}
+	return xform(parent, prog, cls, glv)
+}
 
-	blklist[1] = &ast.IfStmt{Cond: cond, Body: &ast.BlockStmt{List: list}}
+// a failed type assertion panics, like Java's ClassCastException
+func (glv *GoLocalVarCast) Stmts() []ast.Stmt {
+	lhs := []ast.Expr{ast.NewIdent(glv.govar.Name())}
 
-	return blklist
+	return []ast.Stmt{&ast.AssignStmt{Lhs: lhs, Tok: token.DEFINE,
+		Rhs: []ast.Expr{glv.cast.Expr()}}}
 }
 
 func (glc *GoLocalVarCast) String() string {
@@ -3772,6 +5091,64 @@ type GoMethod interface {
 	WriteString(out io.Writer)
 }
 
+// return true if calls to this method must be dispatched through 'self'
+func isVirtualMethod(mthd GoMethod) bool {
+	switch m := mthd.(type) {
+	case *GoClassMethod:
+		return m.is_virtual
+	case *GoMethodReference:
+		return m.ref != nil && m.ref.is_virtual
+	}
+
+	return false
+}
+
+// return the interface which 'self' must be converted to before calling a
+// virtual method first declared below the root class, or "" if the root
+// class's interface includes the method
+func virtualInterface(mthd GoMethod) string {
+	cm, ok := mthd.(*GoClassMethod)
+	if ref, isref := mthd.(*GoMethodReference); isref {
+		cm, ok = ref.ref, ref.ref != nil
+	}
+	if !ok {
+		return ""
+	}
+
+	cls, ok := cm.class.(*GoClassDefinition)
+	if !ok {
+		return ""
+	}
+
+	decl, _ := cls.declaringClass(cm)
+	if _, ok := decl.super.(*GoClassDefinition); !ok {
+		return ""
+	}
+
+	return decl.virtualName()
+}
+
+// return a valid position if the last argument is an array passed
+// directly to a varargs parameter, so the call must spread it with "..."
+func varargsEllipsis(mthd GoMethod, args []GoExpr) token.Pos {
+	if mthd == nil {
+		return token.NoPos
+	}
+
+	params, varargs, ok := methodParams(mthd)
+	if !ok || !varargs || len(args) == 0 || len(args) != len(params) {
+		return token.NoPos
+	}
+
+	switch convertType(params[len(params)-1].VarType(),
+		args[len(args)-1].VarType()) {
+	case convIdentity, convWidening, convSubclass:
+		return 1
+	}
+
+	return token.NoPos
+}
+
 type GoMethodAccess struct {
 	obj    GoExpr
 	method GoMethod
@@ -3784,8 +5161,21 @@ func (ma *GoMethodAccess) Expr() ast.Expr {
 		fun = ma.obj.Expr()
 	} else {
 		if ma.method.Receiver() != nil {
-			fun = &ast.SelectorExpr{X: ma.method.Receiver().Ident(),
+			var rcvr ast.Expr = ma.method.Receiver().Ident()
+			if isVirtualMethod(ma.method) {
+				// dispatch through the most-derived object
+				rcvr = &ast.SelectorExpr{X: rcvr, Sel: ast.NewIdent("self")}
+				if iface := virtualInterface(ma.method); iface != "" {
+					rcvr = &ast.TypeAssertExpr{X: rcvr,
+						Type: ast.NewIdent(iface)}
+				}
+			}
+
+			fun = &ast.SelectorExpr{X: rcvr,
 				Sel: ast.NewIdent(ma.method.GoName())}
+		} else if _, ok := ma.method.Class().(*GoInterfaceDefinition); ok {
+			// static and default interface methods are package functions
+			fun = ast.NewIdent(ma.method.GoName())
 		} else if ma.method.Class() != nil &&
 			!ma.method.Class().IsNil() {
 			fun = &ast.SelectorExpr{X: ast.NewIdent(ma.method.Class().Name()),
@@ -3795,7 +5185,8 @@ func (ma *GoMethodAccess) Expr() ast.Expr {
 		}
 	}
 
-	return &ast.CallExpr{Fun: fun, Args: ma.args.ExprList()}
+	return &ast.CallExpr{Fun: fun, Args: ma.args.ExprList(),
+		Ellipsis: varargsEllipsis(ma.method, ma.args.args)}
 }
 
 func (ma *GoMethodAccess) hasVariable(govar GoVar) bool {
@@ -3876,18 +5267,21 @@ type GoMethodAccessExpr struct {
 	expr   GoExpr
 	method GoMethod
 	args   *GoMethodArguments
+	// 'expr' is a function type value which is called directly
+	call_value bool
 }
 
 func (ma *GoMethodAccessExpr) Expr() ast.Expr {
 	var fun ast.Expr
-	if ma.method == nil {
+	if ma.method == nil || ma.call_value {
 		fun = ma.expr.Expr()
 	} else {
 		fun = &ast.SelectorExpr{X: ma.expr.Expr(),
-			Sel: ast.NewIdent(ma.method.Name())}
+			Sel: ast.NewIdent(callName(ma.method, ma.expr))}
 	}
 
-	return &ast.CallExpr{Fun: fun, Args: ma.args.ExprList()}
+	return &ast.CallExpr{Fun: fun, Args: ma.args.ExprList(),
+		Ellipsis: varargsEllipsis(ma.method, ma.args.args)}
 }
 
 func (ma *GoMethodAccessExpr) hasVariable(govar GoVar) bool {
@@ -4035,11 +5429,52 @@ type GoMethodAccessVar struct {
 	args   *GoMethodArguments
 }
 
+// return true if 'mthd' was translated along with the interface or
+// class of the value of 'expr'
+func isValueMethod(mthd GoMethod, expr GoExpr) bool {
+	if _, ok := mthd.(*GoIfaceMethod); ok {
+		return true
+	} else if mref, ok := mthd.(*GoMethodReference); ok && mref.ref == nil {
+		// the class doesn't declare the method
+		return false
+	} else if cd, ok := mthd.Class().(*GoClassDefinition); ok {
+		td := expr.VarType()
+		if td == nil || !td.isObject() {
+			return false
+		} else if td.vclass == cd.name || cd.program == nil {
+			return td.vclass == cd.name
+		}
+
+		// the method may be inherited by the value's class, and abstract
+		// class values are typed by the class's interface
+		c := cd.program.classForType(td.vclass)
+		for ; c != nil; c, _ = c.super.(*GoClassDefinition) {
+			if c == cd {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// return the name used to call 'mthd' on the value of 'expr'
+func callName(mthd GoMethod, expr GoExpr) string {
+	if isValueMethod(mthd, expr) {
+		// use the Go name of a method from the value's type
+		return mthd.GoName()
+	}
+
+	return mthd.Name()
+}
+
 func (ma *GoMethodAccessVar) Expr() ast.Expr {
-	fun := &ast.SelectorExpr{X: ma.govar.Expr(),
-		Sel: ast.NewIdent(ma.method.Name())}
+	name := callName(ma.method, ma.govar)
 
-	return &ast.CallExpr{Fun: fun, Args: ma.args.ExprList()}
+	fun := &ast.SelectorExpr{X: ma.govar.Expr(), Sel: ast.NewIdent(name)}
+
+	return &ast.CallExpr{Fun: fun, Args: ma.args.ExprList(),
+		Ellipsis: varargsEllipsis(ma.method, ma.args.args)}
 }
 
 func (ma *GoMethodAccessVar) hasVariable(govar GoVar) bool {
@@ -4194,7 +5629,7 @@ func (ma *GoMethodArguments) WriteString(out io.Writer) {
 type GoMethodOwner interface {
 	AddConstant(con *GoConstant)
 	AddMethod(mthd GoMethod)
-	Constants() []ast.Decl
+	Constants(gp *GoProgram) []ast.Decl
 	FindMethod(name string, args *GoMethodArguments) GoMethod
 	IsNil() bool
 	Name() string
@@ -4573,6 +6008,19 @@ type GoProgram struct {
 	enums      []*GoEnumDefinition
 	interfaces []GoInterface
 	classes    map[string]GoClass
+	// static initializers in source order
+	initializers []*GoClassMethod
+	// number of anonymous classes found in each class
+	anon_count map[string]int
+	// number of type assertions hoisted out of expressions
+	ok_count int
+	// Go type names for nested and abstract classes and for interfaces,
+	// indexed by their Java names
+	class_types map[string]string
+	// interfaces declared in this file or generated for abstract classes
+	abstract_types map[string]bool
+	// generated types which replace Java library classes
+	helpers map[string]*helperType
 
 	mgr  *FileManager
 	file *ast.File
@@ -4591,6 +6039,70 @@ func (gp *GoProgram) addClass(cls GoClass) {
 	gp.classes[key] = cls
 }
 
+// remember the Go type name used for a nested or abstract class
+func (gp *GoProgram) addClassType(parent GoMethodOwner, name string,
+	goname string) {
+	if gp.class_types == nil {
+		gp.class_types = make(map[string]string)
+	}
+
+	gp.class_types[name] = goname
+	if parent != nil {
+		gp.class_types[javaClassName(parent)+"."+name] = goname
+	}
+}
+
+// remember the interface generated for an abstract class
+func (gp *GoProgram) addAbstractType(goname string) {
+	if gp.abstract_types == nil {
+		gp.abstract_types = make(map[string]bool)
+	}
+
+	gp.abstract_types[goname] = true
+}
+
+// remember an interface declared in this file, so variables of that
+// type aren't treated as pointers to a struct
+func (gp *GoProgram) addInterfaceType(name string) {
+	gp.addClassType(nil, name, name)
+	gp.addAbstractType(name)
+}
+
+// return the function type definition named 'name'
+func (gp *GoProgram) findFuncType(name string) *GoInterfaceDefinition {
+	for _, iface := range gp.interfaces {
+		if gi, ok := iface.(*GoInterfaceDefinition); ok &&
+			gi.is_functype && gi.name == name {
+			return gi
+		}
+	}
+
+	return nil
+}
+
+// return a new name for the result of a hoisted type assertion
+func (gp *GoProgram) okName() string {
+	gp.ok_count++
+	return fmt.Sprintf("ok%d", gp.ok_count)
+}
+
+// return a new name for an anonymous class defined inside 'owner'
+func (gp *GoProgram) anonymousClassName(owner GoMethodOwner) string {
+	if gp.anon_count == nil {
+		gp.anon_count = make(map[string]int)
+	}
+
+	gp.anon_count[owner.Name()]++
+	return fmt.Sprintf("%s_anon%d", owner.Name(), gp.anon_count[owner.Name()])
+}
+
+func (gp *GoProgram) addStaticInitializer(mthd *GoClassMethod) {
+	if gp.initializers == nil {
+		gp.initializers = make([]*GoClassMethod, 0)
+	}
+	gp.initializers = append(gp.initializers, mthd)
+}
+
 func (gp *GoProgram) addEnum(enm *grammar.JEnumDecl) {
 	if enm.Interfaces != nil && len(enm.Interfaces) > 0 {
 		if gp.verbose {
@@ -4673,6 +6185,9 @@ func (gp *GoProgram) addInterface(iface *grammar.JInterfaceDecl) {
 		gp.interfaces = append(gp.interfaces, gi)
 	}
 
+	// static and default methods
+	mthds := make([]*grammar.JMethodDecl, 0)
+
 	for _, jobj := range iface.Body {
 		switch j := jobj.(type) {
 		case *grammar.JConstantDecl:
@@ -4697,10 +6212,32 @@ func (gp *GoProgram) addInterface(iface *grammar.JInterfaceDecl) {
 			}
 		case *grammar.JInterfaceMethodDecl:
 			gi.AddMethod(NewGoInterfaceMethod(gp, gi.name, j))
+		case *grammar.JMethodDecl:
+			mthds = append(mthds, j)
 		default:
 			grammar.ReportCastError("InterfaceDecl", jobj)
 		}
 	}
+
+	// declare default methods and translate static methods before
+	// translating the default method bodies which may call them
+	for _, j := range mthds {
+		if j.Modifiers != nil && j.Modifiers.IsSet(grammar.ModStatic) {
+			gi.addFunction(gp, j)
+		} else {
+			gi.AddMethod(newGoIfaceMethod(gp, gi.name, j.Name,
+				j.FormalParams, j.TypeSpec))
+		}
+	}
+	for _, j := range mthds {
+		if j.Modifiers == nil || !j.Modifiers.IsSet(grammar.ModStatic) {
+			gi.addFunction(gp, j)
+		}
+	}
+
+	if gp.config.isFuncType(gi.name) {
+		gi.setFuncType(gp)
+	}
 }
 
 func (gp *GoProgram) addInterfaceReference(name *grammar.JTypeName) *GoInterfaceReference {
@@ -4729,6 +6266,13 @@ func (gp *GoProgram) analyzeCode(pgm *grammar.JProgramFile) {
 		return
 	}
 
+	// interfaces may be used before they're declared
+	for _, tobj := range pgm.TypeDecls {
+		if iface, ok := tobj.(*grammar.JInterfaceDecl); ok {
+			gp.addInterfaceType(iface.Name.String())
+		}
+	}
+
 	var gs *GoState
 
 	for _, tobj := range pgm.TypeDecls {
@@ -4837,7 +6381,37 @@ func (gp *GoProgram) createTypeData(typename *grammar.JTypeName,
 		return NewTypeDataPrimitive(typestr, dims)
 	}
 
-	return NewTypeDataObject(gp, typestr, dims)
+	if typestr == "Object" {
+		// an Object can hold any value
+		if dims == 0 {
+			return genericObject
+		}
+
+		return &TypeData{vtype: VT_ARRAY, type1: genericObject,
+			array_dims: dims}
+	}
+
+	if goname, pkgpath := gp.libraryType(typestr); goname != "" {
+		// some Java library classes are replaced by Go library types
+		gp.addImport(pkgpath, "")
+
+		td := &TypeData{vtype: VT_CLASS, vclass: goname}
+		if dims == 0 {
+			return td
+		}
+
+		return &TypeData{vtype: VT_ARRAY, type1: td, array_dims: dims}
+	}
+
+	td := NewTypeDataObject(gp, typestr, dims)
+	if dims == 0 && len(type_args) == 1 && type_args[0].TypeSpec != nil &&
+		isListClass(typestr) {
+		// remember the element type of a list
+		elem := type_args[0].TypeSpec
+		td.type1 = gp.createTypeData(elem.Name, elem.TypeArgs, elem.Dims)
+	}
+
+	return td
 }
 
 func (gp *GoProgram) Decls() []ast.Decl {
@@ -4892,7 +6466,7 @@ func (gp *GoProgram) Decls() []ast.Decl {
 		sort.Sort(InterfaceSlice(gp.interfaces))
 
 		for _, iface := range gp.interfaces {
-			consts := iface.Constants()
+			consts := iface.Constants(gp)
 			if consts != nil {
 				decls = append(decls, consts...)
 			}
@@ -4901,21 +6475,18 @@ func (gp *GoProgram) Decls() []ast.Decl {
 			if idecl != nil {
 				decls = append(decls, idecl)
 			}
+
+			stats := iface.Statics()
+			if stats != nil {
+				decls = append(decls, stats...)
+			}
 		}
 	}
 
 	if gp.classes != nil && len(gp.classes) > 0 {
-		keys := make([]string, len(gp.classes))
-		i := 0
-		for k, _ := range gp.classes {
-			keys[i] = k
-			i++
-		}
-		sort.Strings(keys)
-
-		for _, k := range keys {
+		for _, k := range gp.classKeys() {
 			class := gp.classes[k]
-			consts := class.Constants()
+			consts := class.Constants(gp)
 			if consts != nil {
 				decls = append(decls, consts...)
 			}
@@ -4933,11 +6504,18 @@ func (gp *GoProgram) Decls() []ast.Decl {
 		}
 	}
 
+	// Go runs init() functions in the order they appear, so keep
+	// static initializers in Java source order
+	for _, m := range gp.initializers {
+		decls = append(decls, m.Decl())
+	}
+
 	return decls
 }
 
 func (gp *GoProgram) Dump(out io.Writer) {
 	format.Node(out, gp.FileSet(), gp.File())
+	gp.writeHelpers(out)
 }
 
 func (gp *GoProgram) DumpTree() {
@@ -4952,10 +6530,24 @@ func (gp *GoProgram) File() *ast.File {
 	if gp.file == nil {
 		gp.file = &ast.File{Name: ast.NewIdent(gp.pkgname), Decls: gp.Decls(),
 			Imports: gp.Imports(), Scope: nil, Unresolved: nil, Comments: nil}
+		gp.resolveCharType(gp.file)
 	}
 	return gp.file
 }
 
+// replace the placeholder used for Java 'char' types with the Go type
+// chosen by the configuration
+func (gp *GoProgram) resolveCharType(file *ast.File) {
+	ctype := gp.config.charType()
+	ast.Inspect(file, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && id.Name == charPlaceholder {
+			id.Name = ctype
+		}
+
+		return true
+	})
+}
+
 func (gp *GoProgram) FileSet() *token.FileSet {
 	if gp.mgr == nil {
 		gp.mgr = NewFileManager(gp.name)
@@ -4964,15 +6556,54 @@ func (gp *GoProgram) FileSet() *token.FileSet {
 	return gp.mgr.FileSet()
 }
 
+// return the keys of all classes in sorted order, so classes are
+// finalized and written in the same order every time
+func (gp *GoProgram) classKeys() []string {
+	keys := make([]string, 0, len(gp.classes))
+	for k := range gp.classes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
 func (gp *GoProgram) finalize() {
 	// finalize all interfaces
 	for _, iface := range gp.interfaces {
 		iface.finalize(gp)
 	}
+	// forward default interface methods which aren't overridden
+	for _, k := range gp.classKeys() {
+		if cd, ok := gp.classes[k].(*GoClassDefinition); ok {
+			cd.addDefaultMethods(gp)
+		}
+	}
+	// find overridden methods before any constructors are modified
+	for _, k := range gp.classKeys() {
+		if cd, ok := gp.classes[k].(*GoClassDefinition); ok {
+			cd.findOverrides()
+		}
+	}
 	// finalize all classes
-	for _, cls := range gp.classes {
-		cls.finalize(gp)
+	for _, k := range gp.classKeys() {
+		gp.classes[k].finalize(gp)
+	}
+	// check method sets after duplicate methods have been renamed
+	for _, k := range gp.classKeys() {
+		if cd, ok := gp.classes[k].(*GoClassDefinition); ok {
+			cd.checkInterfaces(gp)
+		}
 	}
+	// decide whether each try-with-resources Close() returns an error
+	gp.RunTransform(func(parent GoObject, prog *GoProgram, cls GoClass,
+		object GoObject) (GoObject, bool) {
+		if rsrc, ok := object.(*GoTryResource); ok {
+			rsrc.resolveClose(prog)
+		}
+
+		return nil, true
+	}, gp, nil, nil)
 }
 
 func (gp *GoProgram) findClass(name string) GoClass {
@@ -4987,6 +6618,22 @@ func (gp *GoProgram) findClass(name string) GoClass {
 	return nil
 }
 
+// return the class whose values have the Go type 'vclass'
+func (gp *GoProgram) classForType(vclass string) *GoClassDefinition {
+	if vclass == "" {
+		return nil
+	}
+
+	for _, c := range gp.classes {
+		if cd, ok := c.(*GoClassDefinition); ok &&
+			(cd.name == vclass || cd.iface_name == vclass) {
+			return cd
+		}
+	}
+
+	return nil
+}
+
 func (gp *GoProgram) findInterface(name *grammar.JTypeName) GoInterface {
 	if gp.interfaces != nil {
 		for _, iface := range gp.interfaces {
@@ -5012,6 +6659,14 @@ func (gp *GoProgram) Imports() []*ast.ImportSpec {
 }
 
 func (gp *GoProgram) ImportedType(name string) string {
+	if goname, ok := gp.class_types[name]; ok {
+		return goname
+	}
+
+	if gp.config.isFuncType(name) {
+		return name
+	}
+
 	if cls, ok := gp.import_types[name]; ok {
 		return cls.FullName()
 	}
@@ -5020,6 +6675,10 @@ func (gp *GoProgram) ImportedType(name string) string {
 }
 
 func (gp *GoProgram) IsInterface(name string) bool {
+	if gp.abstract_types[name] || gp.config.isFuncType(name) {
+		return true
+	}
+
 	if gp.config == nil {
 		return false
 	}
@@ -5051,11 +6710,12 @@ func (gp *GoProgram) Receiver(class string) string {
 }
 
 func (gp *GoProgram) RunTransform(xform TransformFunc, prog *GoProgram, cls GoClass, parent GoObject) (GoObject, bool) {
-	for i, cls := range gp.classes {
+	for _, k := range gp.classKeys() {
+		cls := gp.classes[k]
 		obj, is_nil := cls.RunTransform(xform, gp, cls, gp)
 		if !is_nil {
 			if cla, ok := obj.(GoClass); ok {
-				gp.classes[i] = cla
+				gp.classes[k] = cla
 			} else {
 				panic(fmt.Errorf("%v<%T> is not a GoClass", obj, obj))
 			}
@@ -5099,7 +6759,7 @@ func (gp *GoProgram) Write(topdir string) error {
 
 	fd.Close()
 
-	return nil
+	return gp.writeHelperFiles(dirpath)
 }
 
 func (gp *GoProgram) WriteString(out io.Writer) {
@@ -5139,8 +6799,18 @@ type GoReference struct {
 }
 
 func (ref *GoReference) Expr() ast.Expr {
+	var elts []ast.Expr
+	if cls, ok := ref.cls.(*GoClassDefinition); ok {
+		// initialize captured variables
+		for _, c := range cls.captures {
+			elts = append(elts, &ast.KeyValueExpr{
+				Key:   ast.NewIdent(c.field.GoName()),
+				Value: c.param.Ident()})
+		}
+	}
+
 	return &ast.UnaryExpr{Op: token.AND,
-		X: &ast.CompositeLit{Type: ast.NewIdent(ref.cls.Name())}}
+		X: &ast.CompositeLit{Type: ast.NewIdent(ref.cls.Name()), Elts: elts}}
 }
 
 func (ref *GoReference) hasVariable(govar GoVar) bool {
@@ -5186,14 +6856,21 @@ func (rtn *GoReturn) RunTransform(xform TransformFunc, prog *GoProgram, cls GoCl
 }
 
 func (rtn *GoReturn) Stmts() []ast.Stmt {
+	if gio, ok := rtn.expr.(*GoInstanceOf); ok && gio.binding == nil {
+		// hoist the type assertion out of the return statement
+		return []ast.Stmt{gio.assign("_"),
+			&ast.ReturnStmt{Results: []ast.Expr{ast.NewIdent("ok")}}}
+	}
 
+	var stmts []ast.Stmt
 	var results []ast.Expr
 	if rtn.expr != nil {
+		stmts = hoistInstanceOf(rtn.expr)
 		results = make([]ast.Expr, 1)
 		results[0] = rtn.expr.Expr()
 	}
 
-	return []ast.Stmt{&ast.ReturnStmt{Results: results}}
+	return append(stmts, &ast.ReturnStmt{Results: results})
 }
 
 func (rtn *GoReturn) String() string {
@@ -5318,7 +6995,62 @@ func (sel *GoSelector) Type() ast.Expr {
 }
 
 func (sel *GoSelector) VarType() *TypeData {
-	return sel.expr.VarType()
+	return sel.sel.VarType()
+}
+
+// slice of an array, "x[low:high]" (either bound may be nil)
+type GoSliceExpr struct {
+	x    GoExpr
+	low  GoExpr
+	high GoExpr
+}
+
+func (gse *GoSliceExpr) Expr() ast.Expr {
+	slice := &ast.SliceExpr{X: gse.x.Expr()}
+	if gse.low != nil {
+		slice.Low = gse.low.Expr()
+	}
+	if gse.high != nil {
+		slice.High = gse.high.Expr()
+	}
+
+	return slice
+}
+
+func (gse *GoSliceExpr) hasVariable(govar GoVar) bool {
+	return gse.x.hasVariable(govar) ||
+		(gse.low != nil && gse.low.hasVariable(govar)) ||
+		(gse.high != nil && gse.high.hasVariable(govar))
+}
+
+func (gse *GoSliceExpr) Init() ast.Stmt {
+	return nil
+}
+
+func (gse *GoSliceExpr) RunTransform(xform TransformFunc, prog *GoProgram, cls GoClass, parent GoObject) (GoObject, bool) {
+	for _, ptr := range []*GoExpr{&gse.x, &gse.low, &gse.high} {
+		if *ptr == nil {
+			continue
+		}
+
+		obj, is_nil := (*ptr).RunTransform(xform, prog, cls, gse)
+		if !is_nil {
+			var err error
+			if *ptr, err = convertToExpr(obj); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	return xform(parent, prog, cls, gse)
+}
+
+func (gse *GoSliceExpr) String() string {
+	return fmt.Sprintf("GoSliceExpr[%v|%v|%v]", gse.x, gse.low, gse.high)
+}
+
+func (gse *GoSliceExpr) VarType() *TypeData {
+	return gse.x.VarType()
 }
 
 type GoState struct {
@@ -5327,6 +7059,11 @@ type GoState struct {
 	class   *GoClassDefinition
 	vars    map[string]GoVar
 	classes map[string]GoClass
+	// anonymous or inner class which captures variables from
+	// enclosing scopes
+	capture *GoClassDefinition
+	// nested classes which are analyzed after the enclosing class
+	nested map[*grammar.JClassDecl]*GoClassDefinition
 }
 
 func NewGoState(parent *GoState) *GoState {
@@ -5379,9 +7116,70 @@ func (gs *GoState) addClass(nref GoClass) {
 }
 
 func (gs *GoState) addClassDecl(parent GoMethodOwner, jcls *grammar.JClassDecl) {
-	cls := NewGoClassDefinition(gs.Program(), parent, jcls.Name)
+	if _, ok := gs.nested[jcls]; ok {
+		// nested classes are analyzed after the enclosing class
+		return
+	}
+
+	cls := gs.declareClass(parent, jcls)
+	gs.defineClass(cls, jcls)
+}
+
+// create the class definition so it can be referenced before the body
+// has been analyzed
+func (gs *GoState) declareClass(parent GoMethodOwner,
+	jcls *grammar.JClassDecl) *GoClassDefinition {
+	gp := gs.Program()
+
+	goname := jcls.Name
+	if parent != nil {
+		goname = gp.config.nestedClassName(parent.Name(), jcls.Name)
+	}
+
+	var cls *GoClassDefinition
+	if !jcls.IsAbstract() {
+		cls = NewGoClassDefinition(gp, parent, goname)
+	} else {
+		// abstract class variables use an interface, while the
+		// concrete code lives in a base struct
+		cls = NewGoClassDefinition(gp, parent, goname+"Base")
+		cls.iface_name = goname
+		gp.addAbstractType(goname)
+	}
+
+	if cls.name != jcls.Name {
+		cls.jname = jcls.Name
+		gp.addClassType(parent, jcls.Name, goname)
+	}
+
+	if parent != nil {
+		gp.addClass(cls)
+
+		if pcls, ok := parent.(*GoClassDefinition); ok && !jcls.IsStatic() {
+			// inner classes are tied to an instance of the enclosing class
+			cls.addOuter(pcls.name, nil)
+		}
+	}
 	gs.addClass(cls)
 
+	if jcls.Interfaces != nil && len(jcls.Interfaces) > 0 {
+		ifaces := make([]GoInterface, len(jcls.Interfaces))
+		for i, iname := range jcls.Interfaces {
+			iface := gs.Program().findInterface(iname)
+			if iface == nil {
+				iface = gs.Program().addInterfaceReference(iname)
+			}
+			ifaces[i] = iface
+		}
+		cls.interfaces = ifaces
+	}
+
+	return cls
+}
+
+// analyze the body of a class declaration
+func (gs *GoState) defineClass(cls *GoClassDefinition,
+	jcls *grammar.JClassDecl) {
 	if jcls.Extends != nil {
 		if jcls.Extends.Dims != 0 {
 			log.Printf("Class %v cannot extend array %v with dim=%d",
@@ -5399,38 +7197,50 @@ func (gs *GoState) addClassDecl(parent GoMethodOwner, jcls *grammar.JClassDecl)
 		}
 
 		extname := jcls.Extends.Name.LastType()
-		cls.super = gs.Program().findClass(extname)
+		cls.super = gs.findClass(cls.parent, extname)
 		if cls.super == nil {
 			cls.super = NewGoFakeClass(extname)
 			gs.Program().addClass(cls.super)
 		}
 	}
 
-	if jcls.Interfaces != nil && len(jcls.Interfaces) > 0 {
-		ifaces := make([]GoInterface, len(jcls.Interfaces))
-		for i, iname := range jcls.Interfaces {
-			iface := gs.Program().findInterface(iname)
-			if iface == nil {
-				iface = gs.Program().addInterfaceReference(iname)
+	gs2 := NewGoState(gs)
+	gs2.class = cls
+	if cls.outer != nil {
+		// access enclosing instance fields through 'outer'
+		gs2.capture = cls
+	}
+
+	// declare nested classes so the enclosing class can refer to them
+	var nested []*grammar.JClassDecl
+	for _, jobj := range jcls.Body {
+		if body, ok := jobj.(*grammar.JClassBody); ok {
+			for _, bobj := range body.List {
+				if jdecl, ok := bobj.(*grammar.JClassDecl); ok {
+					if gs2.nested == nil {
+						gs2.nested = make(map[*grammar.JClassDecl]*GoClassDefinition)
+					}
+					gs2.nested[jdecl] = gs2.declareClass(cls, jdecl)
+					nested = append(nested, jdecl)
+				}
 			}
-			ifaces[i] = iface
 		}
-		cls.interfaces = ifaces
 	}
 
-	gs2 := NewGoState(gs)
-	gs2.class = cls
-
 	for _, jobj := range jcls.Body {
 		switch j := jobj.(type) {
 		case *grammar.JClassBody:
 			analyzeClassBody(gs2, cls, j)
 		case *grammar.JBlock:
 			blk := analyzeBlock(gs2, cls, j)
-			if blk != nil {
-				m := &GoClassMethod{class: cls, name: "init", goname: "init",
-					rcvr: nil, method_type: mt_static, body: blk}
-				cls.AddMethod(m)
+			if blk == nil {
+				break
+			}
+
+			if j.IsStatic() {
+				cls.addStaticInitializer(blk)
+			} else {
+				cls.addInitializer(blk)
 			}
 		case *grammar.JEmpty:
 			// do nothing
@@ -5438,6 +7248,11 @@ func (gs *GoState) addClassDecl(parent GoMethodOwner, jcls *grammar.JClassDecl)
 			grammar.ReportCastError("JClassDecl", jobj)
 		}
 	}
+
+	// nested classes can refer to any member of the enclosing class
+	for _, jdecl := range nested {
+		gs2.defineClass(gs2.nested[jdecl], jdecl)
+	}
 }
 
 func (gs *GoState) addVariable(name string, modifiers *grammar.JModifiers, dims int,
@@ -5524,23 +7339,65 @@ func (gs *GoState) dumpVariables(fd io.Writer, indentLevel int) {
 }
 
 func (gs *GoState) findClass(parent GoMethodOwner, name string) GoClass {
-	key := makeClassKeyFromParts(parent, name)
+	// check the parent class and then any enclosing classes
+	for parent != nil {
+		if cls := gs.findClassKey(makeClassKeyFromParts(parent, name)); cls != nil {
+			return cls
+		}
+
+		pcls, ok := parent.(GoClass)
+		if !ok {
+			break
+		}
+		parent = pcls.Parent()
+	}
+
+	return gs.findClassKey(makeClassKeyFromParts(nil, name))
+}
 
+func (gs *GoState) findClassKey(key string) GoClass {
 	if gs.classes != nil {
 		if ocls, ok := gs.classes[key]; ok {
 			return ocls
 		}
 	}
 
-	if gs.parent != nil {
-		return gs.parent.findClass(parent, name)
-	}
+	if gs.parent != nil {
+		return gs.parent.findClassKey(key)
+	}
+
+	if gp := gs.Program(); gp != nil && gp.classes != nil {
+		if ocls, ok := gp.classes[key]; ok {
+			return ocls
+		}
+	}
+
+	return nil
+}
+
+// return an expression which refers to the instance of 'target'
+// enclosing the current class (or nil if there is no such instance)
+func (gs *GoState) enclosingInstance(target *GoClassDefinition) GoExpr {
+	rcvr := gs.findVariableString(gs.Receiver())
+	if rcvr == nil {
+		return nil
+	}
+
+	var expr GoExpr = rcvr
+	for cls := gs.Class(); cls != target; {
+		if cls == nil || cls.outer == nil {
+			return nil
+		}
+
+		expr = NewGoSelector(expr, cls.outer)
 
-	if gs.Program() != nil {
-		return gs.Program().findClass(name)
+		var ok bool
+		if cls, ok = cls.parent.(*GoClassDefinition); !ok {
+			return nil
+		}
 	}
 
-	return nil
+	return expr
 }
 
 func (gs *GoState) findOrFakeVariable(typename *grammar.JTypeName,
@@ -5569,12 +7426,16 @@ func (gs *GoState) findVariable(typename *grammar.JTypeName) GoVar {
 		val, ok := gs.vars[typename.FirstType()]
 		if ok {
 			return &GoClassAttribute{govar: val,
-				suffix: typename.NotFirst().String()}
+				suffix: typename.NotFirst().String(), program: gs.Program()}
 		}
 	}
 
 	if gs.parent != nil {
 		if govar := gs.parent.findVariable(typename); govar != nil {
+			if gs.capture != nil {
+				return gs.capture.captureVariable(gs.parent, govar)
+			}
+
 			return govar
 		}
 	}
@@ -5630,7 +7491,7 @@ type GoStatic struct {
 func (stat *GoStatic) Decl() ast.Decl {
 	var vtype ast.Expr
 	var vals []ast.Expr
-	if stat.init.expr == nil {
+	if stat.init.expr == nil && len(stat.init.elements) == 0 {
 		vtype = stat.init.govar.Type()
 		vals = nil
 	} else {
@@ -5968,12 +7829,31 @@ func (thr *GoThrow) String() string {
 }
 
 type GoTry struct {
-	block   *GoBlock
-	catches []*GoTryCatch
-	finally *GoBlock
+	resources []*GoTryResource
+	block     *GoBlock
+	catches   []*GoTryCatch
+	finally   *GoBlock
+	// true if the try-with-resources block contains a 'return'
+	returns bool
+	// true if the try-with-resources block always ends with a 'return'
+	always bool
+	// true if a 'returned' flag is needed to tell whether this block (or
+	// a try-with-resources block inside it) has returned
+	flagged bool
+	// enclosing try-with-resources statement, which declares the
+	// variables holding the returned value
+	outer *GoTry
+	// type returned by the enclosing method (nil for void methods)
+	rtype *TypeData
 }
 
 func (try *GoTry) hasVariable(govar GoVar) bool {
+	for _, r := range try.resources {
+		if r.hasVariable(govar) {
+			return true
+		}
+	}
+
 	if try.block != nil && try.block.hasVariable(govar) {
 		return true
 	}
@@ -5992,6 +7872,17 @@ func (try *GoTry) hasVariable(govar GoVar) bool {
 }
 
 func (try *GoTry) RunTransform(xform TransformFunc, prog *GoProgram, cls GoClass, parent GoObject) (GoObject, bool) {
+	for i, r := range try.resources {
+		obj, is_nil := r.RunTransform(xform, prog, cls, try)
+		if !is_nil {
+			if rsrc, ok := obj.(*GoTryResource); ok {
+				try.resources[i] = rsrc
+			} else {
+				panic(fmt.Errorf("%v<%T> is not a *GoTryResource", obj, obj))
+			}
+		}
+	}
+
 	if try.block != nil {
 		obj, is_nil := try.block.RunTransform(xform, prog, cls, try)
 		if !is_nil {
@@ -6027,8 +7918,16 @@ func (try *GoTry) RunTransform(xform TransformFunc, prog *GoProgram, cls GoClass
 }
 
 func (try *GoTry) Stmts() []ast.Stmt {
+	body := try.block.BlockStmt()
+	if len(try.resources) > 0 {
+		body = try.resourceBlock(body)
+		if len(try.catches) == 0 && try.finally == nil {
+			return body.List
+		}
+	}
+
 	tfake := &ast.CallExpr{Fun: ast.NewIdent("try"), Args: make([]ast.Expr, 0)}
-	top_if := &ast.IfStmt{Cond: tfake, Body: try.block.BlockStmt()}
+	top_if := &ast.IfStmt{Cond: tfake, Body: body}
 
 	cur_if := top_if
 
@@ -6059,9 +7958,114 @@ func (try *GoTry) Stmts() []ast.Stmt {
 	return []ast.Stmt{top_if}
 }
 
+// wrap the body of a try-with-resources statement in a function literal
+// which acquires each resource and defers closing it, so resources are
+// closed in reverse order when the block ends.  Errors returned by Close()
+// are joined (approximating Java's suppressed exceptions) and thrown.
+func (try *GoTry) resourceBlock(body *ast.BlockStmt) *ast.BlockStmt {
+	err := ast.NewIdent("err")
+	noargs := &ast.FieldList{}
+
+	list := make([]ast.Stmt, 0)
+	joined := false
+	for _, r := range try.resources {
+		list = append(list, r.stmt.Stmts()...)
+
+		call, has_err := r.closeCall()
+		if call == nil {
+			continue
+		} else if !has_err {
+			list = append(list, &ast.DeferStmt{Call: call})
+			continue
+		}
+
+		join := &ast.CallExpr{Fun: &ast.SelectorExpr{
+			X: ast.NewIdent("errors"), Sel: ast.NewIdent("Join")},
+			Args: []ast.Expr{err, call}}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok &&
+			sel.Sel.Name == "Join" {
+			// flatten "errors.Join(err, errors.Join(a, b))"
+			join.Args = append([]ast.Expr{err}, call.Args...)
+		}
+		asgn := &ast.AssignStmt{Lhs: []ast.Expr{err}, Tok: token.ASSIGN,
+			Rhs: []ast.Expr{join}}
+		list = append(list, &ast.DeferStmt{Call: &ast.CallExpr{
+			Fun: &ast.FuncLit{Type: &ast.FuncType{Params: noargs},
+				Body: &ast.BlockStmt{List: []ast.Stmt{asgn}}}}})
+		joined = true
+	}
+	list = append(list, body.List...)
+
+	if !joined {
+		fn := &ast.FuncLit{Type: &ast.FuncType{Params: noargs},
+			Body: &ast.BlockStmt{List: list}}
+		return try.returnBlock(&ast.ExprStmt{X: &ast.CallExpr{Fun: fn}})
+	}
+
+	if !try.always {
+		list = append(list, &ast.ReturnStmt{Results: []ast.Expr{
+			ast.NewIdent("nil")}})
+	}
+
+	results := &ast.FieldList{List: []*ast.Field{&ast.Field{
+		Names: []*ast.Ident{err}, Type: ast.NewIdent("error")}}}
+	fn := &ast.FuncLit{Type: &ast.FuncType{Params: noargs, Results: results},
+		Body: &ast.BlockStmt{List: list}}
+
+	return try.returnBlock(checkErr(&ast.AssignStmt{
+		Lhs: []ast.Expr{err}, Tok: token.DEFINE,
+		Rhs: []ast.Expr{&ast.CallExpr{Fun: fn}}}))
+}
+
+// surround the statement which calls the function literal with the
+// variables set by a 'return' inside the try-with-resources block, and
+// return the saved value after the literal
+func (try *GoTry) returnBlock(call ast.Stmt) *ast.BlockStmt {
+	if !try.returns {
+		return &ast.BlockStmt{List: []ast.Stmt{call}}
+	}
+
+	list := make([]ast.Stmt, 0)
+	var results []ast.Expr
+	if try.outer == nil {
+		if try.rtype != nil {
+			list = append(list, varDecl(tryReturnValue, try.rtype.Expr()))
+			results = []ast.Expr{ast.NewIdent(tryReturnValue)}
+		}
+		if try.flagged {
+			list = append(list, varDecl(tryReturned, ast.NewIdent("bool")))
+		}
+	}
+
+	list = append(list, call)
+
+	rtn := &ast.ReturnStmt{Results: results}
+	if try.always {
+		list = append(list, rtn)
+	} else {
+		list = append(list, &ast.IfStmt{Cond: ast.NewIdent(tryReturned),
+			Body: &ast.BlockStmt{List: []ast.Stmt{rtn}}})
+	}
+
+	return &ast.BlockStmt{List: list}
+}
+
+// return the outermost try-with-resources statement enclosing this one
+func (try *GoTry) top() *GoTry {
+	for try.outer != nil {
+		try = try.outer
+	}
+
+	return try
+}
+
 func (try *GoTry) String() string {
 	b := &bytes.Buffer{}
 	b.WriteString("GoTry[")
+	for _, r := range try.resources {
+		b.WriteString(r.String())
+		b.WriteString(";")
+	}
 	b.WriteString(try.block.String())
 	b.WriteString("|")
 	if try.catches != nil && len(try.catches) > 0 {
@@ -6080,6 +8084,140 @@ func (try *GoTry) String() string {
 	return b.String()
 }
 
+// statement which opens a resource and returns the call which closes it
+// (and true if that call returns an error)
+type resourceCloser interface {
+	closeCall() (*ast.CallExpr, bool)
+}
+
+// variable declared by a try-with-resources statement
+type GoTryResource struct {
+	stmt  GoStatement
+	govar GoVar
+	// class of the variable, if it is known when the statement is analyzed
+	class GoClass
+	// true if the variable's type is translated from a Java class whose
+	// close() method is void, so Close() does not return an error
+	void_close bool
+}
+
+func (rsrc *GoTryResource) closeCall() (*ast.CallExpr, bool) {
+	if closer, ok := rsrc.stmt.(resourceCloser); ok {
+		return closer.closeCall()
+	}
+
+	return &ast.CallExpr{Fun: &ast.SelectorExpr{X: rsrc.govar.Expr(),
+		Sel: ast.NewIdent("Close")}}, !rsrc.void_close
+}
+
+// find the resource's close() method once every class (including local
+// classes declared after the try statement) has been analyzed
+func (rsrc *GoTryResource) resolveClose(gp *GoProgram) {
+	if _, ok := rsrc.stmt.(resourceCloser); ok {
+		return
+	}
+
+	cls := rsrc.class
+	if _, ok := cls.(*GoClassDefinition); !ok {
+		if td := rsrc.govar.VarType(); td != nil && td.vtype == VT_CLASS {
+			cls = gp.findClass(td.vclass)
+		}
+	}
+
+	rsrc.void_close = false
+	for cd, ok := cls.(*GoClassDefinition); ok && cd != nil; cd, ok =
+		cd.Super().(*GoClassDefinition) {
+		if mthd := cd.FindMethod("close", &GoMethodArguments{}); mthd != nil {
+			rtype := mthd.VarType()
+			rsrc.void_close = rtype == nil || rtype.vtype == VT_VOID
+			break
+		}
+	}
+
+	if !rsrc.void_close {
+		gp.addImport("errors", "")
+	}
+}
+
+func (rsrc *GoTryResource) hasVariable(govar GoVar) bool {
+	return rsrc.stmt.hasVariable(govar)
+}
+
+func (rsrc *GoTryResource) RunTransform(xform TransformFunc, prog *GoProgram, cls GoClass, parent GoObject) (GoObject, bool) {
+	obj, is_nil := rsrc.stmt.RunTransform(xform, prog, cls, rsrc)
+	if !is_nil {
+		var err error
+		if rsrc.stmt, err = convertToStmt(obj); err != nil {
+			panic(err)
+		}
+	}
+
+	return xform(parent, prog, cls, rsrc)
+}
+
+func (rsrc *GoTryResource) String() string {
+	return "GoTryResource[" + rsrc.stmt.String() + "]"
+}
+
+// names of the variables which carry a value returned inside a
+// try-with-resources block out of its function literal
+const (
+	tryReturnValue = "rval"
+	tryReturned    = "returned"
+)
+
+// 'return' inside a try-with-resources block, which saves the value and
+// leaves the function literal
+type GoTryReturn struct {
+	expr GoExpr
+	try  *GoTry
+}
+
+func (rtn *GoTryReturn) hasVariable(govar GoVar) bool {
+	return rtn.expr != nil && rtn.expr.hasVariable(govar)
+}
+
+func (rtn *GoTryReturn) RunTransform(xform TransformFunc, prog *GoProgram, cls GoClass, parent GoObject) (GoObject, bool) {
+	if rtn.expr != nil {
+		obj, is_nil := rtn.expr.RunTransform(xform, prog, cls, rtn)
+		if !is_nil {
+			var err error
+			if rtn.expr, err = convertToExpr(obj); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	return xform(parent, prog, cls, rtn)
+}
+
+func (rtn *GoTryReturn) Stmts() []ast.Stmt {
+	list := make([]ast.Stmt, 0)
+	if rtn.expr != nil {
+		list = append(list, &ast.AssignStmt{
+			Lhs: []ast.Expr{ast.NewIdent(tryReturnValue)},
+			Tok: token.ASSIGN, Rhs: []ast.Expr{rtn.expr.Expr()}})
+	}
+
+	if rtn.try.top().flagged {
+		list = append(list, &ast.AssignStmt{
+			Lhs: []ast.Expr{ast.NewIdent(tryReturned)}, Tok: token.ASSIGN,
+			Rhs: []ast.Expr{ast.NewIdent("true")}})
+	}
+
+	return append(list, &ast.ReturnStmt{})
+}
+
+func (rtn *GoTryReturn) String() string {
+	b := &bytes.Buffer{}
+	b.WriteString("GoTryReturn[")
+	if rtn.expr != nil {
+		b.WriteString(rtn.expr.String())
+	}
+	b.WriteString("]")
+	return b.String()
+}
+
 type GoTryCatch struct {
 	govar GoVar
 	block *GoBlock
@@ -6125,6 +8263,224 @@ func (gtc *GoTryCatch) String() string {
 	return "GoTryCatch[" + gtc.govar.String() + "|" + gtc.block.String() + "]"
 }
 
+type GoTypeConversion struct {
+	target   GoExpr
+	convtype *TypeData
+}
+
+func NewGoTypeConversion(target GoExpr, convtype *TypeData) *GoTypeConversion {
+	if target == nil {
+		panic("Type conversion target cannot be nil")
+	} else if convtype == nil {
+		panic("Type conversion type cannot be nil")
+	}
+
+	return &GoTypeConversion{target: target, convtype: convtype}
+}
+
+func (conv *GoTypeConversion) Expr() ast.Expr {
+	args := make([]ast.Expr, 1)
+	args[0] = conv.target.Expr()
+
+	return &ast.CallExpr{Fun: conv.convtype.Expr(), Args: args}
+}
+
+func (conv *GoTypeConversion) hasVariable(govar GoVar) bool {
+	return conv.target.hasVariable(govar)
+}
+
+func (conv *GoTypeConversion) Init() ast.Stmt {
+	return nil
+}
+
+func (conv *GoTypeConversion) RunTransform(xform TransformFunc, prog *GoProgram, cls GoClass, parent GoObject) (GoObject, bool) {
+	obj, is_nil := conv.target.RunTransform(xform, prog, cls, conv)
+	if !is_nil {
+		var err error
+		if conv.target, err = convertToExpr(obj); err != nil {
+			panic(err)
+		}
+	}
+
+	return xform(parent, prog, cls, conv)
+}
+
+func (conv *GoTypeConversion) String() string {
+	return "GoTypeConversion[" + conv.target.String() + "|" +
+		conv.convtype.String() + "]"
+}
+
+func (conv *GoTypeConversion) VarType() *TypeData {
+	return conv.convtype
+}
+
+// chain of "if (x instanceof A) ... else if (x instanceof B) ..."
+// statements which test the same variable
+type GoTypeSwitch struct {
+	expr  GoExpr
+	cases []*GoTypeCase
+	dflt  GoStatement
+}
+
+func (tsw *GoTypeSwitch) hasVariable(govar GoVar) bool {
+	if tsw.expr != nil && tsw.expr.hasVariable(govar) {
+		return true
+	}
+
+	for _, c := range tsw.cases {
+		if c.hasVariable(govar) {
+			return true
+		}
+	}
+
+	return tsw.dflt != nil && tsw.dflt.hasVariable(govar)
+}
+
+func (tsw *GoTypeSwitch) RunTransform(xform TransformFunc, prog *GoProgram, cls GoClass, parent GoObject) (GoObject, bool) {
+	if tsw.expr != nil {
+		obj, is_nil := tsw.expr.RunTransform(xform, prog, cls, tsw)
+		if !is_nil {
+			var err error
+			if tsw.expr, err = convertToExpr(obj); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	for i, c := range tsw.cases {
+		obj, is_nil := c.RunTransform(xform, prog, cls, tsw)
+		if !is_nil {
+			if ca, ok := obj.(*GoTypeCase); ok {
+				tsw.cases[i] = ca
+			} else {
+				panic(fmt.Errorf("%v<%T> is not a *GoTypeCase", obj, obj))
+			}
+		}
+	}
+
+	if tsw.dflt != nil {
+		obj, is_nil := tsw.dflt.RunTransform(xform, prog, cls, tsw)
+		if !is_nil {
+			var err error
+			if tsw.dflt, err = convertToStmt(obj); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	return xform(parent, prog, cls, tsw)
+}
+
+func (tsw *GoTypeSwitch) Stmts() []ast.Stmt {
+	// the switch binds the first pattern variable, and cases which use
+	// a different name get a copy of it
+	var bound string
+	for _, c := range tsw.cases {
+		if c.usesBinding() {
+			bound = c.test.binding.Name()
+			break
+		}
+	}
+
+	cases := make([]ast.Stmt, 0)
+	for _, c := range tsw.cases {
+		cases = append(cases, c.CaseClause(bound))
+	}
+
+	if tsw.dflt != nil {
+		cases = append(cases, &ast.CaseClause{Body: blockStmts(tsw.dflt)})
+	}
+
+	asrt := &ast.TypeAssertExpr{X: tsw.expr.Expr()}
+
+	var assign ast.Stmt
+	if bound == "" {
+		assign = &ast.ExprStmt{X: asrt}
+	} else {
+		assign = &ast.AssignStmt{Lhs: []ast.Expr{ast.NewIdent(bound)},
+			Tok: token.DEFINE, Rhs: []ast.Expr{asrt}}
+	}
+
+	return []ast.Stmt{&ast.TypeSwitchStmt{Assign: assign,
+		Body: &ast.BlockStmt{List: cases}}}
+}
+
+func (tsw *GoTypeSwitch) String() string {
+	b := &bytes.Buffer{}
+	b.WriteString("GoTypeSwitch[")
+	b.WriteString(tsw.expr.String())
+	b.WriteString("|")
+	for i, c := range tsw.cases {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString(c.String())
+	}
+	if tsw.dflt != nil {
+		b.WriteString("|")
+		b.WriteString(tsw.dflt.String())
+	}
+	b.WriteString("]")
+	return b.String()
+}
+
+// return the statements in 'stmt', removing the braces from a block
+func blockStmts(stmt GoStatement) []ast.Stmt {
+	stmts := stmt.Stmts()
+	if len(stmts) == 1 {
+		if blk, ok := stmts[0].(*ast.BlockStmt); ok {
+			return blk.List
+		}
+	}
+
+	return stmts
+}
+
+type GoTypeCase struct {
+	test *GoInstanceOf
+	body GoStatement
+}
+
+// 'bound' is the name of the variable bound by the type switch
+func (gtc *GoTypeCase) CaseClause(bound string) *ast.CaseClause {
+	body := blockStmts(gtc.body)
+	if gtc.usesBinding() && gtc.test.binding.Name() != bound {
+		lhs := []ast.Expr{ast.NewIdent(gtc.test.binding.Name())}
+		bind := &ast.AssignStmt{Lhs: lhs, Tok: token.DEFINE,
+			Rhs: []ast.Expr{ast.NewIdent(bound)}}
+		body = append([]ast.Stmt{bind}, body...)
+	}
+
+	return &ast.CaseClause{List: []ast.Expr{gtc.test.typedata.Expr()},
+		Body: body}
+}
+
+// return true if the case body uses the pattern variable
+func (gtc *GoTypeCase) usesBinding() bool {
+	return gtc.test.binding != nil && gtc.body.hasVariable(gtc.test.binding)
+}
+
+func (gtc *GoTypeCase) hasVariable(govar GoVar) bool {
+	return gtc.test.hasVariable(govar) || gtc.body.hasVariable(govar)
+}
+
+func (gtc *GoTypeCase) RunTransform(xform TransformFunc, prog *GoProgram, cls GoClass, parent GoObject) (GoObject, bool) {
+	obj, is_nil := gtc.body.RunTransform(xform, prog, cls, gtc)
+	if !is_nil {
+		var err error
+		if gtc.body, err = convertToStmt(obj); err != nil {
+			panic(err)
+		}
+	}
+
+	return xform(parent, prog, cls, gtc)
+}
+
+func (gtc *GoTypeCase) String() string {
+	return "GoTypeCase[" + gtc.test.typedata.String() + "|" +
+		gtc.body.String() + "]"
+}
+
 type GoUnaryExpr struct {
 	op token.Token
 	x  GoExpr
@@ -6255,16 +8611,26 @@ func (gvi *GoVarInit) Expr() ast.Expr {
 		return gvi.expr.Expr()
 	}
 
-	elements := make([]ast.Expr, len(gvi.elements))
-	for i, v := range gvi.elements {
-		elements[i] = v.Expr()
-	}
-
 	var vartype ast.Expr
 	if gvi.govar != nil {
 		vartype = gvi.govar.Type()
 	}
 
+	return gvi.compositeLit(vartype)
+}
+
+// return the "{...}" initializer list as a composite literal of type
+// 'vartype', leaving out the types of nested lists
+func (gvi *GoVarInit) compositeLit(vartype ast.Expr) ast.Expr {
+	elements := make([]ast.Expr, len(gvi.elements))
+	for i, v := range gvi.elements {
+		if nested, ok := v.(*GoVarInit); ok && nested.expr == nil {
+			elements[i] = nested.compositeLit(nil)
+		} else {
+			elements[i] = v.Expr()
+		}
+	}
+
 	return &ast.CompositeLit{Type: vartype, Elts: elements}
 }
 
@@ -6456,7 +8822,7 @@ func (nm *NilMethodOwner) AddMethod(mthd GoMethod) {
 	panic("Unimplemented")
 }
 
-func (nm *NilMethodOwner) Constants() []ast.Decl {
+func (nm *NilMethodOwner) Constants(gp *GoProgram) []ast.Decl {
 	panic("Unimplemented")
 }
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"go/ast"
+	"strings"
 )
 
 type GoVar interface {
@@ -33,11 +34,12 @@ type GoVarData struct {
 	class_field bool
 	is_static bool
 	is_final bool
+	is_const bool
 }
 
 func (gvd *GoVarData) Expr() ast.Expr {
 	ident := gvd.Ident()
-	if gvd.rcvr == "" || gvd.is_static {
+	if gvd.rcvr == "" || gvd.is_static || gvd.is_const {
 		return ident
 	}
 
@@ -160,6 +162,8 @@ func (gvd *GoVarData) VarType() *TypeData {
 type GoClassAttribute struct {
 	govar GoVar
 	suffix string
+	// used to find the type of the field after all classes are analyzed
+	program *GoProgram
 }
 
 func (gvr *GoClassAttribute) Equals(govar GoVar) bool {
@@ -227,6 +231,44 @@ func (gvr *GoClassAttribute) Type() ast.Expr {
 	return gvr.govar.Type()
 }
 
+// return the type of the field, or nil if the field cannot be found
+// (possibly because its class hasn't been analyzed yet)
 func (gvr *GoClassAttribute) VarType() *TypeData {
-	return gvr.govar.VarType()
+	otype := gvr.govar.VarType()
+	if gvr.program == nil || otype == nil || otype.vtype != VT_CLASS {
+		return otype
+	}
+
+	// follow each field in a suffix like "next.name"
+	for _, name := range strings.Split(gvr.suffix, ".") {
+		if otype == nil || otype.vtype != VT_CLASS {
+			return nil
+		}
+
+		otype = gvr.fieldType(otype.vclass, name)
+	}
+
+	return otype
+}
+
+// return the type of field 'name' in class 'clsname' or its superclasses
+func (gvr *GoClassAttribute) fieldType(clsname string, name string) *TypeData {
+	cd, ok := gvr.program.findClass(clsname).(*GoClassDefinition)
+	if !ok {
+		return nil
+	}
+
+	for c := cd; c != nil; c, _ = c.super.(*GoClassDefinition) {
+		if fld := c.findField(name); fld != nil {
+			return fld.VarType()
+		}
+
+		for _, con := range c.constants {
+			if con.name == name {
+				return con.VarType()
+			}
+		}
+	}
+
+	return nil
 }
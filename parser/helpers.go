@@ -0,0 +1,513 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Go source for a type which is added to the translated code when the
+// Java class it replaces is used
+type helperType struct {
+	source  string
+	imports []string
+}
+
+// byte buffer helper which replaces java.nio.ByteBuffer
+var byteBufferHelper = &helperType{imports: []string{"encoding/binary",
+	"math"}, source: `
+// ByteBuffer replaces java.nio.ByteBuffer, reading and writing a byte
+// slice at a position which is advanced by relative gets and puts
+type ByteBuffer struct {
+	buf   []byte
+	pos   int
+	lim   int
+	mark  int
+	order binary.ByteOrder
+}
+
+// NewByteBuffer returns a big-endian buffer which covers all of 'buf'
+func NewByteBuffer(buf []byte) *ByteBuffer {
+	return &ByteBuffer{buf: buf, lim: len(buf), mark: -1,
+		order: binary.BigEndian}
+}
+
+func (bb *ByteBuffer) Array() []byte { return bb.buf }
+
+func (bb *ByteBuffer) Capacity() int { return len(bb.buf) }
+
+func (bb *ByteBuffer) Position() int { return bb.pos }
+
+func (bb *ByteBuffer) SetPosition(pos int) *ByteBuffer {
+	if pos < 0 || pos > bb.lim {
+		panic("ByteBuffer position out of range")
+	}
+	if bb.mark > pos {
+		bb.mark = -1
+	}
+	bb.pos = pos
+	return bb
+}
+
+func (bb *ByteBuffer) Limit() int { return bb.lim }
+
+func (bb *ByteBuffer) SetLimit(lim int) *ByteBuffer {
+	if lim < 0 || lim > len(bb.buf) {
+		panic("ByteBuffer limit out of range")
+	}
+	if bb.pos > lim {
+		bb.pos = lim
+	}
+	if bb.mark > lim {
+		bb.mark = -1
+	}
+	bb.lim = lim
+	return bb
+}
+
+func (bb *ByteBuffer) Remaining() int { return bb.lim - bb.pos }
+
+func (bb *ByteBuffer) HasRemaining() bool { return bb.pos < bb.lim }
+
+func (bb *ByteBuffer) Order() binary.ByteOrder { return bb.order }
+
+func (bb *ByteBuffer) SetOrder(order binary.ByteOrder) *ByteBuffer {
+	bb.order = order
+	return bb
+}
+
+func (bb *ByteBuffer) Mark() *ByteBuffer {
+	bb.mark = bb.pos
+	return bb
+}
+
+func (bb *ByteBuffer) Reset() *ByteBuffer {
+	if bb.mark < 0 {
+		panic("ByteBuffer mark is not set")
+	}
+	bb.pos = bb.mark
+	return bb
+}
+
+func (bb *ByteBuffer) Clear() *ByteBuffer {
+	bb.pos, bb.lim, bb.mark = 0, len(bb.buf), -1
+	return bb
+}
+
+func (bb *ByteBuffer) Flip() *ByteBuffer {
+	bb.pos, bb.lim, bb.mark = 0, bb.pos, -1
+	return bb
+}
+
+func (bb *ByteBuffer) Rewind() *ByteBuffer {
+	bb.pos, bb.mark = 0, -1
+	return bb
+}
+
+func (bb *ByteBuffer) Compact() *ByteBuffer {
+	n := copy(bb.buf, bb.buf[bb.pos:bb.lim])
+	bb.pos, bb.lim, bb.mark = n, len(bb.buf), -1
+	return bb
+}
+
+// Slice returns a big-endian buffer which shares the remaining bytes
+func (bb *ByteBuffer) Slice() *ByteBuffer {
+	return NewByteBuffer(bb.buf[bb.pos:bb.lim:bb.lim])
+}
+
+// Duplicate returns a big-endian buffer which shares all the bytes
+func (bb *ByteBuffer) Duplicate() *ByteBuffer {
+	dup := *bb
+	dup.order = binary.BigEndian
+	return &dup
+}
+
+// return the index of the next 'n' bytes and advance the position
+func (bb *ByteBuffer) next(n int) int {
+	if bb.lim-bb.pos < n {
+		panic("ByteBuffer has too few bytes remaining")
+	}
+	pos := bb.pos
+	bb.pos += n
+	return pos
+}
+
+// return 'idx' if there are 'n' bytes before the limit
+func (bb *ByteBuffer) at(idx int, n int) int {
+	if idx < 0 || idx > bb.lim-n {
+		panic("ByteBuffer index out of range")
+	}
+	return idx
+}
+
+func (bb *ByteBuffer) Get() byte { return bb.buf[bb.next(1)] }
+
+func (bb *ByteBuffer) GetAt(idx int) byte { return bb.buf[bb.at(idx, 1)] }
+
+func (bb *ByteBuffer) GetBytes(dst []byte) *ByteBuffer {
+	copy(dst, bb.buf[bb.next(len(dst)):])
+	return bb
+}
+
+func (bb *ByteBuffer) GetChar() {{char}} {
+	return {{char}}(bb.order.Uint16(bb.buf[bb.next(2):]))
+}
+
+func (bb *ByteBuffer) GetCharAt(idx int) {{char}} {
+	return {{char}}(bb.order.Uint16(bb.buf[bb.at(idx, 2):]))
+}
+
+func (bb *ByteBuffer) GetShort() int16 {
+	return int16(bb.order.Uint16(bb.buf[bb.next(2):]))
+}
+
+func (bb *ByteBuffer) GetShortAt(idx int) int16 {
+	return int16(bb.order.Uint16(bb.buf[bb.at(idx, 2):]))
+}
+
+func (bb *ByteBuffer) GetInt() int {
+	return int(int32(bb.order.Uint32(bb.buf[bb.next(4):])))
+}
+
+func (bb *ByteBuffer) GetIntAt(idx int) int {
+	return int(int32(bb.order.Uint32(bb.buf[bb.at(idx, 4):])))
+}
+
+func (bb *ByteBuffer) GetLong() int64 {
+	return int64(bb.order.Uint64(bb.buf[bb.next(8):]))
+}
+
+func (bb *ByteBuffer) GetLongAt(idx int) int64 {
+	return int64(bb.order.Uint64(bb.buf[bb.at(idx, 8):]))
+}
+
+func (bb *ByteBuffer) GetFloat() float32 {
+	return math.Float32frombits(bb.order.Uint32(bb.buf[bb.next(4):]))
+}
+
+func (bb *ByteBuffer) GetFloatAt(idx int) float32 {
+	return math.Float32frombits(bb.order.Uint32(bb.buf[bb.at(idx, 4):]))
+}
+
+func (bb *ByteBuffer) GetDouble() float64 {
+	return math.Float64frombits(bb.order.Uint64(bb.buf[bb.next(8):]))
+}
+
+func (bb *ByteBuffer) GetDoubleAt(idx int) float64 {
+	return math.Float64frombits(bb.order.Uint64(bb.buf[bb.at(idx, 8):]))
+}
+
+func (bb *ByteBuffer) Put(b byte) *ByteBuffer {
+	bb.buf[bb.next(1)] = b
+	return bb
+}
+
+func (bb *ByteBuffer) PutAt(idx int, b byte) *ByteBuffer {
+	bb.buf[bb.at(idx, 1)] = b
+	return bb
+}
+
+func (bb *ByteBuffer) PutBytes(src []byte) *ByteBuffer {
+	copy(bb.buf[bb.next(len(src)):], src)
+	return bb
+}
+
+func (bb *ByteBuffer) PutChar(v {{char}}) *ByteBuffer {
+	bb.order.PutUint16(bb.buf[bb.next(2):], uint16(v))
+	return bb
+}
+
+func (bb *ByteBuffer) PutCharAt(idx int, v {{char}}) *ByteBuffer {
+	bb.order.PutUint16(bb.buf[bb.at(idx, 2):], uint16(v))
+	return bb
+}
+
+func (bb *ByteBuffer) PutShort(v int16) *ByteBuffer {
+	bb.order.PutUint16(bb.buf[bb.next(2):], uint16(v))
+	return bb
+}
+
+func (bb *ByteBuffer) PutShortAt(idx int, v int16) *ByteBuffer {
+	bb.order.PutUint16(bb.buf[bb.at(idx, 2):], uint16(v))
+	return bb
+}
+
+func (bb *ByteBuffer) PutInt(v int) *ByteBuffer {
+	bb.order.PutUint32(bb.buf[bb.next(4):], uint32(v))
+	return bb
+}
+
+func (bb *ByteBuffer) PutIntAt(idx int, v int) *ByteBuffer {
+	bb.order.PutUint32(bb.buf[bb.at(idx, 4):], uint32(v))
+	return bb
+}
+
+func (bb *ByteBuffer) PutLong(v int64) *ByteBuffer {
+	bb.order.PutUint64(bb.buf[bb.next(8):], uint64(v))
+	return bb
+}
+
+func (bb *ByteBuffer) PutLongAt(idx int, v int64) *ByteBuffer {
+	bb.order.PutUint64(bb.buf[bb.at(idx, 8):], uint64(v))
+	return bb
+}
+
+func (bb *ByteBuffer) PutFloat(v float32) *ByteBuffer {
+	bb.order.PutUint32(bb.buf[bb.next(4):], math.Float32bits(v))
+	return bb
+}
+
+func (bb *ByteBuffer) PutFloatAt(idx int, v float32) *ByteBuffer {
+	bb.order.PutUint32(bb.buf[bb.at(idx, 4):], math.Float32bits(v))
+	return bb
+}
+
+func (bb *ByteBuffer) PutDouble(v float64) *ByteBuffer {
+	bb.order.PutUint64(bb.buf[bb.next(8):], math.Float64bits(v))
+	return bb
+}
+
+func (bb *ByteBuffer) PutDoubleAt(idx int, v float64) *ByteBuffer {
+	bb.order.PutUint64(bb.buf[bb.at(idx, 8):], math.Float64bits(v))
+	return bb
+}
+`}
+
+// alias which lets java.util.regex.Pattern variables hold a compiled
+// regexp.Regexp
+var patternHelper = &helperType{imports: []string{"regexp"}, source: `
+// Pattern replaces java.util.regex.Pattern
+type Pattern = regexp.Regexp
+`}
+
+// split function which keeps Java's handling of trailing empty strings
+var splitHelper = &helperType{imports: []string{"regexp"}, source: `
+// SplitPattern splits 's' around the matches of 're' like Java's split(),
+// which drops trailing empty strings when 'limit' is zero
+func SplitPattern(re *regexp.Regexp, s string, limit int) []string {
+	if limit != 0 {
+		return re.Split(s, limit)
+	}
+
+	parts := re.Split(s, -1)
+	if len(parts) == 1 {
+		// input without a match is returned as is, even if it's empty
+		return parts
+	}
+
+	n := len(parts)
+	for n > 0 && parts[n-1] == "" {
+		n--
+	}
+	return parts[:n]
+}
+`}
+
+// array formatting functions which match Java's Arrays.toString()
+var arrayStringHelper = &helperType{imports: []string{"fmt", "strings"},
+	source: `
+// ArrayString formats 'a' like Java's Arrays.toString(), which separates
+// elements with commas and formats a null array as "null"
+func ArrayString[T any](a []T) string {
+	if a == nil {
+		return "null"
+	}
+
+	parts := make([]string, len(a))
+	for i, v := range a {
+		parts[i] = fmt.Sprint(v)
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+// CharArrayString formats 'a' like Java's Arrays.toString(char[]), which
+// prints the characters rather than their values
+func CharArrayString(a []{{char}}) string {
+	if a == nil {
+		return "null"
+	}
+
+	parts := make([]string, len(a))
+	for i, c := range a {
+		parts[i] = string(c)
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+`}
+
+// hash function which matches Java's default Object.hashCode()
+var identityHashHelper = &helperType{imports: []string{"reflect"},
+	source: `
+// IdentityHashCode returns a hash of the object 'o' points to, which like
+// Java's default hashCode() only matches the hash of the same object
+func IdentityHashCode(o any) int {
+	v := reflect.ValueOf(o)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return 0
+	}
+	return int(int32(v.Pointer() ^ v.Pointer()>>32))
+}
+`}
+
+// clock helper which matches Java's System.nanoTime()
+var nanoTimeHelper = &helperType{imports: []string{"time"}, source: `
+// nanoStart is the time the program started
+var nanoStart = time.Now()
+
+// NanoTime returns the nanoseconds elapsed since the program started,
+// measured on the monotonic clock so, like Java's System.nanoTime(),
+// it is only useful for measuring elapsed time
+func NanoTime() int64 {
+	return time.Since(nanoStart).Nanoseconds()
+}
+`}
+
+// matcher helper which replaces java.util.regex.Matcher
+var matcherHelper = &helperType{imports: []string{"regexp"}, source: `
+// Matcher replaces java.util.regex.Matcher, recording the submatch
+// indexes of the last match of a regexp against the input
+type Matcher struct {
+	re      *regexp.Regexp
+	input   string
+	matches [][]int
+	next    int
+	groups  []int
+}
+
+// NewMatcher returns a Matcher which searches 'input' for 're'
+func NewMatcher(re *regexp.Regexp, input string) *Matcher {
+	return &Matcher{re: re, input: input}
+}
+
+// match the input against the pattern wrapped in 'prefix' and 'suffix'
+func (m *Matcher) anchored(prefix string, suffix string) bool {
+	re := regexp.MustCompile(prefix + "(?:" + m.re.String() + ")" + suffix)
+	m.groups = re.FindStringSubmatchIndex(m.input)
+	return m.groups != nil
+}
+
+// Matches reports whether the entire input matches the pattern
+func (m *Matcher) Matches() bool { return m.anchored("^", "$") }
+
+// LookingAt reports whether the start of the input matches the pattern
+func (m *Matcher) LookingAt() bool { return m.anchored("^", "") }
+
+// Find advances to the next match of the pattern in the input
+func (m *Matcher) Find() bool {
+	if m.matches == nil {
+		m.matches = m.re.FindAllStringSubmatchIndex(m.input, -1)
+	}
+	if m.next >= len(m.matches) {
+		m.groups = nil
+		return false
+	}
+	m.groups = m.matches[m.next]
+	m.next++
+	return true
+}
+
+// Reset discards the match state so Find starts from the beginning
+func (m *Matcher) Reset() *Matcher {
+	m.matches, m.next, m.groups = nil, 0, nil
+	return m
+}
+
+func (m *Matcher) GroupCount() int { return m.re.NumSubexp() }
+
+// return the byte indexes of group 'n' in the last match
+func (m *Matcher) group(n int) (int, int) {
+	if m.groups == nil {
+		panic("Matcher has no match")
+	}
+	if n < 0 || 2*n >= len(m.groups) {
+		panic("Matcher group index out of range")
+	}
+	return m.groups[2*n], m.groups[2*n+1]
+}
+
+// Group returns the text matched by group 'n', or "" if the group did not
+// take part in the match
+func (m *Matcher) Group(n int) string {
+	start, end := m.group(n)
+	if start < 0 {
+		return ""
+	}
+	return m.input[start:end]
+}
+
+func (m *Matcher) Start(n int) int {
+	start, _ := m.group(n)
+	return start
+}
+
+func (m *Matcher) End(n int) int {
+	_, end := m.group(n)
+	return end
+}
+`}
+
+// add the helper type 'name' to the translated code
+func (gp *GoProgram) addHelper(name string, helper *helperType) {
+	if gp.helpers == nil {
+		gp.helpers = make(map[string]*helperType)
+	}
+
+	gp.helpers[name] = helper
+}
+
+// return the sorted names of the helper types used by the translated code
+func (gp *GoProgram) helperNames() []string {
+	names := make([]string, 0, len(gp.helpers))
+	for name := range gp.helpers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// return the name of the file which holds helper type 'name'
+func helperFileName(name string) string {
+	return "java2go_" + strings.ToLower(name) + ".go"
+}
+
+// write the Go source file for helper type 'name'
+func (gp *GoProgram) writeHelper(out io.Writer, name string) {
+	helper := gp.helpers[name]
+
+	fmt.Fprintf(out, "package %s\n\n", gp.pkgname)
+	for _, pkg := range helper.imports {
+		fmt.Fprintf(out, "import %q\n", pkg)
+	}
+
+	io.WriteString(out, strings.ReplaceAll(helper.source, "{{char}}",
+		gp.config.charType()))
+}
+
+// write the source file of each helper type after the translated code
+func (gp *GoProgram) writeHelpers(out io.Writer) {
+	for _, name := range gp.helperNames() {
+		fmt.Fprintf(out, "\n// %s\n", helperFileName(name))
+		gp.writeHelper(out, name)
+	}
+}
+
+// write each helper type to its own file in 'dirpath', so all the
+// translated files in a package share a single copy
+func (gp *GoProgram) writeHelperFiles(dirpath string) error {
+	for _, name := range gp.helperNames() {
+		fd, err := os.Create(path.Join(dirpath, helperFileName(name)))
+		if err != nil {
+			return err
+		}
+
+		gp.writeHelper(fd, name)
+
+		fd.Close()
+	}
+
+	return nil
+}
@@ -1,8 +1,11 @@
 package parser
 
 import (
+	"bytes"
 	"fmt"
+	"log"
 	"os"
+	"strings"
 	"testing"
 
 	"java2go/grammar"
@@ -45,3 +48,1375 @@ func Test_Main(t *testing.T) {
 	fmt.Println("========= FINAL PROGRAM =============")
 	pgm.Dump(os.Stdout)
 }
+
+func translate(t *testing.T, src string) string {
+	return translateConfig(t, src, nil)
+}
+
+func translateConfig(t *testing.T, src string, cfg *Config) string {
+	pgm := translateProgram(t, "", src, cfg, false)
+
+	out := &bytes.Buffer{}
+	pgm.Dump(out)
+	return out.String()
+}
+
+// translate 'src' and return the Go code along with the errors logged
+// while translating it
+func translateLog(t *testing.T, src string, verbose bool) (string, string) {
+	logged := &bytes.Buffer{}
+
+	flags, out := log.Flags(), log.Writer()
+	log.SetFlags(0)
+	log.SetOutput(logged)
+	defer func() {
+		log.SetFlags(flags)
+		log.SetOutput(out)
+	}()
+
+	pgm := translateProgram(t, "", src, nil, verbose)
+
+	buf := &bytes.Buffer{}
+	pgm.Dump(buf)
+	return buf.String(), logged.String()
+}
+
+func translateProgram(t *testing.T, name string, src string,
+	cfg *Config, verbose bool) *GoProgram {
+	lx := grammar.NewLexer(grammar.NewStringReader(src), false)
+
+	rtn := grammar.JulyParse(lx)
+	testutil.AssertEqual(t, rtn, 0, "Expected", 0, "not", rtn)
+	testutil.AssertNotNil(t, lx.JavaProgram(), "Parser did not return Java parse tree")
+
+	pgm := NewGoProgram(name, cfg, verbose)
+	pgm.Analyze(lx.JavaProgram())
+
+	for _, rule := range StandardRules {
+		pgm.RunTransform(rule, pgm, nil, nil)
+	}
+
+	return pgm
+}
+
+func assertContains(t *testing.T, out string, expected ...string) {
+	for _, exp := range expected {
+		if !strings.Contains(out, exp) {
+			t.Fatalf("Expected \"%s\" in output:\n%s", exp, out)
+		}
+	}
+}
+
+func Test_ConstantFolding(t *testing.T) {
+	src := "public class foo\n" +
+		"{\n" +
+		" static final int HEADER = 4;\n" +
+		" static final int SIZE = HEADER + 4 * 8;\n" +
+		" static final long MASK = 1L << 40;\n" +
+		" static final String NAME = \"proto\";\n" +
+		" static final String FULL = NAME + \"-v\" + 2;\n" +
+		" static final int NOW = (int) System.currentTimeMillis();\n" +
+		"}\n"
+
+	out := translate(t, src)
+	assertContains(t, out,
+		"const SIZE = HEADER + 4*8\n",
+		"const MASK int64 = 1 << 40\n",
+		"const FULL = NAME + \"-v2\"\n",
+		"var NOW = int(time.Now().UnixMilli())\n")
+
+	// Java wraps around at the width of each type, Go constants don't
+	src = "public class wrap\n" +
+		"{\n" +
+		" static final int MIN = 1 << 31;\n" +
+		" static final int MAX = MIN - 1;\n" +
+		" static final int ALL = 0xFFFFFFFF;\n" +
+		" static final int SHIFT = 1 << 33;\n" +
+		" static final long TOP = 1L << 63;\n" +
+		" static final long WIDE = (long) (1 << 31);\n" +
+		" static final byte B = (byte) 300;\n" +
+		" static final byte NEG = (byte) -1;\n" +
+		" static final short S = (short) 40000;\n" +
+		" static final char C = (char) 65601;\n" +
+		" static final int OK = (1 << 30) - 1;\n" +
+		"}\n"
+
+	out = translate(t, src)
+	assertContains(t, out,
+		"const MIN = -2147483648\n",
+		"const MAX = 2147483647\n",
+		"const ALL = -1\n",
+		"const SHIFT = 2\n",
+		"const TOP int64 = -9223372036854775808\n",
+		"const WIDE int64 = -2147483648\n",
+		"const B byte = 44\n",
+		"const NEG byte = 255\n",
+		"const S int16 = -25536\n",
+		"const C rune = 65\n",
+		"const OK = 1<<30 - 1\n")
+
+	cfg := &Config{}
+	cfg.setCharModel(charModelByte)
+	assertContains(t, translateConfig(t, src, cfg), "const C byte = 65\n")
+}
+
+func Test_CharModel(t *testing.T) {
+	src := "public class Chars\n" +
+		"{\n" +
+		" static final char SEP = ',';\n" +
+		" static int count(String s) {\n" +
+		"  int n = 0;\n" +
+		"  for (int i = 0; i < s.length(); i++) {\n" +
+		"   char c = s.charAt(i);\n" +
+		"   switch (c) {\n" +
+		"   case 'a':\n" +
+		"    n++;\n" +
+		"    break;\n" +
+		"   default:\n" +
+		"    break;\n" +
+		"   }\n" +
+		"  }\n" +
+		"  return n;\n" +
+		" }\n" +
+		" static char[] chars(String s) { return s.toCharArray(); }\n" +
+		" static char next(int i) { return (char) (i + 1); }\n" +
+		" static String show(char c) { return String.valueOf(c); }\n" +
+		"}\n"
+
+	byteCfg := &Config{}
+	byteCfg.setCharModel(charModelByte)
+	runeCfg := &Config{}
+	runeCfg.setCharModel(charModelRune)
+
+	// translate with each model in turn to make sure one program's
+	// model doesn't leak into the next
+	for _, cfg := range []*Config{byteCfg, runeCfg, nil} {
+		out := translateConfig(t, src, cfg)
+		if cfg == byteCfg {
+			assertContains(t, out,
+				"const SEP byte = ','\n",
+				"\tfor i := 0; i < len(s); i++ {\n",
+				"\t\tc := s[i]\n",
+				"\t\tswitch c {\n\t\tcase 'a':\n",
+				"func chars(s string) ([]byte) {\n\treturn []byte(s)\n",
+				"func next(i int) (byte) {\n\treturn byte(i + 1)\n",
+				"func show(c byte) (string) {\n\treturn string(c)\n")
+			if strings.Contains(out, "rune") {
+				t.Fatalf("Unexpected rune in byte model:\n%s", out)
+			}
+			continue
+		}
+
+		assertContains(t, out,
+			"const SEP = ','\n",
+			"\tfor i := 0; i < utf8.RuneCountInString(s); i++ {\n",
+			"\t\tc := []rune(s)[i]\n",
+			"\t\tswitch c {\n\t\tcase 'a':\n",
+			"func chars(s string) ([]rune) {\n\treturn []rune(s)\n",
+			"func next(i int) (rune) {\n\treturn rune(i + 1)\n",
+			"func show(c rune) (string) {\n\treturn string(c)\n")
+		if strings.Contains(out, "byte") {
+			t.Fatalf("Unexpected byte in rune model:\n%s", out)
+		}
+	}
+
+	// string fields and String method results are also converted
+	src = "public class Node\n" +
+		"{\n" +
+		" String name;\n" +
+		" Node next;\n" +
+		" int size(Node o, String s) {\n" +
+		"  return this.name.length() + o.next.name.length() +\n" +
+		"   s.trim().charAt(1);\n" +
+		" }\n" +
+		"}\n"
+
+	assertContains(t, translateConfig(t, src, byteCfg),
+		"\treturn len(rcvr.name) + len(o.next.name) + s.trim()[1]\n")
+}
+
+func Test_Initializers(t *testing.T) {
+	src := "public class foo\n" +
+		"{\n" +
+		" private static int[] TABLE = new int[4];\n" +
+		" private int count = 3;\n" +
+		" private int other;\n" +
+		" static { TABLE[0] = 1; }\n" +
+		" { other = count * 2; }\n" +
+		" static { TABLE[1] = 2; }\n" +
+		" public foo() { }\n" +
+		" public foo(int x) { this(); count = x; }\n" +
+		"}\n"
+
+	out := translate(t, src)
+	assertContains(t, out,
+		"func init() {\n\ttABLE[0] = 1\n\ttABLE[1] = 2\n}\n",
+		"rcvr = &foo{}\n\trcvr.count = 3\n\t{\n\t\trcvr.other = rcvr.count * 2\n\t}\n")
+	if strings.Count(out, "rcvr.other =") != 1 {
+		t.Fatalf("Instance initializer should only be in one constructor:\n%s",
+			out)
+	}
+
+	// static fields initialized after a static block are set in init()
+	src = "public class bar\n" +
+		"{\n" +
+		" static int[] T = new int[4];\n" +
+		" static { T[0] = 7; }\n" +
+		" static int U = T[0];\n" +
+		" static int[] W = {1, 2};\n" +
+		"}\n"
+
+	out = translate(t, src)
+	assertContains(t, out,
+		"var T = make([]int, 4)\nvar U int\nvar W []int\n",
+		"func init() {\n\tT[0] = 7\n\tU = T[0]\n\tW = []int{1, 2}\n}\n")
+}
+
+func Test_AnonymousClasses(t *testing.T) {
+	src := "public class foo\n" +
+		"{\n" +
+		" private int base = 3;\n" +
+		" public void go(final int limit) {\n" +
+		"  Runnable r = new Runnable() {\n" +
+		"   public void run() { System.out.println(limit); }\n" +
+		"  };\n" +
+		"  Listener l = new Listener() {\n" +
+		"   private int count;\n" +
+		"   public void fired(int x) { count += x + limit + base; }\n" +
+		"   public void reset() { count = 0; }\n" +
+		"  };\n" +
+		"  r.run();\n" +
+		" }\n" +
+		"}\n"
+
+	out := translate(t, src)
+	assertContains(t, out,
+		"r := foo_anon1(func() {\n",
+		"\tr.Run()\n",
+		"type foo_anon1 func()\n",
+		"func (fn foo_anon1) Run() {\n\tfn()\n}\n",
+		"l := Newfoo_anon2(limit, rcvr)\n",
+		"rcvr = &foo_anon2{limit: limit, outer: outer}\n",
+		"rcvr.count += x + rcvr.limit + rcvr.outer.base\n",
+		"func (rcvr *foo_anon2) Reset() {\n")
+}
+
+func Test_InnerClasses(t *testing.T) {
+	src := "public class foo\n" +
+		"{\n" +
+		" private int base = 3;\n" +
+		" class Inner {\n" +
+		"  private int x;\n" +
+		"  Inner(int x) { this.x = x; }\n" +
+		"  int sum() { return x + base + foo.this.base; }\n" +
+		"  void bump() { helper(); }\n" +
+		" }\n" +
+		" static class Nested {\n" +
+		"  int y;\n" +
+		" }\n" +
+		" void helper() { }\n" +
+		" int run() { Inner in = new Inner(2); return in.sum(); }\n" +
+		"}\n"
+
+	out := translate(t, src)
+	assertContains(t, out,
+		"type foo_Inner struct {\n\tx     int\n\touter *foo\n}\n",
+		"func Newfoo_Inner(outer *foo, x int) (rcvr *foo_Inner) {\n"+
+			"\trcvr = &foo_Inner{outer: outer}\n",
+		"return rcvr.x + rcvr.outer.base + rcvr.outer.base\n",
+		"rcvr.outer.helper()\n",
+		"in := Newfoo_Inner(rcvr, 2)\n",
+		"type foo_Nested struct {\n\ty int\n}\n",
+		"func Newfoo_Nested() (rcvr *foo_Nested) {\n")
+}
+
+func Test_VirtualMethods(t *testing.T) {
+	src := "public class foo\n" +
+		"{\n" +
+		" static class Base {\n" +
+		"  protected int count;\n" +
+		"  public void process() { step(); count++; }\n" +
+		"  protected int step() { return count; }\n" +
+		" }\n" +
+		" static class Derived extends Base {\n" +
+		"  public Derived() { super(); }\n" +
+		"  protected int step() { return count * 2; }\n" +
+		" }\n" +
+		"}\n"
+
+	out := translate(t, src)
+	assertContains(t, out,
+		"type foo_BaseInterface interface {\n\tstep() (int)\n}\n",
+		"\tself  foo_BaseInterface\n",
+		"rcvr = &foo_Base{}\n\trcvr.self = rcvr\n",
+		"rcvr.self.step()\n",
+		"type foo_Derived struct {\n\t*foo_Base\n}\n",
+		"rcvr.foo_Base = Newfoo_Base()\n\trcvr.self = rcvr\n",
+		"return rcvr.count * 2\n")
+
+	// virtual methods are found in the same order on every run
+	src = "public class bar\n" +
+		"{\n" +
+		" static class Base {\n" +
+		"  public int size() { return 0; }\n" +
+		"  public int step() { return 1; }\n" +
+		"  public String name() { return \"base\"; }\n" +
+		" }\n" +
+		" static class Left extends Base {\n" +
+		"  public String name() { return \"left\"; }\n" +
+		" }\n" +
+		" static class Right extends Base {\n" +
+		"  public int step() { return 2; }\n" +
+		"  public int size() { return 3; }\n" +
+		" }\n" +
+		"}\n"
+
+	out = translate(t, src)
+	assertContains(t, out,
+		"type bar_BaseInterface interface {\n"+
+			"\tName() (string)\n"+
+			"\tSize() (int)\n"+
+			"\tStep() (int)\n"+
+			"}\n")
+	for i := 0; i < 10; i++ {
+		testutil.AssertEqual(t, translate(t, src), out,
+			"Translation changed on run", i)
+	}
+
+	// a method first declared below the root is virtual in its own class,
+	// and inherited methods are called on the receiver
+	src = "public class V2\n" +
+		"{\n" +
+		" int n;\n" +
+		" public void run() { n++; }\n" +
+		"}\n" +
+		"class Mid extends V2 {\n" +
+		" public void Go() { hook(); }\n" +
+		" protected void hook() { n = 1; }\n" +
+		" protected void step() { n = 3; }\n" +
+		"}\n" +
+		"class Leaf extends Mid {\n" +
+		" protected void hook() { n = 2; }\n" +
+		"}\n" +
+		"class Deeper extends Leaf {\n" +
+		" public void finish() { step(); run(); hook(); }\n" +
+		"}\n"
+
+	out = translate(t, src)
+	assertContains(t, out,
+		"type MidInterface interface {\n\thook()\n}\n",
+		"\tself V2Interface\n",
+		"func (rcvr *Mid) Go() {\n\trcvr.self.(MidInterface).hook()\n}\n",
+		"\trcvr.step()\n\trcvr.Run()\n\trcvr.self.(MidInterface).hook()\n")
+}
+
+func Test_SuperCalls(t *testing.T) {
+	src := "public class foo\n" +
+		"{\n" +
+		" static class Base {\n" +
+		"  protected int count;\n" +
+		"  Base() { count = 1; }\n" +
+		"  Base(int c) { count = c; }\n" +
+		"  int step(int x) { return count + x; }\n" +
+		" }\n" +
+		" static class Derived extends Base {\n" +
+		"  Derived(int c) { super(c + 1); }\n" +
+		"  Derived() { this(3); }\n" +
+		"  int step(int x) { return super.step(x) + super.count; }\n" +
+		" }\n" +
+		" static class Plain extends Base {\n" +
+		" }\n" +
+		"}\n"
+
+	out := translate(t, src)
+	assertContains(t, out,
+		"func Newfoo_DerivedFromC(c int) (rcvr *foo_Derived) {\n"+
+			"\trcvr = &foo_Derived{}\n"+
+			"\trcvr.foo_Base = Newfoo_BaseFromC(c + 1)\n",
+		"func Newfoo_Derived() (rcvr *foo_Derived) {\n"+
+			"\trcvr = Newfoo_DerivedFromC(3)\n",
+		"return rcvr.foo_Base.step(x) + rcvr.foo_Base.count\n",
+		"func Newfoo_Plain() (rcvr *foo_Plain) {\n"+
+			"\trcvr = &foo_Plain{}\n"+
+			"\trcvr.foo_Base = Newfoo_Base()\n")
+}
+
+func Test_AbstractClasses(t *testing.T) {
+	src := "public class foo\n" +
+		"{\n" +
+		" static abstract class Shape {\n" +
+		"  protected String label;\n" +
+		"  abstract double area();\n" +
+		"  String describe() { return label + area(); }\n" +
+		" }\n" +
+		" static class Square extends Shape {\n" +
+		"  private double side;\n" +
+		"  double area() { return side * side; }\n" +
+		" }\n" +
+		" double total(Shape a) { return a.area(); }\n" +
+		"}\n"
+
+	out := translate(t, src)
+	assertContains(t, out,
+		"type foo_Shape interface {\n\tarea() (float64)\n"+
+			"\tdescribe() (string)\n}\n",
+		"type foo_ShapeBase struct {\n\tlabel string\n\tself  foo_Shape\n}\n",
+		"func Newfoo_ShapeBase() (rcvr *foo_ShapeBase) {\n"+
+			"\trcvr = &foo_ShapeBase{}\n\treturn\n}\n",
+		"rcvr.self.area()",
+		"type foo_Square struct {\n\t*foo_ShapeBase\n",
+		"rcvr.foo_ShapeBase = Newfoo_ShapeBase()\n\trcvr.self = rcvr\n",
+		"func (rcvr *foo) total(a foo_Shape) (float64) {\n")
+	if strings.Contains(out, "func (rcvr *foo_ShapeBase) area()") {
+		t.Fatalf("Abstract method should not be declared:\n%s", out)
+	}
+
+	// calls through abstract class and subclass values use Go names
+	src = "public abstract class Shape2\n" +
+		"{\n" +
+		" public abstract double area();\n" +
+		" public String describe() { return \"shape\"; }\n" +
+		" static double one(Shape2 s) { return s.area(); }\n" +
+		"}\n" +
+		"class Square2 extends Shape2 {\n" +
+		" public double area() { return 4; }\n" +
+		" static String two(Square2 q) { return q.describe(); }\n" +
+		"}\n" +
+		"class User2 {\n" +
+		" double three(Shape2 s) { return s.area(); }\n" +
+		"}\n"
+
+	out = translate(t, src)
+	assertContains(t, out,
+		"type Shape2 interface {\n\tArea() (float64)\n"+
+			"\tDescribe() (string)\n}\n",
+		"func one(s Shape2) (float64) {\n\treturn s.Area()\n}\n",
+		"func two(q *Square2) (string) {\n\treturn q.Describe()\n}\n",
+		"func (rcvr *User2) three(s Shape2) (float64) {\n"+
+			"\treturn s.Area()\n}\n")
+}
+
+func Test_InterfaceMethods(t *testing.T) {
+	src := "interface Sized {\n" +
+		" int size();\n" +
+		" default boolean isEmpty() { return size() == 0; }\n" +
+		" public default int half() { return twice(size()) / 4; }\n" +
+		" static int twice(int x) { return x * 2; }\n" +
+		"}\n" +
+		"class Box implements Sized {\n" +
+		" public int size() { return 3; }\n" +
+		"}\n" +
+		"class Other implements Sized {\n" +
+		" public int size() { return 0; }\n" +
+		" public boolean isEmpty() { return true; }\n" +
+		" int use() { return Sized.twice(5); }\n" +
+		"}\n"
+
+	out := translate(t, src)
+	assertContains(t, out,
+		"type Sized interface {\n\tHalf() (int)\n\tIsEmpty() (bool)\n"+
+			"\tSize() (int)\n}\n",
+		"func Sized_twice(x int) (int) {\n",
+		"func Sized_isEmpty(rcvr Sized) (bool) {\n"+
+			"\treturn rcvr.Size() == 0\n}\n",
+		"return Sized_twice(rcvr.Size()) / 4\n",
+		"func (rcvr *Box) IsEmpty() (bool) {\n"+
+			"\treturn Sized_isEmpty(rcvr)\n}\n",
+		"func (rcvr *Box) Half() (int) {\n",
+		"return Sized_twice(5)\n")
+	if strings.Contains(out, "func (rcvr *Other) IsEmpty() (bool) {\n"+
+		"\treturn Sized_isEmpty(rcvr)") {
+		t.Fatalf("Overridden default method should not be forwarded:\n%s",
+			out)
+	}
+}
+
+func Test_InterfaceAssertions(t *testing.T) {
+	src := "interface Shape {\n" +
+		" double area();\n" +
+		"}\n" +
+		"class Square implements Shape, Runnable {\n" +
+		" public double area() { return 1.0; }\n" +
+		" public void run() { }\n" +
+		"}\n"
+
+	out := translate(t, src)
+	assertContains(t, out,
+		"type Square struct {\n}\n\nvar _ Shape = (*Square)(nil)\n")
+	if strings.Contains(out, "var _ Runnable") {
+		t.Fatalf("Undefined interface should not be asserted:\n%s", out)
+	}
+
+	src = "interface Sized {\n" +
+		" int size();\n" +
+		" void resize(int n);\n" +
+		"}\n" +
+		"interface Named {\n" +
+		" String name();\n" +
+		" void rename(String s);\n" +
+		"}\n" +
+		"class Box implements Sized, Named {\n" +
+		" public long size() { return 1L; }\n" +
+		" public void resize(String n) { }\n" +
+		" public void resize(int n) { }\n" +
+		" public void rename(int s) { }\n" +
+		"}\n"
+
+	expected := "//ERR// Box method Named.Name is missing\n" +
+		"//ERR// Box method Named.Rename has a different type for parameter s\n" +
+		"//ERR// Box method Sized.Resize is named ResizeInt\n" +
+		"//ERR// Box method Sized.Size has a different result type\n"
+	for i := 0; i < 10; i++ {
+		_, logged := translateLog(t, src, true)
+		if !strings.Contains(logged, expected) {
+			t.Fatalf("Expected interface warnings\n%s\nnot\n%s",
+				expected, logged)
+		}
+	}
+
+	_, logged := translateLog(t, src, false)
+	assertContains(t, logged, "//ERR// Interface method is missing\n")
+}
+
+func Test_FunctionTypes(t *testing.T) {
+	src := "interface Listener {\n" +
+		" void onEvent(String e);\n" +
+		"}\n" +
+		"class Bus {\n" +
+		" private Listener listener;\n" +
+		" void fire(String e) { listener.onEvent(e); }\n" +
+		" void install(final String prefix) {\n" +
+		"  listener = new Listener() {\n" +
+		"   public void onEvent(String e) { System.out.println(prefix + e); }\n" +
+		"  };\n" +
+		" }\n" +
+		"}\n"
+
+	cfg := &Config{}
+	cfg.addFuncType("Listener")
+
+	out := translateConfig(t, src, cfg)
+	assertContains(t, out,
+		"type Listener func(e string)\n",
+		"\tlistener Listener\n",
+		"rcvr.listener(e)\n",
+		"rcvr.listener = Listener(func(e string) {\n")
+	if strings.Contains(out, "Bus_anon1") {
+		t.Fatalf("Function type should not need an adapter:\n%s", out)
+	}
+}
+
+func Test_OverloadedMethods(t *testing.T) {
+	src := "public class Point\n" +
+		"{\n" +
+		" private int x, y;\n" +
+		" public Point() { this(0, 0); }\n" +
+		" public Point(int x, int y) { this.x = x; this.y = y; }\n" +
+		" public void write(int v) { }\n" +
+		" public void write(String s) { }\n" +
+		" public void write(long v, String s) { }\n" +
+		" void copy(Point other) {\n" +
+		"  write(\"a\");\n" +
+		"  write(5, \"b\");\n" +
+		"  other.write(x);\n" +
+		" }\n" +
+		"}\n"
+
+	out := translate(t, src)
+	assertContains(t, out,
+		"func NewPoint() (rcvr *Point) {\n\trcvr = NewPointFromXY(0, 0)\n",
+		"func NewPointFromXY(x int, y int) (rcvr *Point) {\n",
+		"func (rcvr *Point) WriteInt(v int) {\n",
+		"func (rcvr *Point) WriteString(s string) {\n",
+		"func (rcvr *Point) WriteLongString(v int64, s string) {\n",
+		"\trcvr.WriteString(\"a\")\n",
+		"\trcvr.WriteLongString(5, \"b\")\n",
+		"\tother.WriteInt(rcvr.x)\n")
+
+	cfg := &Config{}
+	cfg.addMethod("main.Point.write(String)", "WriteText")
+	cfg.addMethod("main.Point.Point(int,int)", "NewPointAt")
+
+	out = translateConfig(t, src, cfg)
+	assertContains(t, out,
+		"func NewPointAt(x int, y int) (rcvr *Point) {\n",
+		"func (rcvr *Point) WriteText(s string) {\n",
+		"\trcvr.WriteText(\"a\")\n")
+}
+
+func Test_OverloadResolution(t *testing.T) {
+	src := "public class Calc\n" +
+		"{\n" +
+		" void run(int[] vals) {\n" +
+		"  show('c');\n" +
+		"  show(2.5);\n" +
+		"  box(3);\n" +
+		"  sum(1, 2, 3);\n" +
+		"  sum(vals);\n" +
+		" }\n" +
+		" void show(long v) { }\n" +
+		" void show(int v) { }\n" +
+		" void show(double v) { }\n" +
+		" void box(Integer v) { }\n" +
+		" void box(Object v) { }\n" +
+		" int sum(int... vals) { return vals.length; }\n" +
+		"}\n"
+
+	out := translate(t, src)
+	assertContains(t, out,
+		"\trcvr.showInt('c')\n",
+		"\trcvr.showDouble(2.5)\n",
+		"\trcvr.boxInteger(3)\n",
+		"\trcvr.sum(1, 2, 3)\n",
+		"\trcvr.sum(vals...)\n",
+		"func (rcvr *Calc) sum(vals ...int) (int) {\n")
+
+	// neither overload is more specific, so Java rejects the call
+	src = "public class Calc\n" +
+		"{\n" +
+		" void run() { f(1, 2); }\n" +
+		" void f(int a, long b) { }\n" +
+		" void f(long a, int b) { }\n" +
+		"}\n"
+
+	_, logged := translateLog(t, src, true)
+	assertContains(t, logged, "//ERR// Ambiguous call to Calc.f(int, int)\n")
+
+	_, logged = translateLog(t, src, false)
+	assertContains(t, logged, "//ERR// Ambiguous method call\n")
+
+	// fields of other objects, 'this' and widened arguments
+	src = "public class Q\n" +
+		"{\n" +
+		" int x, y;\n" +
+		" short s;\n" +
+		" Q() { }\n" +
+		" Q(int a, int b) { x = a; y = b; }\n" +
+		" Q(Q o) { this(o.x, o.y); }\n" +
+		" static Q make(Q o) { return new Q(o.x, 2); }\n" +
+		" void write(int v) { }\n" +
+		" void write(String v) { }\n" +
+		" void write(Q v) { }\n" +
+		" void show(double v) { }\n" +
+		" void show(String v) { }\n" +
+		" void pick(int v) { }\n" +
+		" void pick(Q v) { }\n" +
+		" void copy(Q o, float f, Object obj) {\n" +
+		"  write(o.x);\n" +
+		"  write(this);\n" +
+		"  write(s);\n" +
+		"  show(f);\n" +
+		"  show(2);\n" +
+		"  pick(obj.x);\n" +
+		" }\n" +
+		"}\n"
+
+	out, logged = translateLog(t, src, true)
+	assertContains(t, out,
+		"\trcvr = NewQFromAB(o.x, o.y)\n",
+		"\treturn NewQFromAB(o.x, 2)\n",
+		"\trcvr.writeInt(o.x)\n",
+		"\trcvr.writeQ(rcvr)\n",
+		"\trcvr.writeInt(int(rcvr.s))\n",
+		"\trcvr.showDouble(float64(f))\n",
+		"\trcvr.showDouble(2)\n")
+	assertContains(t, logged, "//ERR// Ambiguous call to Q.pick(Object)\n")
+}
+
+func Test_InstanceOf(t *testing.T) {
+	src := "interface Shape { double area(); }\n" +
+		"class Square implements Shape {\n" +
+		" double side;\n" +
+		" public double area() { return side * side; }\n" +
+		"}\n" +
+		"class Circle implements Shape {\n" +
+		" double r;\n" +
+		" public double area() { return 3.14 * r * r; }\n" +
+		"}\n" +
+		"class Shapes {\n" +
+		" double size(Object obj) {\n" +
+		"  if (obj instanceof Square sq) {\n" +
+		"   return sq.side;\n" +
+		"  } else if (obj instanceof Circle c) {\n" +
+		"   return c.r;\n" +
+		"  } else if (obj instanceof Shape) {\n" +
+		"   return -1.0;\n" +
+		"  } else {\n" +
+		"   return 0.0;\n" +
+		"  }\n" +
+		" }\n" +
+		" double side(Shape s) {\n" +
+		"  if (s instanceof Square sq) {\n" +
+		"   return sq.side;\n" +
+		"  }\n" +
+		"  Circle c = (Circle) s;\n" +
+		"  return c.r;\n" +
+		" }\n" +
+		" boolean isShape(Object o) {\n" +
+		"  boolean b = o instanceof Shape;\n" +
+		"  if (!(o instanceof Shape)) {\n" +
+		"   return b;\n" +
+		"  }\n" +
+		"  return o instanceof Shape;\n" +
+		" }\n" +
+		"}\n"
+
+	assertContains(t, translate(t, src),
+		"func (rcvr *Shapes) size(obj interface{}) (float64) {\n"+
+			"\tswitch sq := obj.(type) {\n"+
+			"\tcase *Square:\n"+
+			"\t\treturn sq.side\n"+
+			"\tcase *Circle:\n"+
+			"\t\tc := sq\n"+
+			"\t\treturn c.r\n"+
+			"\tcase Shape:\n"+
+			"\t\treturn -1.0\n"+
+			"\tdefault:\n",
+		"func (rcvr *Shapes) side(s Shape) (float64) {\n"+
+			"\tif sq, ok := s.(*Square); ok {\n",
+		"\tc := s.(*Circle)\n",
+		"\t_, b := o.(Shape)\n",
+		"\tif _, ok := o.(Shape); !ok {\n",
+		"\t_, ok := o.(Shape)\n\treturn ok\n")
+
+	// pattern variables are bound in compound conditions, and type tests
+	// are hoisted out of other expressions when they are always evaluated
+	src = "class Sq { int s; }\n" +
+		"class Tests {\n" +
+		" int check(Object o, Object p) {\n" +
+		"  if (o instanceof Sq q && q.s > 2) {\n" +
+		"   return q.s;\n" +
+		"  }\n" +
+		"  boolean b = o instanceof Sq || p instanceof Sq;\n" +
+		"  System.out.println(p instanceof Sq);\n" +
+		"  return 0;\n" +
+		" }\n" +
+		"}\n"
+
+	out := translate(t, src)
+	assertContains(t, out,
+		"\tif q, ok := o.(*Sq); ok && q.s > 2 {\n\t\treturn q.s\n",
+		"\t_, ok1 := o.(*Sq)\n\tb := ok1 || func() bool {\n",
+		"\t_, ok2 := p.(*Sq)\n\tfmt.Println(ok2)\n")
+}
+
+func Test_ObjectMethods(t *testing.T) {
+	src := "public class Person implements Comparable {\n" +
+		" private String name;\n" +
+		" private int age;\n" +
+		" public String toString() { return name; }\n" +
+		" public boolean equals(Object o) {\n" +
+		"  Person other = (Person) o;\n" +
+		"  return name.equals(other.name) && age == other.age;\n" +
+		" }\n" +
+		" public int hashCode() { return age; }\n" +
+		" public int compareTo(Person other) { return age - other.age; }\n" +
+		" static boolean same(Person a, Person b, String s) {\n" +
+		"  return a.equals(b) && s.equals(a.toString()) && a.compareTo(b) == 0;\n" +
+		" }\n" +
+		" static void sortAll(Person[] people) {\n" +
+		"  Arrays.sort(people);\n" +
+		" }\n" +
+		"}\n"
+
+	assertContains(t, translate(t, src),
+		"func (rcvr *Person) String() (string) {\n",
+		"func (rcvr *Person) Equals(o interface{}) (bool) {\n",
+		"\treturn rcvr.name == other.name && rcvr.age == other.age\n",
+		"func (rcvr *Person) HashCode() (int) {\n",
+		"func (rcvr *Person) Compare(other *Person) (int) {\n",
+		"\treturn a.Equals(b) && s == a.String() && a.Compare(b) == 0\n",
+		"\tslices.SortStableFunc(people, (*Person).Compare)\n")
+
+	// classes without equals() and hashCode() use Object's identity
+	src = "public class Plain {\n" +
+		" boolean same(Plain p) { return this.equals(p) || equals(p); }\n" +
+		" int hash(Plain p) { return p.hashCode() + hashCode(); }\n" +
+		" String show() { return this.toString() + toString(); }\n" +
+		"}\n"
+
+	out := translate(t, src)
+	assertContains(t, out,
+		"\treturn rcvr == p || rcvr == p\n",
+		"\treturn IdentityHashCode(p) + IdentityHashCode(rcvr)\n",
+		"\treturn fmt.Sprintf(\"%v%v\", fmt.Sprint(rcvr), fmt.Sprint(rcvr))\n")
+}
+
+func Test_Sort(t *testing.T) {
+	src := "public class Item implements Comparable {\n" +
+		" static class ByName implements Comparator {\n" +
+		"  public int compare(Item a, Item b) { return 0; }\n" +
+		" }\n" +
+		" int n;\n" +
+		" public int compareTo(Item o) { return n - o.n; }\n" +
+		" static void sortAll(Item[] items, List<Item> list, int[] nums,\n" +
+		"   List<String> names, Comparator<Item> cmp) {\n" +
+		"  Arrays.sort(nums);\n" +
+		"  Arrays.sort(nums, 1, 3);\n" +
+		"  Collections.sort(names);\n" +
+		"  Collections.sort(list);\n" +
+		"  Arrays.sort(items, cmp);\n" +
+		"  list.sort(new ByName());\n" +
+		"  Collections.sort(list, new Comparator<Item>() {\n" +
+		"   public int compare(Item a, Item b) { return b.n - a.n; }\n" +
+		"  });\n" +
+		"  Arrays.sort(items, new Comparator() {\n" +
+		"   public int compare(Object a, Object b) { return 0; }\n" +
+		"  });\n" +
+		" }\n" +
+		"}\n"
+
+	out := translate(t, src)
+
+	assertContains(t, out,
+		"\tslices.Sort(nums)\n",
+		"\tslices.Sort(nums[1:3])\n",
+		"\tslices.Sort(names)\n",
+		"\tslices.SortStableFunc(list, (*Item).Compare)\n",
+		"\tslices.SortStableFunc(items, cmp.Compare)\n",
+		"\tslices.SortStableFunc(list, NewItem_ByName().Compare)\n",
+		"\tslices.SortStableFunc(list, func(a *Item, b *Item) (int) {\n"+
+			"\t\treturn b.n - a.n\n\t})\n",
+		"\tsort.SliceStable(items, func(i, j int) bool {\n")
+
+	if strings.Contains(out, "type Item_anon") {
+		t.Errorf("Inlined comparator type should not be declared:\n%s", out)
+	}
+}
+
+func Test_Arrays(t *testing.T) {
+	src := "public class Buf {\n" +
+		" int n;\n" +
+		" public boolean equals(Object o) { return n == ((Buf) o).n; }\n" +
+		" static void run(byte[] src, byte[] dst, int[] nums, Buf[] bufs,\n" +
+		"   Buf[] others, int n) {\n" +
+		"  System.arraycopy(src, 0, dst, 0, n);\n" +
+		"  System.arraycopy(src, 2, dst, 4, n);\n" +
+		"  Arrays.fill(nums, -1);\n" +
+		"  Arrays.fill(nums, 1, n, 7);\n" +
+		"  int[] dup = Arrays.copyOf(nums, nums.length);\n" +
+		"  int[] grown = Arrays.copyOf(nums, n);\n" +
+		"  byte[] part = Arrays.copyOfRange(src, 1, n);\n" +
+		"  byte[] tail = Arrays.copyOfRange(src, n, src.length);\n" +
+		"  boolean eq = Arrays.equals(src, dst);\n" +
+		"  boolean same = Arrays.equals(bufs, others);\n" +
+		"  int idx = Arrays.binarySearch(nums, 5);\n" +
+		"  String text = Arrays.toString(nums);\n" +
+		"  String chars = Arrays.toString(new char[] { 'a', 'b' });\n" +
+		" }\n" +
+		"}\n"
+
+	assertContains(t, translate(t, src),
+		"\tcopy(dst[:n], src[:n])\n",
+		"\tcopy(dst[4:4+n], src[2:2+n])\n",
+		"\tfor i := range nums {\n\t\tnums[i] = -1\n\t}\n",
+		"\tfor i := 1; i < n; i++ {\n\t\tnums[i] = 7\n\t}\n",
+		"\tdup := slices.Clone(nums)\n",
+		"\tgrown := func() []int {\n"+
+			"\t\tdst := make([]int, n)\n"+
+			"\t\tcopy(dst, nums)\n"+
+			"\t\treturn dst\n"+
+			"\t}()\n",
+		"\tpart := func() []byte {\n"+
+			"\t\tdst := make([]byte, n-1)\n"+
+			"\t\tcopy(dst, src[1:])\n"+
+			"\t\treturn dst\n"+
+			"\t}()\n",
+		"\ttail := slices.Clone(src[n:])\n",
+		"\teq := slices.Equal(src, dst)\n",
+		"\tsame := slices.EqualFunc(bufs, others, func(a, b *Buf) (bool) {\n"+
+			"\t\treturn a.Equals(b)\n"+
+			"\t})\n",
+		"\tidx := func() int {\n"+
+			"\t\ti, found := slices.BinarySearch(nums, 5)\n"+
+			"\t\tif !found {\n"+
+			"\t\t\treturn -i - 1\n"+
+			"\t\t}\n"+
+			"\t\treturn i\n"+
+			"\t}()\n",
+		"\ttext := ArrayString(nums)\n",
+		"\tchars := CharArrayString([]rune{'a', 'b'})\n",
+		"// java2go_arraystring.go\n",
+		"func ArrayString[T any](a []T) string {\n",
+		"\treturn \"[\" + strings.Join(parts, \", \") + \"]\"\n",
+		"func CharArrayString(a []rune) string {\n")
+}
+
+func Test_MultiDimArrays(t *testing.T) {
+	src := "public class Grid {\n" +
+		" int cells[][] = new int[3][4];\n" +
+		" static int[][] table = {{1, 2}, {3, 4}};\n" +
+		" static int[][] build(int rows, int cols, String args[]) {\n" +
+		"  int[][][] cube = new int[2][rows][cols];\n" +
+		"  for (int i = 0; i < 2; i++) cube[i] = new int[rows][cols];\n" +
+		"  int[][][][][][][][] deep = new int[1][2][3][4][5][6][7][8];\n" +
+		"  String[][] names = new String[rows][];\n" +
+		"  int[][] ragged = new int[][] {{1}, {2, 3}};\n" +
+		"  return new int[rows][cols];\n" +
+		" }\n" +
+		"}\n"
+
+	assertContains(t, translate(t, src),
+		"var table = [][]int{{1, 2}, {3, 4}}\n",
+		"\tcells [][]int\n",
+		"\trcvr.cells = make([][]int, 3)\n"+
+			"\tfor i := range rcvr.cells {\n"+
+			"\t\trcvr.cells[i] = make([]int, 4)\n"+
+			"\t}\n",
+		"func build(rows int, cols int, args []string) ([][]int) {\n",
+		"\tcube := make([][][]int, 2)\n"+
+			"\tfor i := range cube {\n"+
+			"\t\tcube[i] = make([][]int, rows)\n"+
+			"\t\tfor j := range cube[i] {\n"+
+			"\t\t\tcube[i][j] = make([]int, cols)\n"+
+			"\t\t}\n"+
+			"\t}\n",
+		"\t\tcube[i] = make([][]int, rows)\n"+
+			"\t\tfor j := range cube[i] {\n"+
+			"\t\t\tcube[i][j] = make([]int, cols)\n"+
+			"\t\t}\n",
+		"\t\t\t\t\t\tfor n := range deep[i][j][k][l][m] {\n"+
+			"\t\t\t\t\t\t\tdeep[i][j][k][l][m][n] = make([][]int, 7)\n"+
+			"\t\t\t\t\t\t\tfor i2 := range deep[i][j][k][l][m][n] {\n",
+		"\tnames := make([][]string, rows)\n",
+		"\tragged := [][]int{{1}, {2, 3}}\n",
+		"\treturn func() [][]int {\n"+
+			"\t\tarr := make([][]int, rows)\n"+
+			"\t\tfor i := range arr {\n"+
+			"\t\t\tarr[i] = make([]int, cols)\n"+
+			"\t\t}\n"+
+			"\t\treturn arr\n"+
+			"\t}()\n")
+}
+
+func Test_ByteBuffer(t *testing.T) {
+	src := "public class Packet {\n" +
+		" private ByteBuffer buf = ByteBuffer.allocate(16);\n" +
+		" int decode(byte[] data, long t) {\n" +
+		"  ByteBuffer bb = ByteBuffer.wrap(data);\n" +
+		"  bb.order(ByteOrder.LITTLE_ENDIAN);\n" +
+		"  int size = bb.getInt();\n" +
+		"  short s = bb.getShort(4);\n" +
+		"  bb.position(bb.position() + 2);\n" +
+		"  buf.clear();\n" +
+		"  buf.putInt(size).putLong(size);\n" +
+		"  buf.flip();\n" +
+		"  buf.get(data, 0, buf.remaining());\n" +
+		"  return size;\n" +
+		" }\n" +
+		"}\n"
+
+	assertContains(t, translate(t, src),
+		"import \"encoding/binary\"\n",
+		"\trcvr.buf = NewByteBuffer(make([]byte, 16))\n",
+		"\tbb := NewByteBuffer(data)\n",
+		"\tbb.SetOrder(binary.LittleEndian)\n",
+		"\tsize := bb.GetInt()\n",
+		"\ts := bb.GetShortAt(4)\n",
+		"\tbb.SetPosition(bb.Position() + 2)\n",
+		"\trcvr.buf.Clear()\n",
+		"\trcvr.buf.PutInt(size).PutLong(int64(size))\n",
+		"\trcvr.buf.Flip()\n",
+		"\trcvr.buf.GetBytes(data[:rcvr.buf.Remaining()])\n",
+		"\ntype ByteBuffer struct {\n",
+		"func (bb *ByteBuffer) GetInt() int {\n")
+}
+
+func Test_HelperFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	cfg := &Config{}
+	cfg.setCharModel(charModelByte)
+
+	for _, name := range []string{"First", "Second"} {
+		src := "public class " + name + " {\n" +
+			" char get(byte[] data) {\n" +
+			"  return ByteBuffer.wrap(data).getChar();\n" +
+			" }\n" +
+			"}\n"
+
+		pgm := translateProgram(t, name+".go", src, cfg, false)
+		if err := pgm.Write(dir); err != nil {
+			t.Fatalf("Cannot write %s: %v", name, err)
+		}
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := make([]string, len(files))
+	for i, f := range files {
+		names[i] = f.Name()
+	}
+	testutil.AssertEqual(t, strings.Join(names, ","),
+		"First.go,Second.go,java2go_bytebuffer.go", "Unexpected files", names)
+
+	for _, name := range []string{"First.go", "Second.go"} {
+		data, err := os.ReadFile(dir + "/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(data), "type ByteBuffer") ||
+			strings.Contains(string(data), "import \"math\"") {
+			t.Fatalf("Helper type written to %s:\n%s", name, data)
+		}
+	}
+
+	data, err := os.ReadFile(dir + "/java2go_bytebuffer.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertContains(t, string(data),
+		"package main\n\nimport \"encoding/binary\"\nimport \"math\"\n",
+		"func (bb *ByteBuffer) GetChar() byte {\n")
+}
+
+func Test_Streams(t *testing.T) {
+	src := "public class Files {\n" +
+		" static int count(String path) throws IOException {\n" +
+		"  BufferedReader in = new BufferedReader(new FileReader(path));\n" +
+		"  int n = 0;\n" +
+		"  String line;\n" +
+		"  while ((line = in.readLine()) != null) {\n" +
+		"   n++;\n" +
+		"  }\n" +
+		"  in.close();\n" +
+		"  return n;\n" +
+		" }\n" +
+		" static void copy(String src, String dst) throws IOException {\n" +
+		"  DataInputStream in = new DataInputStream(new FileInputStream(src));\n" +
+		"  OutputStream out = new FileOutputStream(dst);\n" +
+		"  int magic = in.readInt();\n" +
+		"  byte[] buf = new byte[512];\n" +
+		"  int n;\n" +
+		"  while ((n = in.read(buf)) > 0) {\n" +
+		"   out.write(buf, 0, n);\n" +
+		"  }\n" +
+		"  out.close();\n" +
+		"  in.close();\n" +
+		" }\n" +
+		"}\n"
+
+	assertContains(t, translate(t, src),
+		"\tinFile, err := os.Open(path)\n"+
+			"\tif err != nil {\n"+
+			"\t\tthrow(err)\n"+
+			"\t}\n"+
+			"\tdefer inFile.Close()\n"+
+			"\tin := bufio.NewScanner(inFile)\n",
+		"\tfor in.Scan() {\n"+
+			"\t\tline = in.Text()\n"+
+			"\t\tn++\n"+
+			"\t}\n"+
+			"\tif err := in.Err(); err != nil {\n"+
+			"\t\tthrow(err)\n"+
+			"\t}\n"+
+			"\treturn n\n",
+		"\tin := bufio.NewReader(inFile)\n",
+		"\toutFile, err := os.Create(dst)\n",
+		"\tmagic := func() int {\n"+
+			"\t\tvar v int32\n"+
+			"\t\tif err := binary.Read(in, binary.BigEndian, &v); err != nil {\n"+
+			"\t\t\tthrow(err)\n"+
+			"\t\t}\n"+
+			"\t\treturn int(v)\n"+
+			"\t}()\n",
+		"\t\tif n <= 0 {\n\t\t\tbreak\n\t\t}\n",
+		"\t\tif _, err := out.Write(buf[:n]); err != nil {\n",
+		"\tif err := out.Flush(); err != nil {\n"+
+			"\t\tthrow(err)\n"+
+			"\t}\n"+
+			"}\n")
+}
+
+func Test_ReadLine(t *testing.T) {
+	src := "public class Lines {\n" +
+		" static int count(String path) throws IOException {\n" +
+		"  BufferedReader in = new BufferedReader(new FileReader(path));\n" +
+		"  int n = 0;\n" +
+		"  while (in.readLine() != null) n++;\n" +
+		"  in.close();\n" +
+		"  return n;\n" +
+		" }\n" +
+		" static String first(String path) throws IOException {\n" +
+		"  BufferedReader in = new BufferedReader(new FileReader(path));\n" +
+		"  String l = in.readLine();\n" +
+		"  if (l == null) {\n" +
+		"   l = \"\";\n" +
+		"  }\n" +
+		"  in.close();\n" +
+		"  return l;\n" +
+		" }\n" +
+		" static String last(String path) throws IOException {\n" +
+		"  BufferedReader in = new BufferedReader(new FileReader(path));\n" +
+		"  String l = in.readLine();\n" +
+		"  String prev = null;\n" +
+		"  while (l != null) {\n" +
+		"   prev = l;\n" +
+		"   l = in.readLine();\n" +
+		"  }\n" +
+		"  in.close();\n" +
+		"  return prev;\n" +
+		" }\n" +
+		"}\n"
+
+	assertContains(t, translate(t, src),
+		"\tfor func() bool {\n"+
+			"\t\tif in.Scan() {\n"+
+			"\t\t\treturn true\n"+
+			"\t\t}\n"+
+			"\t\tif err := in.Err(); err != nil {\n"+
+			"\t\t\tthrow(err)\n"+
+			"\t\t}\n"+
+			"\t\treturn false\n"+
+			"\t}() {\n"+
+			"\t\tn++\n"+
+			"\t}\n",
+		"\tif !lOK {\n"+
+			"\t\tl, lOK = \"\", true\n"+
+			"\t}\n",
+		"\tl, lOK := func() (string, bool) {\n"+
+			"\t\tif in.Scan() {\n"+
+			"\t\t\treturn in.Text(), true\n"+
+			"\t\t}\n"+
+			"\t\tif err := in.Err(); err != nil {\n"+
+			"\t\t\tthrow(err)\n"+
+			"\t\t}\n"+
+			"\t\treturn \"\", false\n"+
+			"\t}()\n",
+		"\tfor lOK {\n"+
+			"\t\tprev = l\n"+
+			"\t\tl, lOK = func() (string, bool) {\n")
+}
+
+func Test_TryWithResources(t *testing.T) {
+	src := "public class Lines {\n" +
+		" static int count(String path) {\n" +
+		"  int n = 0;\n" +
+		"  try (BufferedReader in = new BufferedReader(new FileReader(path));\n" +
+		"       PrintWriter out = new PrintWriter(new FileWriter(path + \".out\"))) {\n" +
+		"   String line;\n" +
+		"   while ((line = in.readLine()) != null) {\n" +
+		"    out.println(line);\n" +
+		"    n++;\n" +
+		"   }\n" +
+		"  } catch (IOException ex) {\n" +
+		"   n = -1;\n" +
+		"  }\n" +
+		"  return n;\n" +
+		" }\n" +
+		"}\n"
+
+	assertContains(t, translate(t, src),
+		"\t\tif err := func() (err error) {\n"+
+			"\t\t\tinFile, err := os.Open(path)\n",
+		"\t\t\tin := bufio.NewScanner(inFile)\n"+
+			"\t\t\tdefer func() {\n"+
+			"\t\t\t\terr = errors.Join(err, inFile.Close())\n"+
+			"\t\t\t}()\n",
+		"\t\t\t\terr = errors.Join(err, out.Flush(), outFile.Close())\n",
+		"\t\t\treturn nil\n"+
+			"\t\t}(); err != nil {\n"+
+			"\t\t\tthrow(err)\n"+
+			"\t\t}\n"+
+			"\t} else if catch_IOException(ex) {\n")
+
+	src = "public class Res implements AutoCloseable {\n" +
+		" public void close() {}\n" +
+		" static String first(String path) throws IOException {\n" +
+		"  try (BufferedReader in = new BufferedReader(new FileReader(path))) {\n" +
+		"   return in.readLine();\n" +
+		"  }\n" +
+		" }\n" +
+		" static int find(String key) {\n" +
+		"  try (Res r1 = new Res(); Res r2 = new Res()) {\n" +
+		"   if (key.isEmpty()) {\n" +
+		"    return -1;\n" +
+		"   }\n" +
+		"  }\n" +
+		"  return 0;\n" +
+		" }\n" +
+		"}\n"
+
+	assertContains(t, translate(t, src),
+		"\tvar rval string\n"+
+			"\tif err := func() (err error) {\n",
+		"\t\trval = func() string {\n",
+		"\t\t\treturn in.Text()\n"+
+			"\t\t}()\n"+
+			"\t\treturn\n"+
+			"\t}(); err != nil {\n"+
+			"\t\tthrow(err)\n"+
+			"\t}\n"+
+			"\treturn rval\n"+
+			"}\n",
+		"\tvar rval int\n"+
+			"\tvar returned bool\n"+
+			"\tfunc() {\n"+
+			"\t\tr1 := NewRes()\n"+
+			"\t\tdefer r1.Close()\n"+
+			"\t\tr2 := NewRes()\n"+
+			"\t\tdefer r2.Close()\n",
+		"\t\t\trval = -1\n"+
+			"\t\t\treturned = true\n"+
+			"\t\t\treturn\n",
+		"\t}()\n"+
+			"\tif returned {\n"+
+			"\t\treturn rval\n"+
+			"\t}\n"+
+			"\treturn 0\n")
+}
+
+func Test_Regexp(t *testing.T) {
+	src := "public class Words {\n" +
+		" static int sum(String text) {\n" +
+		"  Pattern p = Pattern.compile(\"(\\\\w+)=(\\\\d+)\", Pattern.CASE_INSENSITIVE);\n" +
+		"  Matcher m = p.matcher(text);\n" +
+		"  int n = 0;\n" +
+		"  while (m.find()) {\n" +
+		"   String key = m.group(1);\n" +
+		"   n += m.end() - m.start(2);\n" +
+		"  }\n" +
+		"  return n;\n" +
+		" }\n" +
+		" static String[] fields(String line) {\n" +
+		"  if (line.matches(\"\\\\d+\")) {\n" +
+		"   return null;\n" +
+		"  }\n" +
+		"  line = line.replaceAll(\"(\\\\w+)@(\\\\w+)\", \"$2 at $1\");\n" +
+		"  return line.split(\",\");\n" +
+		" }\n" +
+		" static String[] pair(String line) {\n" +
+		"  return line.split(\"=\", 2);\n" +
+		" }\n" +
+		" static boolean doubled(String word) {\n" +
+		"  return word.matches(\"(\\\\w)\\\\1\");\n" +
+		" }\n" +
+		" static Pattern prefix() {\n" +
+		"  return Pattern.compile(\"foo(?=bar)\");\n" +
+		" }\n" +
+		"}\n"
+
+	out, logged := translateLog(t, src, false)
+	assertContains(t, logged,
+		"//ERR// Words: String.matches() pattern \"(\\\\w)\\\\1\""+
+			" is not supported by Go: backreference \\1\n",
+		"//ERR// Words: Pattern.compile() pattern \"foo(?=bar)\""+
+			" is not supported by Go: lookaround (?=\n")
+	assertContains(t, out,
+		"\tp := regexp.MustCompile(\"(?i)(\\\\w+)=(\\\\d+)\")\n"+
+			"\tm := NewMatcher(p, text)\n",
+		"\tfor m.Find() {\n"+
+			"\t\tkey := m.Group(1)\n"+
+			"\t\tn += m.End(0) - m.Start(2)\n",
+		"\tif regexp.MustCompile(\"^(?:\\\\d+)$\").MatchString(line) {\n",
+		"\tline = regexp.MustCompile(\"(\\\\w+)@(\\\\w+)\")."+
+			"ReplaceAllString(line, \"${2} at ${1}\")\n",
+		"\treturn SplitPattern(regexp.MustCompile(\",\"), line, 0)\n",
+		"\treturn regexp.MustCompile(\"=\").Split(line, 2)\n",
+		"func SplitPattern(re *regexp.Regexp, s string, limit int) []string {\n",
+		"func (m *Matcher) Find() bool {\n",
+		"type Pattern = regexp.Regexp\n")
+}
+
+func Test_BigNumbers(t *testing.T) {
+	src := "public class Money {\n" +
+		" static BigInteger factorial(int n) {\n" +
+		"  BigInteger result = BigInteger.ONE;\n" +
+		"  for (int i = 2; i <= n; i++) {\n" +
+		"   result = result.multiply(BigInteger.valueOf(i));\n" +
+		"  }\n" +
+		"  return result.mod(new BigInteger(\"1000000007\"));\n" +
+		" }\n" +
+		" static boolean big(BigInteger v) {\n" +
+		"  return v.compareTo(BigInteger.TEN) > 0 && v.toString(16).length() > 2;\n" +
+		" }\n" +
+		" static BigDecimal total(BigDecimal a, BigDecimal b) {\n" +
+		"  return a.add(b).subtract(BigDecimal.valueOf(2));\n" +
+		" }\n" +
+		"}\n"
+
+	assertContains(t, translate(t, src),
+		"import \"math/big\"\n",
+		"func factorial(n int) (*big.Int) {\n"+
+			"\tresult := big.NewInt(1)\n",
+		"\t\tresult = new(big.Int).Mul(result, big.NewInt(int64(i)))\n",
+		"\treturn new(big.Int).Mod(result, func() *big.Int {\n"+
+			"\t\tv, ok := new(big.Int).SetString(\"1000000007\", 10)\n"+
+			"\t\tif !ok {\n"+
+			"\t\t\tpanic(\"Cannot parse BigInteger\")\n"+
+			"\t\t}\n"+
+			"\t\treturn v\n"+
+			"\t}())\n",
+		"\treturn v.Cmp(big.NewInt(10)) > 0 && ",
+		"func total(a *big.Float, b *big.Float) (*big.Float) {\n"+
+			"\treturn new(big.Float).Sub(new(big.Float).Add(a, b),"+
+			" new(big.Float).SetInt64(2))\n")
+
+	cfg := &Config{}
+	cfg.setDecimalModel(decimalModelRat)
+
+	assertContains(t, translateConfig(t, src, cfg),
+		"func total(a *big.Rat, b *big.Rat) (*big.Rat) {\n"+
+			"\treturn new(big.Rat).Sub(new(big.Rat).Add(a, b),"+
+			" new(big.Rat).SetInt64(2))\n")
+}
+
+func Test_SystemCalls(t *testing.T) {
+	src := "public class Env {\n" +
+		" public static void main(String[] args) throws Exception {\n" +
+		"  long start = System.nanoTime();\n" +
+		"  String dir = System.getProperty(\"user.dir\");\n" +
+		"  String sep = System.getProperty(\"file.separator\");\n" +
+		"  String path = System.getenv(\"PATH\");\n" +
+		"  int cpus = Runtime.getRuntime().availableProcessors();\n" +
+		"  int c = System.in.read();\n" +
+		"  if (c < 0) {\n" +
+		"   System.exit(1);\n" +
+		"  }\n" +
+		" }\n" +
+		"}\n"
+
+	assertContains(t, translate(t, src),
+		"\tstart := NanoTime()\n",
+		"\treturn time.Since(nanoStart).Nanoseconds()\n",
+		"\tdir := func() string {\n"+
+			"\t\tv, _ := os.Getwd()\n"+
+			"\t\treturn v\n"+
+			"\t}()\n",
+		"\tsep := string(os.PathSeparator)\n",
+		"\tpath := os.Getenv(\"PATH\")\n",
+		"\tcpus := runtime.NumCPU()\n",
+		"\t\tvar b [1]byte\n"+
+			"\t\t_, err := io.ReadFull(os.Stdin, b[:])\n",
+		"\t\tos.Exit(1)\n")
+}
+
+func Test_Logging(t *testing.T) {
+	src := "public class Service {\n" +
+		" private static final Logger LOG = Logger.getLogger(Service.class);\n" +
+		" void run(String name, int n) {\n" +
+		"  LOG.info(\"starting \" + name);\n" +
+		"  if (LOG.isDebugEnabled()) {\n" +
+		"   LOG.debug(n);\n" +
+		"  }\n" +
+		"  try {\n" +
+		"   work();\n" +
+		"  } catch (IOException ex) {\n" +
+		"   LOG.error(\"failed\", ex);\n" +
+		"   LOG.log(Level.WARNING, \"retrying\", ex);\n" +
+		"  }\n" +
+		" }\n" +
+		" void work() throws IOException { }\n" +
+		"}\n"
+
+	assertContains(t, translate(t, src),
+		"var lOG = slog.Default().With(\"component\", \"Service\")\n",
+		"\tlOG.Info(fmt.Sprintf(\"%v%v\", \"starting \", name))\n",
+		"\tif lOG.Enabled(context.Background(), slog.LevelDebug) {\n"+
+			"\t\tlOG.Debug(fmt.Sprint(n))\n",
+		"\t\tlOG.Error(\"failed\", \"err\", ex)\n"+
+			"\t\tlOG.Warn(\"retrying\", \"err\", ex)\n")
+}
@@ -1,10 +1,12 @@
 package parser
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"log"
 	"sort"
+	"strings"
 )
 
 type methodMapper interface {
@@ -13,6 +15,8 @@ type methodMapper interface {
 
 type methodMap struct {
 	methods map[string][]GoMethod
+	// true after overloaded methods have been renamed
+	named bool
 }
 
 func (mmap *methodMap) AddMethod(newmthd GoMethod, mapper methodMapper) {
@@ -30,19 +34,293 @@ func (mmap *methodMap) AddMethod(newmthd GoMethod, mapper methodMapper) {
 	}
 }
 
+// return the method whose parameters best match the arguments
 func (mmap *methodMap) FindMethod(name string,
 	args *GoMethodArguments) GoMethod {
-	for key, mlist := range mmap.methods {
-		if key == name {
-			for _, m := range mlist {
-				if m.HasArguments(args) {
-					return m
+	mlist := mmap.MethodList(name)
+	if best, _ := resolveOverload(mlist, args); best != nil {
+		return best
+	}
+
+	// reuse references with identical arguments
+	for _, m := range mlist {
+		if _, _, ok := methodParams(m); !ok && m.HasArguments(args) {
+			return m
+		}
+	}
+
+	return nil
+}
+
+// phases of overload resolution, tried in order until one finds an
+// applicable method
+const (
+	// identity and widening conversions
+	phaseStrict = iota
+	// also allow boxing and unboxing
+	phaseLoose
+	// also allow variable arity calls
+	phaseVarargs
+)
+
+// return a method's parameters and whether the last one is varargs,
+// or false if the method's parameters are not known
+func methodParams(mthd GoMethod) ([]GoVar, bool, bool) {
+	switch m := mthd.(type) {
+	case *GoClassMethod:
+		return m.params, m.is_varargs, true
+	case *GoIfaceMethod:
+		return m.param_list, false, true
+	case *GoMethodReference:
+		if m.ref != nil {
+			return methodParams(m.ref)
+		}
+	}
+
+	return nil, false, false
+}
+
+// return the type of parameter 'idx', expanding a varargs parameter into
+// its element type
+func paramType(params []GoVar, expand bool, idx int) *TypeData {
+	last := len(params) - 1
+	if expand && idx >= last {
+		return params[last].VarType().elementType()
+	}
+
+	return params[idx].VarType()
+}
+
+// return the conversions needed to pass the arguments to a method in
+// the given phase, or false if the method is not applicable
+func applicable(params []GoVar, varargs bool, args *GoMethodArguments,
+	phase int) ([]conversion, bool) {
+	nargs := 0
+	if args != nil {
+		nargs = args.Length()
+	}
+
+	expand := phase == phaseVarargs
+	if expand {
+		if !varargs || nargs < len(params)-1 {
+			return nil, false
+		}
+	} else if nargs != len(params) {
+		return nil, false
+	}
+
+	convs := make([]conversion, nargs)
+	for i := 0; i < nargs; i++ {
+		var atype *TypeData
+		if args.args[i] != nil {
+			atype = args.args[i].VarType()
+		}
+
+		c := convertType(paramType(params, expand, i), atype)
+		if c == convNone || (c == convBoxing && phase == phaseStrict) {
+			return nil, false
+		}
+
+		convs[i] = c
+	}
+
+	return convs, true
+}
+
+// an applicable method and the conversions used to call it
+type overloadCandidate struct {
+	mthd   GoMethod
+	params []GoVar
+	convs  []conversion
+}
+
+// return true if each of the candidate's parameters can be passed to
+// the corresponding parameter of 'other'
+func (cand *overloadCandidate) moreSpecific(other *overloadCandidate,
+	expand bool) bool {
+	for i := range cand.convs {
+		c := convertType(paramType(other.params, expand, i),
+			paramType(cand.params, expand, i))
+		if c != convIdentity && c != convWidening {
+			return false
+		}
+	}
+
+	return true
+}
+
+// return true if an argument's type is unknown, so the candidate may
+// only have been chosen because any type is accepted for it
+func (cand *overloadCandidate) isGuess() bool {
+	for _, c := range cand.convs {
+		if c == convUnknown {
+			return true
+		}
+	}
+
+	return false
+}
+
+// return a score used to choose between candidates when neither is
+// more specific
+func (cand *overloadCandidate) score() int {
+	score := 0
+	for _, c := range cand.convs {
+		switch c {
+		case convIdentity:
+			score += 4
+		case convWidening:
+			score += 3
+		case convSubclass:
+			score += 2
+		case convBoxing:
+			score += 1
+		}
+	}
+
+	return score
+}
+
+// choose the method called with 'args' using Java's overload resolution
+// phases; if no method is more specific than all the others, or the
+// choice depends on arguments whose types are unknown, return the
+// closest match and report the call as ambiguous
+func resolveOverload(mlist []GoMethod, args *GoMethodArguments) (GoMethod,
+	bool) {
+	for phase := phaseStrict; phase <= phaseVarargs; phase++ {
+		cands := make([]*overloadCandidate, 0)
+		for _, m := range mlist {
+			params, varargs, ok := methodParams(m)
+			if !ok {
+				continue
+			}
+
+			if convs, ok := applicable(params, varargs, args,
+				phase); ok {
+				cands = append(cands, &overloadCandidate{mthd: m,
+					params: params, convs: convs})
+			}
+		}
+
+		if len(cands) == 0 {
+			continue
+		}
+
+		expand := phase == phaseVarargs
+
+		var best *overloadCandidate
+		for _, c := range cands {
+			specific := true
+			for _, c2 := range cands {
+				if c2 != c && !c.moreSpecific(c2, expand) {
+					specific = false
+					break
 				}
 			}
+
+			if specific {
+				if best != nil {
+					// equally specific methods
+					best = nil
+					break
+				}
+
+				best = c
+			}
+		}
+
+		if best != nil {
+			return best.mthd, len(cands) > 1 && best.isGuess()
+		}
+
+		for _, c := range cands {
+			if best == nil || c.score() > best.score() {
+				best = c
+			}
 		}
+
+		return best.mthd, true
 	}
 
-	return nil
+	// argument types may be wrong, so fall back to the number of arguments
+	nargs := 0
+	if args != nil {
+		nargs = args.Length()
+	}
+
+	var found GoMethod
+	for _, m := range mlist {
+		if params, varargs, ok := methodParams(m); ok &&
+			!varargs && len(params) == nargs {
+			if found != nil {
+				return found, true
+			}
+
+			found = m
+		}
+	}
+
+	return found, false
+}
+
+// convert arguments which Java widens to the primitive type of the
+// method's parameter, since Go doesn't convert them implicitly
+func widenArguments(mthd GoMethod, args *GoMethodArguments) {
+	params, varargs, ok := methodParams(mthd)
+	if !ok || args == nil {
+		return
+	}
+
+	expand := false
+	convs, ok := applicable(params, varargs, args, phaseLoose)
+	if !ok {
+		if convs, ok = applicable(params, varargs, args,
+			phaseVarargs); !ok {
+			return
+		}
+
+		expand = true
+	}
+
+	for i, c := range convs {
+		ptype := paramType(params, expand, i)
+		if c != convWidening || !ptype.isPrimitive() ||
+			ptype.vtype == VT_STRING {
+			continue
+		}
+
+		// untyped Go constants don't need to be converted
+		if _, ok := args.args[i].(*GoLiteral); ok {
+			continue
+		} else if uex, ok := args.args[i].(*GoUnaryExpr); ok {
+			if _, ok := uex.x.(*GoLiteral); ok {
+				continue
+			}
+		}
+
+		args.args[i] = NewGoTypeConversion(args.args[i], ptype)
+	}
+}
+
+// return true if the arguments exactly match a method's parameters
+func isExactMatch(mthd GoMethod, args *GoMethodArguments) bool {
+	params, _, ok := methodParams(mthd)
+	if !ok {
+		return false
+	}
+
+	convs, ok := applicable(params, false, args, phaseStrict)
+	if !ok {
+		return false
+	}
+
+	for _, c := range convs {
+		if c != convIdentity {
+			return false
+		}
+	}
+
+	return true
 }
 
 func (mmap *methodMap) Length() int {
@@ -59,15 +337,165 @@ func (mmap *methodMap) MethodList(name string) []GoMethod {
 	return nil
 }
 
-func (mmap *methodMap) renumberDuplicateMethods(gp *GoProgram) {
-	for _, mlist := range mmap.methods {
-		if len(mlist) > 1 {
-			n := 0
-			for _, m := range mlist {
-				if n > 0 {
-					m.SetGoName(fmt.Sprintf("%s%d", m.GoName(), n + 1))
+// return the distinct methods named 'name', ignoring unresolved
+// references
+func (mmap *methodMap) overloads(name string) []GoMethod {
+	mlist := make([]GoMethod, 0)
+	for _, m := range mmap.MethodList(name) {
+		if ref, ok := m.(*GoMethodReference); ok {
+			if ref.ref == nil {
+				continue
+			}
+
+			m = ref.ref
+		}
+
+		found := false
+		for _, m2 := range mlist {
+			if m2 == m {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			mlist = append(mlist, m)
+		}
+	}
+
+	return mlist
+}
+
+// return the parameters used to name an overloaded method
+func overloadParams(mthd GoMethod) []GoVar {
+	params := mthd.Arguments()
+	if cm, ok := mthd.(*GoClassMethod); ok && cm.method_type == mt_constructor {
+		// inner class constructors are also passed the enclosing instance
+		if cls, ok := cm.class.(*GoClassDefinition); ok && len(params) > 0 &&
+			cls.outer != nil && params[0] == cls.outerParam() {
+			params = params[1:]
+		}
+	}
+
+	return params
+}
+
+// build a name for an overloaded method from its parameter types, or
+// from its parameter names for constructors ("NewPointFromXY")
+func overloadName(mthd GoMethod, by_name bool) string {
+	params := overloadParams(mthd)
+	if len(params) == 0 {
+		return mthd.GoName()
+	}
+
+	b := &bytes.Buffer{}
+	b.WriteString(mthd.GoName())
+	if mthd.MethodType() == mt_constructor {
+		b.WriteString("From")
+	}
+
+	for _, p := range params {
+		if by_name {
+			name := p.Name()
+			b.WriteString(strings.ToUpper(name[:1]) + name[1:])
+		} else {
+			b.WriteString(typeSuffix(p.VarType()))
+		}
+	}
+
+	return b.String()
+}
+
+// return true if any name is used more than once
+func hasDuplicateNames(names []string) bool {
+	seen := make(map[string]bool)
+	for _, n := range names {
+		if seen[n] {
+			return true
+		}
+		seen[n] = true
+	}
+
+	return false
+}
+
+// return the config file key for a method, e.g. "go_pkg.Point.write(int)"
+func methodKey(gp *GoProgram, owner GoMethodOwner, mthd GoMethod) string {
+	jname := javaClassName(owner)
+
+	name := mthd.Name()
+	if mthd.MethodType() == mt_constructor {
+		name = jname
+	}
+
+	types := make([]string, 0)
+	for _, p := range overloadParams(mthd) {
+		types = append(types, javaTypeName(p.VarType()))
+	}
+
+	key := jname + "." + name + "(" + strings.Join(types, ",") + ")"
+	if gp.pkgname != "" {
+		key = gp.pkgname + "." + key
+	}
+
+	return key
+}
+
+// rename overloaded methods using their parameters instead of numbering
+// them, and apply any method names from the config file;
+// 'inherited' returns true if a method name is overloaded elsewhere in
+// the class hierarchy
+func (mmap *methodMap) renameOverloads(gp *GoProgram, owner GoMethodOwner,
+	inherited func(name string) bool) {
+	if mmap.named {
+		return
+	}
+	mmap.named = true
+
+	keys := mmap.SortedKeys()
+
+	for _, key := range keys {
+		mlist := mmap.overloads(key)
+		if len(mlist) == 0 || (len(mlist) == 1 && !inherited(key)) {
+			continue
+		}
+
+		// names used by other methods
+		used := make(map[string]bool)
+		for _, k2 := range keys {
+			if k2 != key {
+				for _, m := range mmap.overloads(k2) {
+					used[m.GoName()] = true
 				}
-				n += 1
+			}
+		}
+
+		names := make([]string, len(mlist))
+		for i, m := range mlist {
+			names[i] = overloadName(m, m.MethodType() == mt_constructor)
+		}
+		if hasDuplicateNames(names) {
+			for i, m := range mlist {
+				names[i] = overloadName(m, false)
+			}
+		}
+
+		for i, m := range mlist {
+			name := names[i]
+			for n := 2; used[name]; n++ {
+				name = fmt.Sprintf("%s%d", names[i], n)
+			}
+			used[name] = true
+
+			m.SetGoName(name)
+		}
+	}
+
+	for _, key := range keys {
+		for _, m := range mmap.overloads(key) {
+			if name := gp.config.methodName(methodKey(gp, owner,
+				m)); name != "" {
+				m.SetGoName(name)
 			}
 		}
 	}
@@ -111,27 +539,34 @@ func (mmap *methodMap) WriteString(out io.Writer, verbose bool) {
 
 type classMethodMap struct {
 	methodMap
+	// references replaced by class methods
+	refs []*GoMethodReference
 }
 
 // create a map of class names to objects
 func NewClassMethodMap() *classMethodMap {
-	return &classMethodMap{methodMap{}}
+	return &classMethodMap{methodMap: methodMap{}}
 }
 
 func (cmm *classMethodMap) FixDuplicate(mlist []GoMethod, newmthd GoMethod) bool {
 	fixed := false
 	if gm, is_gm := newmthd.(*GoClassMethod); !is_gm {
-		if _, is_ref := newmthd.(*GoMethodReference); !is_ref {
+		if ref, is_ref := newmthd.(*GoMethodReference); !is_ref {
 			log.Printf("//ERR// Unknown class method type %T\n", newmthd)
+		} else {
+			// bind the reference after all overloads have been added
+			cmm.refs = append(cmm.refs, ref)
 		}
 		fixed = true
 	} else {
 		for i, m2 := range mlist {
 			if m2.IsMethod(newmthd) {
-				if _, m2ok := m2.(*GoMethodReference); m2ok {
+				if ref, m2ok := m2.(*GoMethodReference); m2ok {
 					m2.SetOriginal(gm)
 					mlist[i] = gm
 					fixed = true
+
+					cmm.refs = append(cmm.refs, ref)
 				}
 			}
 		}
@@ -139,6 +574,121 @@ func (cmm *classMethodMap) FixDuplicate(mlist []GoMethod, newmthd GoMethod) bool
 	return fixed
 }
 
+// return the overload which best matches the arguments; unless it is
+// an exact match, return a reference so resolveReferences() can choose
+// again after all the overloads have been added
+func (cmm *classMethodMap) FindMethod(name string,
+	args *GoMethodArguments) GoMethod {
+	mthd := cmm.methodMap.FindMethod(name, args)
+
+	cm, ok := mthd.(*GoClassMethod)
+	if !ok || cmm.named || isExactMatch(cm, args) {
+		return mthd
+	}
+
+	ref := &GoMethodReference{class: cm.class, name: name, goname: name,
+		args: args, ref: cm, cnt: refcnt}
+	refcnt++
+
+	cmm.refs = append(cmm.refs, ref)
+
+	return ref
+}
+
+// point each reference at the overload which best matches its
+// arguments, then drop duplicate entries left behind by the references
+func (cmm *classMethodMap) resolveReferences(gp *GoProgram,
+	owner GoMethodOwner) {
+	// bind references which only match a varargs method
+	for _, key := range cmm.SortedKeys() {
+		for _, m := range cmm.MethodList(key) {
+			if ref, ok := m.(*GoMethodReference); ok && ref.ref == nil &&
+				ref.args != nil {
+				cmm.refs = append(cmm.refs, ref)
+			}
+		}
+	}
+
+	inherited := make(map[*GoMethodReference]bool)
+	for _, ref := range cmm.refs {
+		if ref.args == nil {
+			continue
+		}
+
+		mlist := cmm.overloads(ref.name)
+		if len(mlist) == 0 {
+			// unqualified call to a superclass method
+			if mlist = inheritedOverloads(owner, ref.name); len(mlist) > 0 {
+				inherited[ref] = true
+			}
+		}
+
+		mthd, ambiguous := resolveOverload(mlist, ref.args)
+		if cm, ok := mthd.(*GoClassMethod); ok {
+			ref.ref = cm
+			widenArguments(cm, ref.args)
+		}
+
+		if ambiguous {
+			if gp.verbose {
+				types := make([]string, ref.args.Length())
+				for i, arg := range ref.args.args {
+					types[i] = javaTypeName(arg.VarType())
+				}
+
+				log.Printf("//ERR// Ambiguous call to %s.%s(%s)\n",
+					javaClassName(owner), ref.name, strings.Join(types, ", "))
+			} else {
+				log.Printf("//ERR// Ambiguous method call\n")
+			}
+		}
+	}
+
+	// inherited methods are declared by the superclass
+	for key, mlist := range cmm.methods {
+		local := make([]GoMethod, 0, len(mlist))
+		for _, m := range mlist {
+			if ref, ok := m.(*GoMethodReference); !ok || !inherited[ref] {
+				local = append(local, m)
+			}
+		}
+
+		if len(local) == 0 {
+			delete(cmm.methods, key)
+		} else {
+			cmm.methods[key] = local
+		}
+	}
+
+	for key, mlist := range cmm.methods {
+		distinct := cmm.overloads(key)
+		for _, m := range mlist {
+			if ref, ok := m.(*GoMethodReference); ok && ref.ref == nil {
+				distinct = append(distinct, m)
+			}
+		}
+		cmm.methods[key] = distinct
+	}
+}
+
+// return the overloads of 'name' in the closest superclass which
+// defines any
+func inheritedOverloads(owner GoMethodOwner, name string) []GoMethod {
+	cls, ok := owner.(*GoClassDefinition)
+	if !ok {
+		return nil
+	}
+
+	c, ok := cls.super.(*GoClassDefinition)
+	for ; ok; c, ok = c.super.(*GoClassDefinition) {
+		if mlist := c.methods.overloads(name); len(mlist) > 0 {
+			return mlist
+		}
+	}
+
+	return nil
+}
+
 type interfaceMethodMap struct {
 	methodMap
 }
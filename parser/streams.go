@@ -0,0 +1,1193 @@
+package parser
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"log"
+	"strings"
+)
+
+// Go value used to read or write a java.io stream
+type streamKind int
+
+const (
+	streamScanner streamKind = iota // *bufio.Scanner reading lines
+	streamReader                    // *bufio.Reader reading bytes
+	streamWriter                    // *bufio.Writer
+)
+
+// java.io classes which read lines, read bytes, or write, indexed by name
+var javaStreamKinds = map[string]streamKind{
+	"BufferedReader":       streamScanner,
+	"FileReader":           streamReader,
+	"InputStreamReader":    streamReader,
+	"FileInputStream":      streamReader,
+	"BufferedInputStream":  streamReader,
+	"DataInputStream":      streamReader,
+	"FileWriter":           streamWriter,
+	"BufferedWriter":       streamWriter,
+	"OutputStreamWriter":   streamWriter,
+	"PrintWriter":          streamWriter,
+	"FileOutputStream":     streamWriter,
+	"BufferedOutputStream": streamWriter,
+	"DataOutputStream":     streamWriter,
+	"PrintStream":          streamWriter,
+}
+
+// values read by DataInputStream.readXXX() and written by
+// DataOutputStream.writeXXX(), with the Go type used by encoding/binary
+// and the translated Java type
+var javaStreamValues = map[string]struct {
+	wire  string
+	vtype *TypeData
+}{
+	"Boolean":       {"bool", boolType},
+	"Byte":          {"uint8", byteType},
+	"UnsignedByte":  {"uint8", intType},
+	"Char":          {"uint16", charType},
+	"Short":         {"int16", shortType},
+	"UnsignedShort": {"uint16", intType},
+	"Int":           {"int32", intType},
+	"Long":          {"int64", longType},
+	"Float":         {"float32", floatType},
+	"Double":        {"float64", doubleType},
+}
+
+// local variable holding a java.io stream
+type streamVar struct {
+	govar GoVar
+	kind  streamKind
+	// name of the outermost Java class
+	class string
+	// call which opens the file, or nil for a standard stream
+	open GoExpr
+	// Go name of the opened file or standard stream
+	file string
+	// true if the file is closed by a deferred Close()
+	deferred bool
+	// true if the standard stream is read directly instead of through a
+	// bufio value
+	unbuffered bool
+}
+
+// return "throw(err)", which is how thrown exceptions are translated
+func throwStmt(err ast.Expr) ast.Stmt {
+	return &ast.ExprStmt{X: &ast.CallExpr{Fun: ast.NewIdent("throw"),
+		Args: []ast.Expr{err}}}
+}
+
+// return "if init; err != nil { throw(err) }"
+func checkErr(init ast.Stmt) ast.Stmt {
+	err := ast.NewIdent("err")
+
+	return &ast.IfStmt{Init: init, Cond: &ast.BinaryExpr{X: err,
+		Op: token.NEQ, Y: ast.NewIdent("nil")},
+		Body: &ast.BlockStmt{List: []ast.Stmt{throwStmt(err)}}}
+}
+
+// declares local variable 'name' of type 'vtype'
+func varDecl(name string, vtype ast.Expr) ast.Stmt {
+	return &ast.DeclStmt{Decl: &ast.GenDecl{Tok: token.VAR,
+		Specs: []ast.Spec{&ast.ValueSpec{
+			Names: []*ast.Ident{ast.NewIdent(name)}, Type: vtype}}}}
+}
+
+// opens the file underneath a stream and wraps it in a 'bufio' value
+type GoStreamOpen struct {
+	stream *streamVar
+}
+
+func (gso *GoStreamOpen) hasVariable(govar GoVar) bool {
+	return gso.stream.govar.Equals(govar) ||
+		(gso.stream.open != nil && gso.stream.open.hasVariable(govar))
+}
+
+func (gso *GoStreamOpen) RunTransform(xform TransformFunc, prog *GoProgram,
+	cls GoClass, parent GoObject) (GoObject, bool) {
+	if gso.stream.open != nil {
+		obj, is_nil := gso.stream.open.RunTransform(xform, prog, cls, gso)
+		if !is_nil {
+			var err error
+			if gso.stream.open, err = convertToExpr(obj); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	return xform(parent, prog, cls, gso)
+}
+
+func (gso *GoStreamOpen) Stmts() []ast.Stmt {
+	sv := gso.stream
+
+	var stmts []ast.Stmt
+	var file ast.Expr
+	if sv.open == nil {
+		file = &ast.SelectorExpr{X: ast.NewIdent("os"),
+			Sel: ast.NewIdent(sv.file)}
+	} else {
+		file = ast.NewIdent(sv.file)
+		err := ast.NewIdent("err")
+
+		stmts = append(stmts,
+			&ast.AssignStmt{Lhs: []ast.Expr{file, err}, Tok: token.DEFINE,
+				Rhs: []ast.Expr{sv.open.Expr()}},
+			checkErr(nil))
+		if sv.deferred {
+			stmts = append(stmts, &ast.DeferStmt{Call: &ast.CallExpr{
+				Fun: &ast.SelectorExpr{X: file,
+					Sel: ast.NewIdent("Close")}}})
+		}
+	}
+
+	var wrap string
+	switch sv.kind {
+	case streamScanner:
+		wrap = "NewScanner"
+	case streamReader:
+		wrap = "NewReader"
+	default:
+		wrap = "NewWriter"
+	}
+
+	call := &ast.CallExpr{Fun: &ast.SelectorExpr{X: ast.NewIdent("bufio"),
+		Sel: ast.NewIdent(wrap)}, Args: []ast.Expr{file}}
+
+	return append(stmts, &ast.AssignStmt{
+		Lhs: []ast.Expr{ast.NewIdent(sv.govar.Name())}, Tok: token.DEFINE,
+		Rhs: []ast.Expr{call}})
+}
+
+// return the call which releases a stream opened by a try-with-resources
+// statement, flushing writers before their file is closed
+func (gso *GoStreamOpen) closeCall() (*ast.CallExpr, bool) {
+	sv := gso.stream
+
+	var flush, close *ast.CallExpr
+	if sv.kind == streamWriter {
+		flush = &ast.CallExpr{Fun: &ast.SelectorExpr{X: sv.govar.Expr(),
+			Sel: ast.NewIdent("Flush")}}
+	}
+	if sv.open != nil {
+		close = &ast.CallExpr{Fun: &ast.SelectorExpr{
+			X: ast.NewIdent(sv.file), Sel: ast.NewIdent("Close")}}
+	}
+
+	if flush == nil {
+		return close, close != nil
+	} else if close == nil {
+		return flush, true
+	}
+
+	return &ast.CallExpr{Fun: &ast.SelectorExpr{X: ast.NewIdent("errors"),
+		Sel: ast.NewIdent("Join")}, Args: []ast.Expr{flush, close}}, true
+}
+
+func (gso *GoStreamOpen) String() string {
+	return fmt.Sprintf("GoStreamOpen[%v|%v|%v]", gso.stream.govar,
+		gso.stream.class, gso.stream.open)
+}
+
+// call which returns an error (preceded by 'values' other results) and
+// throws it if it is not nil
+type GoStreamCall struct {
+	call   GoExpr
+	values int
+}
+
+func (gsc *GoStreamCall) hasVariable(govar GoVar) bool {
+	return gsc.call.hasVariable(govar)
+}
+
+func (gsc *GoStreamCall) RunTransform(xform TransformFunc, prog *GoProgram,
+	cls GoClass, parent GoObject) (GoObject, bool) {
+	obj, is_nil := gsc.call.RunTransform(xform, prog, cls, gsc)
+	if !is_nil {
+		var err error
+		if gsc.call, err = convertToExpr(obj); err != nil {
+			panic(err)
+		}
+	}
+
+	return xform(parent, prog, cls, gsc)
+}
+
+func (gsc *GoStreamCall) Stmts() []ast.Stmt {
+	var lhs []ast.Expr
+	for i := 0; i < gsc.values; i++ {
+		lhs = append(lhs, ast.NewIdent("_"))
+	}
+	lhs = append(lhs, ast.NewIdent("err"))
+
+	return []ast.Stmt{checkErr(&ast.AssignStmt{Lhs: lhs, Tok: token.DEFINE,
+		Rhs: []ast.Expr{gsc.call.Expr()}})}
+}
+
+func (gsc *GoStreamCall) String() string {
+	return fmt.Sprintf("GoStreamCall[%v|%d]", gsc.call, gsc.values)
+}
+
+// close() flushes a writer and closes a file which is not closed by a
+// deferred Close()
+type GoStreamClose struct {
+	stream *streamVar
+}
+
+func (gsc *GoStreamClose) hasVariable(govar GoVar) bool {
+	return gsc.stream.govar.Equals(govar)
+}
+
+func (gsc *GoStreamClose) RunTransform(xform TransformFunc,
+	prog *GoProgram, cls GoClass, parent GoObject) (GoObject, bool) {
+	return xform(parent, prog, cls, gsc)
+}
+
+func (gsc *GoStreamClose) Stmts() []ast.Stmt {
+	var stmts []ast.Stmt
+	if gsc.stream.kind == streamWriter {
+		stmts = append(stmts, checkErr(&ast.AssignStmt{
+			Lhs: []ast.Expr{ast.NewIdent("err")}, Tok: token.DEFINE,
+			Rhs: []ast.Expr{&ast.CallExpr{Fun: &ast.SelectorExpr{
+				X:   gsc.stream.govar.Expr(),
+				Sel: ast.NewIdent("Flush")}}}}))
+	}
+
+	if gsc.stream.open != nil && !gsc.stream.deferred {
+		stmts = append(stmts, checkErr(&ast.AssignStmt{
+			Lhs: []ast.Expr{ast.NewIdent("err")}, Tok: token.DEFINE,
+			Rhs: []ast.Expr{&ast.CallExpr{Fun: &ast.SelectorExpr{
+				X:   ast.NewIdent(gsc.stream.file),
+				Sel: ast.NewIdent("Close")}}}}))
+	}
+
+	return stmts
+}
+
+func (gsc *GoStreamClose) String() string {
+	return fmt.Sprintf("GoStreamClose[%v]", gsc.stream.govar)
+}
+
+// value read from a stream, which returns Java's result at the end of
+// the stream (-1 for bytes) and throws any other error
+type GoStreamRead struct {
+	stream *streamVar
+	method string
+	buf    GoExpr
+	// Go strings cannot be null, so readLine() can also return whether a
+	// line was read ('found'), or return only that ('discard')
+	found   bool
+	discard bool
+}
+
+func (gsr *GoStreamRead) Expr() ast.Expr {
+	rdr := gsr.stream.govar.Expr()
+	err := ast.NewIdent("err")
+
+	switch gsr.method {
+	case "readLine":
+		// Go strings cannot be null, so this returns "" at the end of the
+		// stream unless the caller checks for the end of the stream
+		// (GoScanLoop handles the usual readLine() loop)
+		scan := &ast.CallExpr{Fun: &ast.SelectorExpr{X: rdr,
+			Sel: ast.NewIdent("Scan")}}
+		errcall := &ast.CallExpr{Fun: &ast.SelectorExpr{X: rdr,
+			Sel: ast.NewIdent("Err")}}
+		text := &ast.CallExpr{Fun: &ast.SelectorExpr{X: rdr,
+			Sel: ast.NewIdent("Text")}}
+
+		if gsr.found || gsr.discard {
+			line := []ast.Expr{ast.NewIdent("true")}
+			end := []ast.Expr{ast.NewIdent("false")}
+			results := &ast.FieldList{List: []*ast.Field{
+				&ast.Field{Type: ast.NewIdent("bool")}}}
+			if !gsr.discard {
+				line = append([]ast.Expr{text}, line...)
+				end = append([]ast.Expr{&ast.BasicLit{Kind: token.STRING,
+					Value: "\"\""}}, end...)
+				results.List = append([]*ast.Field{
+					&ast.Field{Type: ast.NewIdent("string")}},
+					results.List...)
+			}
+
+			return &ast.CallExpr{Fun: &ast.FuncLit{Type: &ast.FuncType{
+				Params: &ast.FieldList{}, Results: results},
+				Body: &ast.BlockStmt{List: []ast.Stmt{
+					&ast.IfStmt{Cond: scan, Body: &ast.BlockStmt{
+						List: []ast.Stmt{&ast.ReturnStmt{Results: line}}}},
+					checkErr(&ast.AssignStmt{Lhs: []ast.Expr{err},
+						Tok: token.DEFINE, Rhs: []ast.Expr{errcall}}),
+					&ast.ReturnStmt{Results: end}}}}}
+		}
+
+		return callFuncLit(ast.NewIdent("string"),
+			&ast.IfStmt{Cond: &ast.BinaryExpr{
+				X: &ast.UnaryExpr{Op: token.NOT, X: scan}, Op: token.LAND,
+				Y: &ast.BinaryExpr{X: errcall, Op: token.NEQ,
+					Y: ast.NewIdent("nil")}},
+				Body: &ast.BlockStmt{List: []ast.Stmt{throwStmt(errcall)}}},
+			&ast.ReturnStmt{Results: []ast.Expr{text}})
+	case "read":
+		// Java returns -1 at the end of the stream
+		val := ast.NewIdent("n")
+		var call ast.Expr
+		var result ast.Expr = val
+		var stmts []ast.Stmt
+		if gsr.buf == nil && gsr.stream.unbuffered {
+			// read a single byte into an array
+			buf := ast.NewIdent("b")
+			stmts = append(stmts, &ast.DeclStmt{Decl: &ast.GenDecl{
+				Tok: token.VAR, Specs: []ast.Spec{&ast.ValueSpec{
+					Names: []*ast.Ident{buf},
+					Type: &ast.ArrayType{Len: ast.NewIdent("1"),
+						Elt: ast.NewIdent("byte")}}}}})
+			val = ast.NewIdent("_")
+			call = &ast.CallExpr{Fun: &ast.SelectorExpr{
+				X: ast.NewIdent("io"), Sel: ast.NewIdent("ReadFull")},
+				Args: []ast.Expr{rdr, &ast.SliceExpr{X: buf}}}
+			result = &ast.CallExpr{Fun: ast.NewIdent("int"),
+				Args: []ast.Expr{&ast.IndexExpr{X: buf,
+					Index: ast.NewIdent("0")}}}
+		} else if gsr.buf == nil {
+			val = ast.NewIdent("b")
+			call = &ast.CallExpr{Fun: &ast.SelectorExpr{X: rdr,
+				Sel: ast.NewIdent("ReadByte")}}
+			result = &ast.CallExpr{Fun: ast.NewIdent("int"),
+				Args: []ast.Expr{val}}
+		} else {
+			call = &ast.CallExpr{Fun: &ast.SelectorExpr{X: rdr,
+				Sel: ast.NewIdent("Read")}, Args: []ast.Expr{gsr.buf.Expr()}}
+		}
+
+		eof := &ast.SelectorExpr{X: ast.NewIdent("io"),
+			Sel: ast.NewIdent("EOF")}
+
+		stmts = append(stmts,
+			&ast.AssignStmt{Lhs: []ast.Expr{val, err}, Tok: token.DEFINE,
+				Rhs: []ast.Expr{call}},
+			&ast.IfStmt{Cond: &ast.BinaryExpr{X: err, Op: token.EQL, Y: eof},
+				Body: &ast.BlockStmt{List: []ast.Stmt{
+					&ast.ReturnStmt{Results: []ast.Expr{
+						&ast.UnaryExpr{Op: token.SUB,
+							X: ast.NewIdent("1")}}}}},
+				Else: checkErr(nil)},
+			&ast.ReturnStmt{Results: []ast.Expr{result}})
+
+		return callFuncLit(ast.NewIdent("int"), stmts...)
+	}
+
+	// DataInputStream.readXXX() reads a big-endian value
+	value := javaStreamValues[gsr.method[4:]]
+	val := ast.NewIdent("v")
+	rtype := gsr.VarType().Expr()
+
+	var result ast.Expr = val
+	if id, ok := rtype.(*ast.Ident); !ok || id.Name != value.wire {
+		result = &ast.CallExpr{Fun: rtype, Args: []ast.Expr{val}}
+	}
+
+	read := &ast.CallExpr{Fun: &ast.SelectorExpr{X: ast.NewIdent("binary"),
+		Sel: ast.NewIdent("Read")}, Args: []ast.Expr{rdr,
+		&ast.SelectorExpr{X: ast.NewIdent("binary"),
+			Sel: ast.NewIdent("BigEndian")},
+		&ast.UnaryExpr{Op: token.AND, X: val}}}
+
+	return callFuncLit(rtype,
+		&ast.DeclStmt{Decl: &ast.GenDecl{Tok: token.VAR,
+			Specs: []ast.Spec{&ast.ValueSpec{Names: []*ast.Ident{val},
+				Type: ast.NewIdent(value.wire)}}}},
+		checkErr(&ast.AssignStmt{Lhs: []ast.Expr{err}, Tok: token.DEFINE,
+			Rhs: []ast.Expr{read}}),
+		&ast.ReturnStmt{Results: []ast.Expr{result}})
+}
+
+func (gsr *GoStreamRead) hasVariable(govar GoVar) bool {
+	return gsr.stream.govar.Equals(govar) ||
+		(gsr.buf != nil && gsr.buf.hasVariable(govar))
+}
+
+func (gsr *GoStreamRead) Init() ast.Stmt {
+	return nil
+}
+
+func (gsr *GoStreamRead) RunTransform(xform TransformFunc, prog *GoProgram,
+	cls GoClass, parent GoObject) (GoObject, bool) {
+	if gsr.buf != nil {
+		obj, is_nil := gsr.buf.RunTransform(xform, prog, cls, gsr)
+		if !is_nil {
+			var err error
+			if gsr.buf, err = convertToExpr(obj); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	return xform(parent, prog, cls, gsr)
+}
+
+func (gsr *GoStreamRead) String() string {
+	return fmt.Sprintf("GoStreamRead[%v|%v|%v]", gsr.stream.govar,
+		gsr.method, gsr.buf)
+}
+
+func (gsr *GoStreamRead) VarType() *TypeData {
+	switch gsr.method {
+	case "readLine":
+		if gsr.discard {
+			return boolType
+		}
+
+		return stringType
+	case "read":
+		return intType
+	}
+
+	return javaStreamValues[gsr.method[4:]].vtype
+}
+
+// local variable which holds lines read by readLine() outside a
+// GoScanLoop, and the flag which records whether a line was read
+type lineVar struct {
+	govar GoVar
+	// true if the variable is assigned the result of readLine()
+	read bool
+	// true if the variable is compared with null
+	compared bool
+	// true if the variable is also set by a GoScanLoop
+	scanned bool
+}
+
+// return the name of the flag which is false at the end of the stream
+func (lv *lineVar) flag() string {
+	return lv.govar.GoName() + "OK"
+}
+
+// return the entry for 'govar', adding one if necessary
+func findLineVar(lines *[]*lineVar, govar GoVar) *lineVar {
+	for _, lv := range *lines {
+		if lv.govar.Equals(govar) {
+			return lv
+		}
+	}
+
+	lv := &lineVar{govar: govar}
+	*lines = append(*lines, lv)
+	return lv
+}
+
+// return true if 'expr' is a readLine() call
+func isReadLine(expr GoExpr) bool {
+	rdr, ok := expr.(*GoStreamRead)
+	return ok && rdr.method == "readLine"
+}
+
+// return the variable or readLine() call compared with null by 'bex'
+func nullCompare(bex *GoBinaryExpr) GoExpr {
+	if bex.op != token.EQL && bex.op != token.NEQ {
+		return nil
+	}
+
+	if kw, ok := bex.y.(*GoKeyword); ok && kw.name == "null" {
+		return bex.x
+	} else if kw, ok := bex.x.(*GoKeyword); ok && kw.name == "null" {
+		return bex.y
+	}
+
+	return nil
+}
+
+// declaration or assignment of a variable holding a line read by
+// readLine(), which also sets the variable's flag
+type GoReadLine struct {
+	line *lineVar
+	// nil if the variable is declared without a value
+	value GoExpr
+	tok   token.Token
+}
+
+func (grl *GoReadLine) hasVariable(govar GoVar) bool {
+	return grl.line.govar.Equals(govar) ||
+		(grl.value != nil && grl.value.hasVariable(govar))
+}
+
+func (grl *GoReadLine) RunTransform(xform TransformFunc, prog *GoProgram,
+	cls GoClass, parent GoObject) (GoObject, bool) {
+	if grl.value != nil {
+		obj, is_nil := grl.value.RunTransform(xform, prog, cls, grl)
+		if !is_nil {
+			var err error
+			if grl.value, err = convertToExpr(obj); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	return xform(parent, prog, cls, grl)
+}
+
+func (grl *GoReadLine) Stmts() []ast.Stmt {
+	if grl.value == nil {
+		return []ast.Stmt{varDecl(grl.line.govar.GoName(),
+			ast.NewIdent("string")), varDecl(grl.line.flag(),
+			ast.NewIdent("bool"))}
+	}
+
+	var rhs []ast.Expr
+	if rdr, ok := grl.value.(*GoStreamRead); ok {
+		rhs = []ast.Expr{rdr.Expr()}
+	} else if kw, ok := grl.value.(*GoKeyword); ok && kw.name == "null" {
+		rhs = []ast.Expr{&ast.BasicLit{Kind: token.STRING, Value: "\"\""},
+			ast.NewIdent("false")}
+	} else {
+		rhs = []ast.Expr{grl.value.Expr(), ast.NewIdent("true")}
+	}
+
+	return []ast.Stmt{&ast.AssignStmt{Lhs: []ast.Expr{grl.line.govar.Expr(),
+		ast.NewIdent(grl.line.flag())}, Tok: grl.tok, Rhs: rhs}}
+}
+
+func (grl *GoReadLine) String() string {
+	return fmt.Sprintf("GoReadLine[%v|%v]", grl.line.govar, grl.value)
+}
+
+// flag which is true if the last readLine() into a variable read a line
+type GoLineFound struct {
+	line *lineVar
+}
+
+func (glf *GoLineFound) Expr() ast.Expr {
+	return ast.NewIdent(glf.line.flag())
+}
+
+func (glf *GoLineFound) hasVariable(govar GoVar) bool {
+	return glf.line.govar.Equals(govar)
+}
+
+func (glf *GoLineFound) Init() ast.Stmt {
+	return nil
+}
+
+func (glf *GoLineFound) RunTransform(xform TransformFunc, prog *GoProgram,
+	cls GoClass, parent GoObject) (GoObject, bool) {
+	return xform(parent, prog, cls, glf)
+}
+
+func (glf *GoLineFound) String() string {
+	return fmt.Sprintf("GoLineFound[%v]", glf.line.govar)
+}
+
+func (glf *GoLineFound) VarType() *TypeData {
+	return boolType
+}
+
+// replace comparisons of readLine() results with null, which would
+// otherwise compare a Go string with nil, by tests of whether Scan()
+// read a line
+func transformLineChecks(prog *GoProgram, cls GoClass, mthd *GoClassMethod) {
+	var lines []*lineVar
+	mthd.body.RunTransform(func(parent GoObject, prog *GoProgram,
+		cls GoClass, object GoObject) (GoObject, bool) {
+		switch obj := object.(type) {
+		case *GoLocalVarInit:
+			if isReadLine(obj.init) {
+				findLineVar(&lines, obj.govar).read = true
+			}
+		case *GoAssign:
+			if len(obj.rhs) == 1 && isReadLine(obj.rhs[0]) {
+				findLineVar(&lines, obj.govar).read = true
+			}
+		case *GoScanLoop:
+			findLineVar(&lines, obj.line).scanned = true
+		case *GoBinaryExpr:
+			if govar, ok := nullCompare(obj).(GoVar); ok {
+				findLineVar(&lines, govar).compared = true
+			}
+		}
+
+		return nil, true
+	}, prog, cls, mthd)
+
+	// return the variable if it needs a flag
+	flagged := func(govar GoVar) *lineVar {
+		for _, lv := range lines {
+			if lv.govar.Equals(govar) && lv.read && lv.compared {
+				if lv.scanned {
+					log.Printf("//ERR// Cannot tell when readLine() into"+
+						" %v reached the end of the stream\n", govar.Name())
+					lv.compared = false
+					return nil
+				}
+
+				return lv
+			}
+		}
+
+		return nil
+	}
+
+	mthd.body.RunTransform(func(parent GoObject, prog *GoProgram,
+		cls GoClass, object GoObject) (GoObject, bool) {
+		switch obj := object.(type) {
+		case *GoLocalVarNoInit:
+			if lv := flagged(obj.govar); lv != nil {
+				return &GoReadLine{line: lv}, false
+			}
+		case *GoLocalVarInit:
+			if lv := flagged(obj.govar); lv != nil {
+				if rdr, ok := obj.init.(*GoStreamRead); ok {
+					rdr.found = true
+				}
+				return &GoReadLine{line: lv, value: obj.init,
+					tok: token.DEFINE}, false
+			}
+		case *GoAssign:
+			if lv := flagged(obj.govar); lv != nil &&
+				obj.tok == token.ASSIGN && len(obj.rhs) == 1 {
+				if rdr, ok := obj.rhs[0].(*GoStreamRead); ok {
+					rdr.found = true
+				}
+				return &GoReadLine{line: lv, value: obj.rhs[0],
+					tok: token.ASSIGN}, false
+			}
+		case *GoBinaryExpr:
+			var found GoExpr
+			switch x := nullCompare(obj).(type) {
+			case *GoStreamRead:
+				// "in.readLine() != null" discards the line
+				if isReadLine(x) {
+					x.discard = true
+					found = x
+				}
+			case GoVar:
+				if lv := flagged(x); lv != nil {
+					found = &GoLineFound{line: lv}
+				}
+			}
+
+			if found == nil {
+				break
+			} else if obj.op == token.EQL {
+				return &GoUnaryExpr{op: token.NOT, x: found}, false
+			}
+
+			return found, false
+		}
+
+		return nil, true
+	}, prog, cls, mthd)
+}
+
+// "while ((line = in.readLine()) != null)" loops over the lines of a
+// bufio.Scanner, then throws any error which stopped the scan
+type GoScanLoop struct {
+	stream *streamVar
+	line   GoVar
+	body   GoStatement
+}
+
+func (gsl *GoScanLoop) hasVariable(govar GoVar) bool {
+	return gsl.stream.govar.Equals(govar) || gsl.line.Equals(govar) ||
+		(gsl.body != nil && gsl.body.hasVariable(govar))
+}
+
+func (gsl *GoScanLoop) RunTransform(xform TransformFunc, prog *GoProgram,
+	cls GoClass, parent GoObject) (GoObject, bool) {
+	if gsl.body != nil {
+		obj, is_nil := gsl.body.RunTransform(xform, prog, cls, gsl)
+		if !is_nil {
+			var err error
+			if gsl.body, err = convertToStmt(obj); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	return xform(parent, prog, cls, gsl)
+}
+
+func (gsl *GoScanLoop) Stmts() []ast.Stmt {
+	rdr := gsl.stream.govar.Expr()
+
+	text := &ast.AssignStmt{Lhs: []ast.Expr{gsl.line.Expr()},
+		Tok: token.ASSIGN, Rhs: []ast.Expr{&ast.CallExpr{
+			Fun: &ast.SelectorExpr{X: rdr, Sel: ast.NewIdent("Text")}}}}
+
+	body := []ast.Stmt{text}
+	if gsl.body != nil {
+		body = append(body, loopBody(gsl.body)...)
+	}
+
+	scan := &ast.CallExpr{Fun: &ast.SelectorExpr{X: rdr,
+		Sel: ast.NewIdent("Scan")}}
+	errcall := &ast.CallExpr{Fun: &ast.SelectorExpr{X: rdr,
+		Sel: ast.NewIdent("Err")}}
+
+	return []ast.Stmt{
+		&ast.ForStmt{Cond: scan, Body: &ast.BlockStmt{List: body}},
+		checkErr(&ast.AssignStmt{Lhs: []ast.Expr{ast.NewIdent("err")},
+			Tok: token.DEFINE, Rhs: []ast.Expr{errcall}}),
+	}
+}
+
+func (gsl *GoScanLoop) String() string {
+	return fmt.Sprintf("GoScanLoop[%v|%v|%v]", gsl.stream.govar, gsl.line,
+		gsl.body)
+}
+
+// "while ((n = in.read(buf)) > 0)" reads at the top of the loop and
+// breaks out when the test fails
+type GoReadLoop struct {
+	assign *GoAssign
+	op     token.Token
+	y      GoExpr
+	body   GoStatement
+}
+
+// comparisons and the operators which negate them
+var negatedOps = map[token.Token]token.Token{
+	token.EQL: token.NEQ,
+	token.NEQ: token.EQL,
+	token.LSS: token.GEQ,
+	token.LEQ: token.GTR,
+	token.GTR: token.LEQ,
+	token.GEQ: token.LSS,
+}
+
+func (grl *GoReadLoop) hasVariable(govar GoVar) bool {
+	return grl.assign.hasVariable(govar) || grl.y.hasVariable(govar) ||
+		(grl.body != nil && grl.body.hasVariable(govar))
+}
+
+func (grl *GoReadLoop) RunTransform(xform TransformFunc, prog *GoProgram,
+	cls GoClass, parent GoObject) (GoObject, bool) {
+	if obj, is_nil := grl.assign.RunTransform(xform, prog, cls,
+		grl); !is_nil {
+		if asgn, ok := obj.(*GoAssign); ok {
+			grl.assign = asgn
+		} else {
+			panic(fmt.Errorf("%v<%T> is not a *GoAssign", obj, obj))
+		}
+	}
+
+	obj, is_nil := grl.y.RunTransform(xform, prog, cls, grl)
+	if !is_nil {
+		var err error
+		if grl.y, err = convertToExpr(obj); err != nil {
+			panic(err)
+		}
+	}
+
+	if grl.body != nil {
+		obj, is_nil := grl.body.RunTransform(xform, prog, cls, grl)
+		if !is_nil {
+			var err error
+			if grl.body, err = convertToStmt(obj); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	return xform(parent, prog, cls, grl)
+}
+
+func (grl *GoReadLoop) Stmts() []ast.Stmt {
+	brk := &ast.IfStmt{Cond: &ast.BinaryExpr{X: grl.assign.govar.Expr(),
+		Op: negatedOps[grl.op], Y: grl.y.Expr()},
+		Body: &ast.BlockStmt{List: []ast.Stmt{
+			&ast.BranchStmt{Tok: token.BREAK}}}}
+
+	body := append(grl.assign.Stmts(), brk)
+	if grl.body != nil {
+		body = append(body, loopBody(grl.body)...)
+	}
+
+	return []ast.Stmt{&ast.ForStmt{Body: &ast.BlockStmt{List: body}}}
+}
+
+func (grl *GoReadLoop) String() string {
+	return fmt.Sprintf("GoReadLoop[%v|%v|%v|%v]", grl.assign, grl.op, grl.y,
+		grl.body)
+}
+
+// return the statements in the body of a loop
+func loopBody(stmt GoStatement) []ast.Stmt {
+	stmts := stmt.Stmts()
+	if len(stmts) == 1 {
+		if blk, ok := stmts[0].(*ast.BlockStmt); ok {
+			return blk.List
+		}
+	}
+
+	return stmts
+}
+
+// return the standard stream for "System.in", "System.out" or "System.err"
+// (which may already have been replaced by "os.Stdin", etc.)
+func standardStream(expr GoExpr) string {
+	if pkg, ok := expr.(*GoPkgName); ok && pkg.pkg == "os" &&
+		strings.HasPrefix(pkg.name, "Std") {
+		return pkg.name
+	} else if fv, ok := expr.(*FakeVar); ok {
+		switch fv.name {
+		case "System.in":
+			return "Stdin"
+		case "System.out":
+			return "Stdout"
+		case "System.err":
+			return "Stderr"
+		}
+	}
+
+	return ""
+}
+
+// return the path passed to a java.io constructor, which may be wrapped
+// in a java.io.File
+func streamPath(expr GoExpr) GoExpr {
+	if alloc, ok := expr.(*GoClassAlloc); ok {
+		if alloc.class.Name() != "File" || len(alloc.args) != 1 {
+			return nil
+		}
+
+		return alloc.args[0]
+	} else if td := expr.VarType(); td != nil && td.IsClass("File") {
+		return nil
+	}
+
+	return expr
+}
+
+// find the file or standard stream underneath a stack of java.io
+// constructors and set the stream's 'open' call or standard 'file'
+func (sv *streamVar) findSource(prog *GoProgram, alloc *GoClassAlloc) bool {
+	if len(alloc.args) == 0 {
+		return false
+	}
+
+	if inner, ok := alloc.args[0].(*GoClassAlloc); ok && inner.class != nil &&
+		inner.class.Name() != "File" {
+		if _, ok := javaStreamKinds[inner.class.Name()]; !ok {
+			return false
+		}
+
+		return sv.findSource(prog, inner)
+	}
+
+	if std := standardStream(alloc.args[0]); std != "" {
+		sv.file = std
+		return true
+	}
+
+	var path GoExpr
+	switch alloc.class.Name() {
+	case "FileReader", "FileInputStream", "FileWriter", "FileOutputStream",
+		"PrintWriter", "PrintStream":
+		if path = streamPath(alloc.args[0]); path == nil {
+			return false
+		}
+	default:
+		return false
+	}
+
+	sv.file = sv.govar.Name() + "File"
+
+	if sv.kind != streamWriter {
+		sv.open = packageCall(prog, "os", "Open", nil, path)
+		return true
+	}
+
+	if len(alloc.args) == 2 && strings.HasPrefix(alloc.class.Name(), "File") {
+		kw, ok := alloc.args[1].(*GoKeyword)
+		if !ok {
+			return false
+		}
+
+		if kw.name == "true" {
+			// append to the file
+			flag := func(name string) GoExpr {
+				return &GoPkgName{pkg: "os", name: name}
+			}
+			flags := &GoBinaryExpr{x: &GoBinaryExpr{x: flag("O_WRONLY"),
+				op: token.OR, y: flag("O_CREATE")}, op: token.OR,
+				y: flag("O_APPEND")}
+
+			sv.open = packageCall(prog, "os", "OpenFile", nil, path, flags,
+				&GoLiteral{text: "0644"})
+			return true
+		}
+	}
+
+	sv.open = packageCall(prog, "os", "Create", nil, path)
+	return true
+}
+
+// return the stream which is opened by local variable initializer 'lvi'
+func newStreamVar(prog *GoProgram, lvi *GoLocalVarInit) *streamVar {
+	alloc, ok := lvi.init.(*GoClassAlloc)
+	if !ok || alloc.class == nil {
+		return nil
+	}
+
+	kind, ok := javaStreamKinds[alloc.class.Name()]
+	if !ok {
+		return nil
+	}
+
+	sv := &streamVar{govar: lvi.govar, kind: kind, class: alloc.class.Name()}
+	if !sv.findSource(prog, alloc) {
+		log.Printf("//ERR// Cannot convert %v stream %v\n", sv.class,
+			sv.govar.Name())
+		return nil
+	}
+
+	return sv
+}
+
+// return the stream used by the method call 'object'
+func streamCall(streams []*streamVar,
+	object GoObject) (*streamVar, string, []GoExpr) {
+	if macc, ok := object.(*GoMethodAccessVar); ok {
+		for _, sv := range streams {
+			if sv.govar.Equals(macc.govar) {
+				return sv, macc.method.Name(), macc.args.args
+			}
+		}
+	}
+
+	return nil, "", nil
+}
+
+// transform a call on a stream into an expression
+func streamExpr(prog *GoProgram, sv *streamVar, name string,
+	args []GoExpr) GoExpr {
+	switch {
+	case name == "readLine" && sv.kind == streamScanner && len(args) == 0:
+		return &GoStreamRead{stream: sv, method: name}
+	case name == "read" && sv.kind == streamReader:
+		switch len(args) {
+		case 0:
+			prog.addImport("io", "")
+			return &GoStreamRead{stream: sv, method: name}
+		case 1:
+			prog.addImport("io", "")
+			return &GoStreamRead{stream: sv, method: name, buf: args[0]}
+		case 3:
+			prog.addImport("io", "")
+			return &GoStreamRead{stream: sv, method: name,
+				buf: sliceRange(args[0], args[1], args[2])}
+		}
+	case strings.HasPrefix(name, "read") && sv.kind == streamReader &&
+		len(args) == 0:
+		if _, ok := javaStreamValues[name[4:]]; ok {
+			prog.addImport("encoding/binary", "")
+			return &GoStreamRead{stream: sv, method: name}
+		}
+	case strings.HasPrefix(name, "print") || name == "format":
+		if sv.kind != streamWriter ||
+			!strings.HasPrefix(sv.class, "Print") {
+			break
+		}
+
+		// PrintWriter and PrintStream never throw exceptions
+		var fixed string
+		switch name {
+		case "print":
+			fixed = "Fprint"
+		case "println":
+			fixed = "Fprintln"
+		case "printf", "format":
+			fixed = "Fprintf"
+		default:
+			return nil
+		}
+
+		fargs := append([]GoExpr{sv.govar}, args...)
+		return &GoMethodAccess{method: NewGoFakeMethod(getFmtClass(prog),
+			fixed, nil), args: &GoMethodArguments{args: fargs}}
+	}
+
+	return nil
+}
+
+// transform a call on a stream into a statement
+func streamStmt(prog *GoProgram, sv *streamVar, name string,
+	args []GoExpr) GoStatement {
+	call := func(method string, values int, args ...GoExpr) GoStatement {
+		return &GoStreamCall{call: &GoMethodAccessExpr{expr: sv.govar,
+			method: NewGoFakeMethod(nil, method, nil),
+			args:   &GoMethodArguments{args: args}}, values: values}
+	}
+
+	switch {
+	case name == "close" && len(args) == 0:
+		return &GoStreamClose{stream: sv}
+	case name == "readFully" && sv.kind == streamReader:
+		var buf GoExpr
+		switch len(args) {
+		case 1:
+			buf = args[0]
+		case 3:
+			buf = sliceRange(args[0], args[1], args[2])
+		default:
+			return nil
+		}
+
+		return &GoStreamCall{call: packageCall(prog, "io", "ReadFull", nil,
+			sv.govar, buf), values: 1}
+	case name == "skipBytes" && sv.kind == streamReader && len(args) == 1:
+		return call("Discard", 1, args[0])
+	case sv.kind != streamWriter:
+		return nil
+	case name == "flush" && len(args) == 0:
+		return call("Flush", 0)
+	case name == "newLine" && len(args) == 0:
+		return call("WriteString", 1, &GoLiteral{text: "\"\\n\""})
+	case name == "write" || name == "append":
+		switch len(args) {
+		case 1:
+			td := args[0].VarType()
+			if td == stringType {
+				return call("WriteString", 1, args[0])
+			} else if td != nil && td.vtype == VT_ARRAY {
+				return call("Write", 1, args[0])
+			} else if strings.HasSuffix(sv.class, "Stream") {
+				return call("WriteByte", 0, packageCall(prog, "", "byte",
+					byteType, args[0]))
+			}
+
+			return call("WriteRune", 1, packageCall(prog, "", "rune", nil,
+				args[0]))
+		case 3:
+			if td := args[0].VarType(); td == stringType {
+				hi := &GoBinaryExpr{x: args[1], op: token.ADD, y: args[2]}
+				return call("WriteString", 1, &GoSliceExpr{x: args[0],
+					low: args[1], high: hi})
+			}
+
+			return call("Write", 1, sliceRange(args[0], args[1], args[2]))
+		}
+	case strings.HasPrefix(name, "write") && len(args) == 1:
+		value, ok := javaStreamValues[name[5:]]
+		if !ok {
+			break
+		}
+
+		arg := args[0]
+		if value.wire != "bool" {
+			arg = packageCall(prog, "", value.wire, nil, arg)
+		}
+
+		return &GoStreamCall{call: packageCall(prog, "encoding/binary",
+			"Write", nil, sv.govar, &GoPkgName{pkg: "binary",
+				name: "BigEndian"}, arg)}
+	}
+
+	return nil
+}
+
+// transform local java.io stacks such as
+// "new BufferedReader(new FileReader(path))" into an os.Open() wrapped
+// in a bufio.Scanner, bufio.Reader or bufio.Writer, translate the calls
+// on them, and close each file with a deferred Close() if the stream is
+// declared at the top of the method and closed anywhere within it
+func TransformStreams(parent GoObject, prog *GoProgram, cls GoClass,
+	object GoObject) (GoObject, bool) {
+	mthd, ok := object.(*GoClassMethod)
+	if !ok || mthd.body == nil {
+		return nil, true
+	}
+
+	var streams []*streamVar
+	mthd.body.RunTransform(func(parent GoObject, prog *GoProgram,
+		cls GoClass, object GoObject) (GoObject, bool) {
+		if lvi, ok := object.(*GoLocalVarInit); ok {
+			if sv := newStreamVar(prog, lvi); sv != nil {
+				sv.deferred = sv.open != nil && parent == mthd.body
+				streams = append(streams, sv)
+			}
+		}
+
+		return nil, true
+	}, prog, cls, mthd)
+
+	if len(streams) == 0 {
+		return nil, true
+	}
+
+	// only defer Close() for streams which the method closes
+	closed := make(map[*streamVar]bool)
+	mthd.body.RunTransform(func(parent GoObject, prog *GoProgram,
+		cls GoClass, object GoObject) (GoObject, bool) {
+		if sv, name, _ := streamCall(streams, object); sv != nil &&
+			name == "close" {
+			closed[sv] = true
+		}
+
+		return nil, true
+	}, prog, cls, mthd)
+	for _, sv := range streams {
+		sv.deferred = sv.deferred && closed[sv]
+	}
+
+	prog.addImport("bufio", "")
+	prog.addImport("os", "")
+
+	mthd.body.RunTransform(func(parent GoObject, prog *GoProgram,
+		cls GoClass, object GoObject) (GoObject, bool) {
+		switch obj := object.(type) {
+		case *GoLocalVarInit:
+			for _, sv := range streams {
+				if sv.govar == obj.govar {
+					return &GoStreamOpen{stream: sv}, false
+				}
+			}
+		case *GoMethodAccessVar:
+			if sv, name, args := streamCall(streams, obj); sv != nil {
+				if expr := streamExpr(prog, sv, name, args); expr != nil {
+					return expr, false
+				}
+			}
+		case *GoExprStmt:
+			if sv, name, args := streamCall(streams, obj.x); sv != nil {
+				if stmt := streamStmt(prog, sv, name, args); stmt != nil {
+					return stmt, false
+				}
+
+				log.Printf("//ERR// Cannot convert %v.%v() with %d args\n",
+					sv.class, name, len(args))
+			}
+		case *GoWhile:
+			cond, ok := obj.expr.(*GoBinaryExpr)
+			if !ok || obj.is_do_while {
+				break
+			}
+
+			asgn, ok := cond.x.(*GoAssign)
+			if !ok || asgn.tok != token.ASSIGN || len(asgn.rhs) != 1 {
+				break
+			}
+
+			rdr, ok := asgn.rhs[0].(*GoStreamRead)
+			if !ok {
+				break
+			}
+
+			if kw, ok := cond.y.(*GoKeyword); ok && kw.name == "null" &&
+				cond.op == token.NEQ && rdr.method == "readLine" {
+				return &GoScanLoop{stream: rdr.stream, line: asgn.govar,
+					body: obj.stmt}, false
+			}
+
+			if _, ok := negatedOps[cond.op]; ok {
+				return &GoReadLoop{assign: asgn, op: cond.op, y: cond.y,
+					body: obj.stmt}, false
+			}
+		}
+
+		return nil, true
+	}, prog, cls, mthd)
+
+	transformLineChecks(prog, cls, mthd)
+
+	return nil, true
+}
@@ -5,6 +5,8 @@ import (
 	"go/ast"
 	"go/token"
 	"log"
+	"regexp/syntax"
+	"strconv"
 	"strings"
 
 	"java2go/grammar"
@@ -84,16 +86,27 @@ func convertToVarInit(obj GoObject) (*GoVarInit, error) {
 }
 
 func getFmtClass(prog *GoProgram) GoClass {
-	fmtcls := prog.findClass("fmt")
-	if fmtcls == nil {
-		fmtcls = NewGoFakeClass("fmt")
-		prog.addClass(fmtcls)
+	return getPackageClass(prog, "fmt")
+}
+
+// return a fake class for the Go package at 'pkgpath' (e.g. "unicode/utf8")
+// and make sure that package is imported
+func getPackageClass(prog *GoProgram, pkgpath string) GoClass {
+	name := pkgpath
+	if idx := strings.LastIndex(pkgpath, "/"); idx >= 0 {
+		name = pkgpath[idx+1:]
+	}
+
+	pkgcls := prog.findClass(name)
+	if pkgcls == nil {
+		pkgcls = NewGoFakeClass(name)
+		prog.addClass(pkgcls)
 
-		// make sure "fmt" is imported
-		prog.addImport("fmt", "")
+		// make sure the package is imported
+		prog.addImport(pkgpath, "")
 	}
 
-	return fmtcls
+	return pkgcls
 }
 
 type GoPkgName struct {
@@ -124,7 +137,390 @@ func (gpn *GoPkgName) String() string {
 }
 
 func (gpn *GoPkgName) VarType() *TypeData {
-	panic("GoPkgName.VarType() unimplemented")
+	return nil
+}
+
+// method such as Compare() or Equals() used as a function which takes
+// two instances of its class
+type GoMethodFunc struct {
+	class  *GoClassDefinition
+	method *GoClassMethod
+}
+
+// use the "(*Class).Method" method expression if the method takes the
+// class type, otherwise wrap the call in a function literal
+func (gcf *GoMethodFunc) Expr() ast.Expr {
+	ptr := &ast.StarExpr{X: ast.NewIdent(gcf.class.name)}
+
+	param := gcf.method.params[0].VarType()
+	if param != nil && param.vclass == gcf.class.name {
+		return &ast.SelectorExpr{X: &ast.ParenExpr{X: ptr},
+			Sel: ast.NewIdent(gcf.method.goname)}
+	}
+
+	params := &ast.FieldList{List: []*ast.Field{&ast.Field{
+		Names: []*ast.Ident{ast.NewIdent("a"), ast.NewIdent("b")},
+		Type:  ptr}}}
+	results := gcf.method.results()
+
+	call := &ast.CallExpr{Fun: &ast.SelectorExpr{X: ast.NewIdent("a"),
+		Sel: ast.NewIdent(gcf.method.goname)},
+		Args: []ast.Expr{ast.NewIdent("b")}}
+
+	return &ast.FuncLit{Type: &ast.FuncType{Params: params,
+		Results: results}, Body: &ast.BlockStmt{List: []ast.Stmt{
+		&ast.ReturnStmt{Results: []ast.Expr{call}}}}}
+}
+
+func (gcf *GoMethodFunc) hasVariable(govar GoVar) bool {
+	return false
+}
+
+func (gcf *GoMethodFunc) Init() ast.Stmt {
+	return nil
+}
+
+func (gcf *GoMethodFunc) RunTransform(xform TransformFunc, prog *GoProgram,
+	cls GoClass, parent GoObject) (GoObject, bool) {
+	return xform(parent, prog, cls, gcf)
+}
+
+func (gcf *GoMethodFunc) String() string {
+	return fmt.Sprintf("GoMethodFunc[%s]", gcf.class.name)
+}
+
+func (gcf *GoMethodFunc) VarType() *TypeData {
+	return nil
+}
+
+// Java Comparator passed to a Go sort function as a comparison function
+type GoComparator struct {
+	expr GoExpr
+	// class which defines compare(), if known
+	class  *GoClassDefinition
+	method *GoClassMethod
+	// comparator is already a Go function
+	is_func bool
+}
+
+func newGoComparator(prog *GoProgram, expr GoExpr) *GoComparator {
+	gc := &GoComparator{expr: expr}
+
+	var cd *GoClassDefinition
+	if alloc, ok := expr.(*GoClassAlloc); ok {
+		cd, _ = alloc.class.(*GoClassDefinition)
+	} else if td := expr.VarType(); td != nil && td.isObject() {
+		if prog.config.isFuncType(td.vclass) {
+			gc.is_func = true
+		} else {
+			cd, _ = prog.findClass(td.vclass).(*GoClassDefinition)
+		}
+	}
+
+	if cd != nil {
+		if cd.is_adapter {
+			// pass the function literal rather than an adapter
+			gc.is_func = cd.func_type != ""
+			cd.is_inline = !gc.is_func
+		}
+
+		gc.class = cd
+		gc.method = cd.comparatorMethod()
+	}
+
+	return gc
+}
+
+// return true if the comparator's compare() method takes values of
+// type 'elem', or if either type is unknown
+func (gc *GoComparator) accepts(elem *TypeData) bool {
+	if gc.method == nil || elem == nil {
+		return true
+	}
+
+	for _, p := range gc.method.params {
+		ptype := p.VarType()
+		if ptype == nil || !ptype.Equals(elem) || ptype.vclass != elem.vclass {
+			return false
+		}
+	}
+
+	return true
+}
+
+// use an anonymous comparator's function literal or a function value
+// directly, otherwise use the "cmp.Compare" method value
+func (gc *GoComparator) Expr() ast.Expr {
+	if gc.is_func {
+		return gc.expr.Expr()
+	} else if gc.class != nil && gc.class.is_inline {
+		return gc.class.adapterFunc()
+	}
+
+	name := "Compare"
+	if gc.method != nil {
+		name = gc.method.goname
+	}
+
+	return &ast.SelectorExpr{X: gc.expr.Expr(), Sel: ast.NewIdent(name)}
+}
+
+func (gc *GoComparator) hasVariable(govar GoVar) bool {
+	return gc.expr.hasVariable(govar)
+}
+
+func (gc *GoComparator) Init() ast.Stmt {
+	return nil
+}
+
+func (gc *GoComparator) RunTransform(xform TransformFunc, prog *GoProgram,
+	cls GoClass, parent GoObject) (GoObject, bool) {
+	return xform(parent, prog, cls, gc)
+}
+
+func (gc *GoComparator) String() string {
+	return fmt.Sprintf("GoComparator[%v]", gc.expr)
+}
+
+func (gc *GoComparator) VarType() *TypeData {
+	return nil
+}
+
+// "func(i, j int) bool { return cmp(x[i], x[j]) < 0 }" for sort.SliceStable,
+// used when the comparison function doesn't take the slice's element type
+type GoLessFunc struct {
+	slice GoExpr
+	cmp   GoExpr
+}
+
+func (glf *GoLessFunc) Expr() ast.Expr {
+	elem := func(name string) ast.Expr {
+		return &ast.IndexExpr{X: glf.slice.Expr(), Index: ast.NewIdent(name)}
+	}
+
+	params := &ast.FieldList{List: []*ast.Field{&ast.Field{
+		Names: []*ast.Ident{ast.NewIdent("i"), ast.NewIdent("j")},
+		Type:  ast.NewIdent("int")}}}
+	results := &ast.FieldList{List: []*ast.Field{&ast.Field{
+		Type: ast.NewIdent("bool")}}}
+
+	call := &ast.CallExpr{Fun: glf.cmp.Expr(),
+		Args: []ast.Expr{elem("i"), elem("j")}}
+	less := &ast.BinaryExpr{X: call, Op: token.LSS, Y: ast.NewIdent("0")}
+
+	return &ast.FuncLit{Type: &ast.FuncType{Params: params,
+		Results: results}, Body: &ast.BlockStmt{List: []ast.Stmt{
+		&ast.ReturnStmt{Results: []ast.Expr{less}}}}}
+}
+
+func (glf *GoLessFunc) hasVariable(govar GoVar) bool {
+	return glf.slice.hasVariable(govar) || glf.cmp.hasVariable(govar)
+}
+
+func (glf *GoLessFunc) Init() ast.Stmt {
+	return nil
+}
+
+func (glf *GoLessFunc) RunTransform(xform TransformFunc, prog *GoProgram,
+	cls GoClass, parent GoObject) (GoObject, bool) {
+	return xform(parent, prog, cls, glf)
+}
+
+func (glf *GoLessFunc) String() string {
+	return fmt.Sprintf("GoLessFunc[%v|%v]", glf.slice, glf.cmp)
+}
+
+func (glf *GoLessFunc) VarType() *TypeData {
+	return nil
+}
+
+// return the first name in 'names' which isn't used by any expression
+func unusedName(names []string, exprs ...GoExpr) string {
+	for _, name := range names {
+		used := false
+		for _, expr := range exprs {
+			if expr != nil && expr.hasVariable(NewFakeVar(name, nil, 0)) {
+				used = true
+				break
+			}
+		}
+
+		if !used {
+			return name
+		}
+	}
+
+	panic(fmt.Sprintf("All of %v are used", names))
+}
+
+// return "func() result { body }()"
+func callFuncLit(result ast.Expr, body ...ast.Stmt) ast.Expr {
+	results := &ast.FieldList{List: []*ast.Field{&ast.Field{Type: result}}}
+
+	return &ast.CallExpr{Fun: &ast.FuncLit{Type: &ast.FuncType{
+		Params: &ast.FieldList{}, Results: results},
+		Body: &ast.BlockStmt{List: body}}}
+}
+
+// loop which assigns 'value' to the elements of 'array' from 'low' up to
+// 'high', or to every element if there are no bounds
+type GoFillLoop struct {
+	array GoExpr
+	low   GoExpr
+	high  GoExpr
+	value GoExpr
+}
+
+func (gfl *GoFillLoop) hasVariable(govar GoVar) bool {
+	for _, expr := range []GoExpr{gfl.array, gfl.low, gfl.high, gfl.value} {
+		if expr != nil && expr.hasVariable(govar) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (gfl *GoFillLoop) RunTransform(xform TransformFunc, prog *GoProgram,
+	cls GoClass, parent GoObject) (GoObject, bool) {
+	return xform(parent, prog, cls, gfl)
+}
+
+func (gfl *GoFillLoop) Stmts() []ast.Stmt {
+	idx := ast.NewIdent(unusedName([]string{"i", "j", "k", "idx"},
+		gfl.array, gfl.low, gfl.high, gfl.value))
+
+	assign := &ast.AssignStmt{Lhs: []ast.Expr{&ast.IndexExpr{
+		X: gfl.array.Expr(), Index: idx}}, Tok: token.ASSIGN,
+		Rhs: []ast.Expr{gfl.value.Expr()}}
+	body := &ast.BlockStmt{List: []ast.Stmt{assign}}
+
+	if gfl.low == nil {
+		return []ast.Stmt{&ast.RangeStmt{Key: idx, Tok: token.DEFINE,
+			X: gfl.array.Expr(), Body: body}}
+	}
+
+	init := &ast.AssignStmt{Lhs: []ast.Expr{idx}, Tok: token.DEFINE,
+		Rhs: []ast.Expr{gfl.low.Expr()}}
+	cond := &ast.BinaryExpr{X: idx, Op: token.LSS, Y: gfl.high.Expr()}
+	post := &ast.IncDecStmt{X: idx, Tok: token.INC}
+
+	return []ast.Stmt{&ast.ForStmt{Init: init, Cond: cond, Post: post,
+		Body: body}}
+}
+
+func (gfl *GoFillLoop) String() string {
+	return fmt.Sprintf("GoFillLoop[%v|%v|%v|%v]", gfl.array, gfl.low,
+		gfl.high, gfl.value)
+}
+
+// copy of an array which may be longer or shorter than the original,
+// from Arrays.copyOf(), or of the range starting at 'from' (which may
+// extend past the end of the original) from Arrays.copyOfRange()
+type GoCopyOf struct {
+	src    GoExpr
+	from   GoExpr
+	length GoExpr
+}
+
+func (gco *GoCopyOf) Expr() ast.Expr {
+	dst := ast.NewIdent(unusedName([]string{"dst", "tmp", "buf"}, gco.src,
+		gco.length))
+	atype := gco.src.VarType().Expr()
+
+	src := gco.src.Expr()
+	if gco.from != nil {
+		src = &ast.SliceExpr{X: src, Low: gco.from.Expr()}
+	}
+
+	mk := &ast.CallExpr{Fun: ast.NewIdent("make"),
+		Args: []ast.Expr{atype, gco.length.Expr()}}
+	cp := &ast.CallExpr{Fun: ast.NewIdent("copy"),
+		Args: []ast.Expr{dst, src}}
+
+	return callFuncLit(atype,
+		&ast.AssignStmt{Lhs: []ast.Expr{dst}, Tok: token.DEFINE,
+			Rhs: []ast.Expr{mk}},
+		&ast.ExprStmt{X: cp},
+		&ast.ReturnStmt{Results: []ast.Expr{dst}})
+}
+
+func (gco *GoCopyOf) hasVariable(govar GoVar) bool {
+	return gco.src.hasVariable(govar) || gco.length.hasVariable(govar) ||
+		(gco.from != nil && gco.from.hasVariable(govar))
+}
+
+func (gco *GoCopyOf) Init() ast.Stmt {
+	return nil
+}
+
+func (gco *GoCopyOf) RunTransform(xform TransformFunc, prog *GoProgram,
+	cls GoClass, parent GoObject) (GoObject, bool) {
+	return xform(parent, prog, cls, gco)
+}
+
+func (gco *GoCopyOf) String() string {
+	return fmt.Sprintf("GoCopyOf[%v|%v|%v]", gco.src, gco.from, gco.length)
+}
+
+func (gco *GoCopyOf) VarType() *TypeData {
+	return gco.src.VarType()
+}
+
+// Arrays.binarySearch() returns the index of the key or
+// "-(insertion point) - 1", which is rebuilt from the result of
+// slices.BinarySearch() for a slice starting at 'offset'
+type GoBinarySearch struct {
+	search GoExpr
+	offset GoExpr
+}
+
+func (gbs *GoBinarySearch) Expr() ast.Expr {
+	names := unusedName([]string{"i", "j", "idx"}, gbs.search, gbs.offset)
+	idx := ast.NewIdent(names)
+	found := ast.NewIdent(unusedName([]string{"found", "ok"}, gbs.search,
+		gbs.offset))
+
+	var pos ast.Expr = idx
+	if gbs.offset != nil {
+		pos = &ast.BinaryExpr{X: idx, Op: token.ADD, Y: gbs.offset.Expr()}
+	}
+
+	missing := &ast.BinaryExpr{X: &ast.UnaryExpr{Op: token.SUB,
+		X: &ast.ParenExpr{X: pos}}, Op: token.SUB, Y: ast.NewIdent("1")}
+	if gbs.offset == nil {
+		missing.X = &ast.UnaryExpr{Op: token.SUB, X: idx}
+	}
+
+	return callFuncLit(ast.NewIdent("int"),
+		&ast.AssignStmt{Lhs: []ast.Expr{idx, found}, Tok: token.DEFINE,
+			Rhs: []ast.Expr{gbs.search.Expr()}},
+		&ast.IfStmt{Cond: &ast.UnaryExpr{Op: token.NOT, X: found},
+			Body: &ast.BlockStmt{List: []ast.Stmt{
+				&ast.ReturnStmt{Results: []ast.Expr{missing}}}}},
+		&ast.ReturnStmt{Results: []ast.Expr{pos}})
+}
+
+func (gbs *GoBinarySearch) hasVariable(govar GoVar) bool {
+	return gbs.search.hasVariable(govar) ||
+		(gbs.offset != nil && gbs.offset.hasVariable(govar))
+}
+
+func (gbs *GoBinarySearch) Init() ast.Stmt {
+	return nil
+}
+
+func (gbs *GoBinarySearch) RunTransform(xform TransformFunc,
+	prog *GoProgram, cls GoClass, parent GoObject) (GoObject, bool) {
+	return xform(parent, prog, cls, gbs)
+}
+
+func (gbs *GoBinarySearch) String() string {
+	return fmt.Sprintf("GoBinarySearch[%v|%v]", gbs.search, gbs.offset)
+}
+
+func (gbs *GoBinarySearch) VarType() *TypeData {
+	return intType
 }
 
 // transform "array.length" to "len(array)"
@@ -146,13 +542,26 @@ func TransformArrayLen(parent GoObject, prog *GoProgram, cls GoClass,
 	return &GoMethodAccess{method: fm, args: args}, false
 }
 
-// transform "System.out.print*(...)" to "fmt.Print*(...)" and
-// "System.err.print*(...)" to "fmt.Fprintf(os.Stderr, ...)"
+// transform "System.out.print*(...)" to "fmt.Print*(...)",
+// "System.err.print*(...)" to "fmt.Fprintf(os.Stderr, ...)", and other
+// System and Runtime calls to their 'os', 'time' and 'runtime' equivalents
 func TransformSysfile(parent GoObject, prog *GoProgram, cls GoClass,
 	object GoObject) (GoObject, bool) {
 	var ma *GoMethodAccess
-	var ok bool
-	if ma, ok = object.(*GoMethodAccess); !ok {
+	switch obj := object.(type) {
+	case *FakeVar:
+		// standard streams which are used directly, e.g. passed to a method
+		if std := standardStream(obj); std != "" {
+			prog.addImport("os", "")
+			return &GoPkgName{pkg: "os", name: std}, false
+		}
+
+		return nil, true
+	case *GoMethodAccessExpr:
+		return transformRuntimeCall(prog, obj)
+	case *GoMethodAccess:
+		ma = obj
+	default:
 		return nil, true
 	}
 
@@ -168,6 +577,23 @@ func TransformSysfile(parent GoObject, prog *GoProgram, cls GoClass,
 	clsname := mcls.Name()
 	name := ma.method.Name()
 
+	if clsname == "System" && ma.obj == nil {
+		return transformSystemCall(prog, ma)
+	} else if clsname == "System.in" {
+		// read directly from os.Stdin, since System.in is unbuffered
+		sv := &streamVar{govar: NewFakeVar("os.Stdin", nil, 0),
+			kind: streamReader, class: "InputStream", file: "Stdin",
+			unbuffered: true}
+		if expr := streamExpr(prog, sv, name, ma.args.args); expr != nil {
+			prog.addImport("os", "")
+			return expr, false
+		}
+
+		log.Printf("//ERR// Cannot convert System.in.%v() with %d args\n",
+			name, ma.args.Length())
+		return nil, true
+	}
+
 	if !strings.HasPrefix(clsname, "System.") ||
 		!strings.HasPrefix(name, "print") {
 		return nil, true
@@ -196,6 +622,101 @@ func TransformSysfile(parent GoObject, prog *GoProgram, cls GoClass,
 	return &GoMethodAccess{method: fm, args: args}, false
 }
 
+// transform static System methods (other than arraycopy(), which is
+// handled with the other array methods)
+func transformSystemCall(prog *GoProgram,
+	ma *GoMethodAccess) (GoObject, bool) {
+	name := ma.method.Name()
+	args := ma.args.args
+	switch {
+	case name == "exit" && len(args) == 1:
+		return packageCall(prog, "os", "Exit", nil, args[0]), false
+	case name == "currentTimeMillis" && len(args) == 0:
+		return methodCall(packageCall(prog, "time", "Now", nil),
+			"UnixMilli", longType), false
+	case name == "nanoTime" && len(args) == 0:
+		prog.addHelper("NanoTime", nanoTimeHelper)
+
+		return packageCall(prog, "", "NanoTime", longType), false
+	case name == "getenv" && len(args) == 1:
+		return packageCall(prog, "os", "Getenv", stringType, args[0]), false
+	case name == "lineSeparator" && len(args) == 0:
+		return NewGoLiteral("\"\\n\""), false
+	case name == "gc" && len(args) == 0:
+		return packageCall(prog, "runtime", "GC", nil), false
+	case name == "getProperty" && (len(args) == 1 || len(args) == 2):
+		// any default value is ignored since these properties always exist
+		if key, ok := stringLiteral(args[0]); ok {
+			if expr := systemProperty(prog, key); expr != nil {
+				return expr, false
+			}
+		}
+
+		log.Printf("//ERR// Cannot convert System.getProperty(%v)\n", args[0])
+		return nil, true
+	}
+
+	return nil, true
+}
+
+// return the Go value of Java system property 'key', or nil if the
+// property is not known
+func systemProperty(prog *GoProgram, key string) GoExpr {
+	pkgName := func(pkg string, name string) GoExpr {
+		prog.addImport(pkg, "")
+		return &GoPkgName{pkg: pkg, name: name}
+	}
+
+	switch key {
+	case "line.separator":
+		return NewGoLiteral("\"\\n\"")
+	case "file.separator":
+		return NewGoTypeConversion(pkgName("os", "PathSeparator"),
+			stringType)
+	case "path.separator":
+		return NewGoTypeConversion(pkgName("os", "PathListSeparator"),
+			stringType)
+	case "java.io.tmpdir":
+		return packageCall(prog, "os", "TempDir", stringType)
+	case "user.dir":
+		return &GoFirstResult{call: packageCall(prog, "os", "Getwd", nil),
+			vtype: stringType}
+	case "user.home":
+		return &GoFirstResult{call: packageCall(prog, "os", "UserHomeDir",
+			nil), vtype: stringType}
+	case "os.name":
+		return pkgName("runtime", "GOOS")
+	case "os.arch":
+		return pkgName("runtime", "GOARCH")
+	}
+
+	return nil
+}
+
+// transform "Runtime.getRuntime().method()" calls
+func transformRuntimeCall(prog *GoProgram,
+	macc *GoMethodAccessExpr) (GoObject, bool) {
+	rt, ok := macc.expr.(*GoMethodAccess)
+	if !ok || macc.method == nil || !isStaticCall(rt, "Runtime", "getRuntime") {
+		return nil, true
+	}
+
+	name := macc.method.Name()
+	args := macc.args.args
+	switch {
+	case name == "availableProcessors" && len(args) == 0:
+		return packageCall(prog, "runtime", "NumCPU", intType), false
+	case name == "gc" && len(args) == 0:
+		return packageCall(prog, "runtime", "GC", nil), false
+	case name == "exit" && len(args) == 1:
+		return packageCall(prog, "os", "Exit", nil, args[0]), false
+	}
+
+	log.Printf("//ERR// Cannot convert Runtime.%v() with %d args\n", name,
+		len(args))
+	return nil, true
+}
+
 // transform "func main(String[] xxx)" to "func main()" and all "xxx" references
 // to "os.Args"
 func TransformMainArgs(parent GoObject, prog *GoProgram, cls GoClass,
@@ -254,6 +775,17 @@ func TransformThisArg(parent GoObject, prog *GoProgram, cls GoClass,
 	return nil, true
 }
 
+// return true if 'name' is one of the Java List classes
+func isListClass(name string) bool {
+	for _, n := range javaListType {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 // transform method calls for List variants to appropriate array operations
 func TransformListMethods(parent GoObject, prog *GoProgram, cls GoClass,
 	object GoObject) (GoObject, bool) {
@@ -263,14 +795,8 @@ func TransformListMethods(parent GoObject, prog *GoProgram, cls GoClass,
 		return nil, true
 	}
 
-	var is_list bool
-	for _, n := range javaListType {
-		if mref.govar.VarType().IsClass(n) {
-			is_list = true
-			break
-		}
-	}
-	if !is_list {
+	if td := mref.govar.VarType(); td.vtype != VT_CLASS ||
+		!isListClass(td.vclass) {
 		return nil, true
 	}
 
@@ -315,6 +841,19 @@ func TransformListMethods(parent GoObject, prog *GoProgram, cls GoClass,
 		args := &GoMethodArguments{args: []GoExpr{mref.govar}}
 
 		return &GoMethodAccess{method: fm, args: args}, false
+	case "sort":
+		if len(mref.args.args) != 1 {
+			log.Printf("//ERR// Cannot convert %v sort() with %d args\n",
+				mref.govar.VarType(), len(mref.args.args))
+			return nil, true
+		}
+
+		if obj, is_nil := sortSlice(prog, mref.govar,
+			mref.args.args[0]); !is_nil {
+			return obj, false
+		}
+
+		log.Printf("//ERR// Cannot convert %v sort()\n", mref.govar.VarType())
 	default:
 		log.Printf("//ERR// Not converting %v method %v\n",
 			mref.govar.VarType(), mref.method.Name())
@@ -338,6 +877,11 @@ func TransformToString(parent GoObject, prog *GoProgram, cls GoClass,
 		return nil, true
 	}
 
+	if macc.obj == nil && isStaticCall(macc, "Arrays", "toString") {
+		// TransformArrays formats these like Java
+		return nil, true
+	}
+
 	if len(macc.args.args) == 1 {
 		alist := make([]GoExpr, 2)
 		alist[0] = NewGoLiteral("\"%v\"")
@@ -361,43 +905,1670 @@ func TransformToString(parent GoObject, prog *GoProgram, cls GoClass,
 	return nil, true
 }
 
-// transform String.format method call into fmt.Sprintf
-func TransformStringFormat(parent GoObject, prog *GoProgram, cls GoClass,
+// transform toString(), equals(), compareTo() and Comparator compare()
+// calls on values whose class doesn't define them into their Go equivalents
+func TransformObjectMethods(parent GoObject, prog *GoProgram, cls GoClass,
 	object GoObject) (GoObject, bool) {
+	var obj GoExpr
+	var mthd GoMethod
+	var args *GoMethodArguments
+	switch macc := object.(type) {
+	case *GoMethodAccess:
+		// an unqualified call to a method inherited from Object is
+		// called on the receiver
+		mref, ok := macc.method.(*GoMethodReference)
+		if !ok || macc.obj != nil || mref.ref != nil ||
+			!objectMethods[mref.name] {
+			return nil, true
+		}
 
-	var mref *GoMethodReference
-	var ok bool
-	if mref, ok = object.(*GoMethodReference); !ok {
+		cd, ok := cls.(*GoClassDefinition)
+		if !ok {
+			return nil, true
+		}
+
+		obj = &GoKeyword{token: grammar.THIS, name: "this",
+			vartype: &TypeData{vtype: VT_CLASS, vclass: cd.name},
+			rcvr:    prog.Receiver(cd.name)}
+		mthd, args = macc.method, macc.args
+	case *GoMethodAccessVar:
+		obj, mthd, args = macc.govar, macc.method, macc.args
+	case *GoMethodAccessExpr:
+		if macc.method == nil || macc.call_value {
+			return nil, true
+		}
+
+		obj, mthd, args = macc.expr, macc.method, macc.args
+	default:
 		return nil, true
 	}
 
-	if mref.name == "format" && !mref.class.IsNil() &&
-		mref.class.Name() == "String" {
+	if isValueMethod(mthd, obj) {
+		return nil, true
+	}
+
+	if lit, ok := obj.(*GoClassLiteral); ok && args.Length() == 0 {
+		switch mthd.Name() {
+		case "getName":
+			return NewGoLiteral(strconv.Quote(lit.name)), false
+		case "getSimpleName":
+			return NewGoLiteral(strconv.Quote(lit.SimpleName())), false
+		}
+	}
+
+	switch {
+	case mthd.Name() == "toString" && args.Length() == 0:
 		fmtcls := getFmtClass(prog)
+		sprint := NewGoFakeMethod(fmtcls, "Sprint", stringType)
+
+		return &GoMethodAccess{method: sprint,
+			args: &GoMethodArguments{args: []GoExpr{obj}}}, false
+	case mthd.Name() == "equals" && args.Length() == 1:
+		if isValueType(obj.VarType()) || isLocalClass(prog, obj) {
+			// objects without equals() are compared by identity
+			return &GoBinaryExpr{x: obj, op: token.EQL, y: args.args[0]},
+				false
+		}
 
-		return NewGoMethodReference(fmtcls, "Sprintf", mref.args, false), false
+		return &GoMethodAccessExpr{expr: obj,
+			method: NewGoFakeMethod(nil, "Equals", boolType),
+			args:   args}, false
+	case mthd.Name() == "hashCode" && args.Length() == 0 &&
+		isLocalClass(prog, obj):
+		prog.addHelper("IdentityHashCode", identityHashHelper)
+
+		return packageCall(prog, "", "IdentityHashCode", intType, obj), false
+	case mthd.Name() == "compare" && args.Length() == 2 &&
+		obj.VarType() != nil && obj.VarType().IsClass("Comparator"):
+		// match the method value passed to sort functions
+		return &GoMethodAccessExpr{expr: obj,
+			method: NewGoFakeMethod(nil, "Compare", intType),
+			args:   args}, false
+	case mthd.Name() == "compareTo" && args.Length() == 1:
+		var pkgcls GoClass
+		if td := obj.VarType(); td == stringType {
+			pkgcls = getPackageClass(prog, "strings")
+		} else if isValueType(td) {
+			pkgcls = getPackageClass(prog, "cmp")
+		} else {
+			return &GoMethodAccessExpr{expr: obj,
+				method: NewGoFakeMethod(nil, "Compare", intType),
+				args:   args}, false
+		}
+
+		return &GoMethodAccess{method: NewGoFakeMethod(pkgcls, "Compare",
+			intType), args: &GoMethodArguments{args: []GoExpr{obj,
+			args.args[0]}}}, false
 	}
 
 	return nil, true
 }
 
-// transform string addition into fmt.Sprintf
-func TransformStringAddition(parent GoObject, prog *GoProgram, cls GoClass,
-	object GoObject) (GoObject, bool) {
+// methods which every class inherits from java.lang.Object
+var objectMethods = map[string]bool{"equals": true, "hashCode": true,
+	"toString": true}
 
-	var bex *GoBinaryExpr
-	var ok bool
-	if bex, ok = object.(*GoBinaryExpr); !ok {
-		return nil, true
-	}
+// return true if 'obj' is an instance of a class defined in this file,
+// whose methods are known
+func isLocalClass(prog *GoProgram, obj GoExpr) bool {
+	td := obj.VarType()
+	return td != nil && td.isObject() && prog.classForType(td.vclass) != nil
+}
 
-	var x GoTypedObject
-	if x, ok = bex.x.(GoTypedObject); !ok {
-		return nil, true
+// return true for primitive values and the Java classes which box them,
+// which Go compares with '=='
+func isValueType(td *TypeData) bool {
+	if td == nil {
+		return false
 	}
 
-	if x.VarType() != stringType {
-		return nil, true
+	if td.isPrimitive() {
+		return true
+	}
+
+	_, boxed := boxedTypes[td.vclass]
+	return td.vtype == VT_CLASS && boxed
+}
+
+// transform Arrays.sort() and Collections.sort() into slices.Sort() for
+// values and slices.SortStableFunc() for objects, since Java's object
+// sorts are stable
+func TransformSort(parent GoObject, prog *GoProgram, cls GoClass,
+	object GoObject) (GoObject, bool) {
+	var macc *GoMethodAccess
+	var ok bool
+	if macc, ok = object.(*GoMethodAccess); !ok || macc.obj != nil ||
+		macc.method == nil || macc.method.Name() != "sort" {
+		return nil, true
+	}
+
+	mcls := macc.method.Class()
+	if mcls == nil || mcls.IsNil() {
+		return nil, true
+	}
+
+	args := macc.args.args
+
+	var slice, cmp GoExpr
+	switch {
+	case mcls.Name() == "Collections" && len(args) == 1:
+		slice = args[0]
+	case mcls.Name() == "Collections" && len(args) == 2:
+		slice, cmp = args[0], args[1]
+	case mcls.Name() == "Arrays" && (len(args) == 1 || len(args) == 2):
+		slice = args[0]
+		if len(args) == 2 {
+			cmp = args[1]
+		}
+	case mcls.Name() == "Arrays" && (len(args) == 3 || len(args) == 4):
+		// sort(array, fromIndex, toIndex) sorts a range of the array
+		slice = &GoSliceExpr{x: args[0], low: args[1], high: args[2]}
+		if len(args) == 4 {
+			cmp = args[3]
+		}
+	default:
+		return nil, true
+	}
+
+	return sortSlice(prog, slice, cmp)
+}
+
+// return a call which sorts 'slice' using comparator 'cmp', or using the
+// natural order of its elements if 'cmp' is nil or 'null'
+func sortSlice(prog *GoProgram, slice GoExpr, cmp GoExpr) (GoObject, bool) {
+	var elem *TypeData
+	if td := slice.VarType(); td != nil {
+		elem = td.elementType()
+	}
+
+	if kwd, ok := cmp.(*GoKeyword); ok && kwd.name == "null" {
+		cmp = nil
+	}
+
+	slices := getPackageClass(prog, "slices")
+
+	var fn GoExpr
+	if cmp != nil {
+		gc := newGoComparator(prog, cmp)
+		if !gc.accepts(elem) {
+			// wrap comparators which take another type in a 'less' function
+			sortpkg := getPackageClass(prog, "sort")
+			args := &GoMethodArguments{args: []GoExpr{slice,
+				&GoLessFunc{slice: slice, cmp: gc}}}
+
+			return &GoMethodAccess{method: NewGoFakeMethod(sortpkg,
+				"SliceStable", nil), args: args}, false
+		}
+
+		fn = gc
+	} else if isValueType(elem) {
+		args := &GoMethodArguments{args: []GoExpr{slice}}
+
+		return &GoMethodAccess{method: NewGoFakeMethod(slices, "Sort", nil),
+			args: args}, false
+	} else if elem != nil && elem.vtype == VT_CLASS {
+		cd, ok := prog.findClass(elem.vclass).(*GoClassDefinition)
+		if !ok {
+			return nil, true
+		}
+
+		cmpmthd := cd.compareMethod()
+		if cmpmthd == nil {
+			return nil, true
+		}
+
+		fn = &GoMethodFunc{class: cd, method: cmpmthd}
+	} else {
+		return nil, true
+	}
+
+	args := &GoMethodArguments{args: []GoExpr{slice, fn}}
+
+	return &GoMethodAccess{method: NewGoFakeMethod(slices, "SortStableFunc",
+		nil), args: args}, false
+}
+
+// return true if 'macc' calls static method 'clsname.name'
+func isStaticCall(macc *GoMethodAccess, clsname string, name string) bool {
+	if macc.obj != nil || macc.method == nil || macc.method.Name() != name {
+		return false
+	}
+
+	mcls := macc.method.Class()
+
+	return mcls != nil && !mcls.IsNil() && mcls.Name() == clsname
+}
+
+// return true if 'expr' is the literal zero
+func isZero(expr GoExpr) bool {
+	lit, ok := expr.(*GoLiteral)
+	return ok && lit.text == "0"
+}
+
+// return true if 'expr' is "len(array)"
+func isLength(expr GoExpr, array GoExpr) bool {
+	macc, ok := expr.(*GoMethodAccess)
+	return ok && macc.obj == nil && macc.method != nil &&
+		macc.method.Class() == nil && macc.method.Name() == "len" &&
+		macc.args.Length() == 1 && macc.args.args[0].String() == array.String()
+}
+
+// return "x[low:low+length]", dropping a zero 'low'
+func sliceRange(x GoExpr, low GoExpr, length GoExpr) GoExpr {
+	if isZero(low) {
+		return &GoSliceExpr{x: x, high: length}
+	}
+
+	return &GoSliceExpr{x: x, low: low,
+		high: &GoBinaryExpr{x: low, op: token.ADD, y: length}}
+}
+
+// return a call to function 'name' in package 'pkg'
+func packageCall(prog *GoProgram, pkg string, name string, rtntype *TypeData,
+	args ...GoExpr) *GoMethodAccess {
+	var pkgcls GoClass
+	if pkg != "" {
+		pkgcls = getPackageClass(prog, pkg)
+	}
+
+	return &GoMethodAccess{method: NewGoFakeMethod(pkgcls, name, rtntype),
+		args: &GoMethodArguments{args: args}}
+}
+
+// transform System.arraycopy() and java.util.Arrays methods into copy(),
+// slice expressions, loops and 'slices' functions
+func TransformArrays(parent GoObject, prog *GoProgram, cls GoClass,
+	object GoObject) (GoObject, bool) {
+	switch obj := object.(type) {
+	case *GoExprStmt:
+		// Arrays.fill() becomes a loop
+		macc, ok := obj.x.(*GoMethodAccess)
+		if !ok || !isStaticCall(macc, "Arrays", "fill") {
+			return nil, true
+		}
+
+		args := macc.args.args
+		switch len(args) {
+		case 2:
+			return &GoFillLoop{array: args[0], value: args[1]}, false
+		case 4:
+			return &GoFillLoop{array: args[0], low: args[1], high: args[2],
+				value: args[3]}, false
+		}
+
+		log.Printf("//ERR// Cannot convert Arrays.fill() with %d args\n",
+			len(args))
+	case *GoMethodAccess:
+		if isStaticCall(obj, "System", "arraycopy") {
+			args := obj.args.args
+			if len(args) != 5 {
+				log.Printf("//ERR// Cannot convert System.arraycopy() with"+
+					" %d args\n", len(args))
+				return nil, true
+			}
+
+			// System.arraycopy(src, srcPos, dst, dstPos, length)
+			return packageCall(prog, "", "copy", intType,
+				sliceRange(args[2], args[3], args[4]),
+				sliceRange(args[0], args[1], args[4])), false
+		} else if obj.obj == nil && obj.method != nil &&
+			isStaticCall(obj, "Arrays", obj.method.Name()) {
+			return transformArraysMethod(prog, obj)
+		}
+	}
+
+	return nil, true
+}
+
+func transformArraysMethod(prog *GoProgram,
+	macc *GoMethodAccess) (GoObject, bool) {
+	args := macc.args.args
+
+	switch name := macc.method.Name(); {
+	case name == "copyOf" && len(args) == 2:
+		if isLength(args[1], args[0]) {
+			return packageCall(prog, "slices", "Clone", args[0].VarType(),
+				args[0]), false
+		}
+
+		return &GoCopyOf{src: args[0], length: args[1]}, false
+	case name == "copyOfRange" && len(args) == 3:
+		if isLength(args[2], args[0]) {
+			slice := &GoSliceExpr{x: args[0], low: args[1]}
+			return packageCall(prog, "slices", "Clone", args[0].VarType(),
+				slice), false
+		}
+
+		// Java pads the copy with zeros when 'to' is past the end
+		if isZero(args[1]) {
+			return &GoCopyOf{src: args[0], length: args[2]}, false
+		}
+
+		length := &GoBinaryExpr{x: args[2], op: token.SUB, y: args[1]}
+		return &GoCopyOf{src: args[0], from: args[1], length: length}, false
+	case name == "equals" && len(args) == 2:
+		var elem *TypeData
+		if td := args[0].VarType(); td != nil {
+			elem = td.elementType()
+		}
+
+		if elem != nil && elem.vtype == VT_CLASS {
+			// compare objects with their Equals() method
+			cd, ok := prog.findClass(elem.vclass).(*GoClassDefinition)
+			if ok && cd.equalsMethod() != nil {
+				return packageCall(prog, "slices", "EqualFunc", boolType,
+					args[0], args[1], &GoMethodFunc{class: cd,
+						method: cd.equalsMethod()}), false
+			}
+		}
+
+		return packageCall(prog, "slices", "Equal", boolType, args...), false
+	case name == "binarySearch":
+		return transformBinarySearch(prog, args)
+	case name == "toString" && len(args) == 1:
+		// Go's "%v" would print "[1 2 3]" rather than "[1, 2, 3]"
+		prog.addHelper("ArrayString", arrayStringHelper)
+
+		fname := "ArrayString"
+		if td := args[0].VarType(); td != nil && td.elementType() != nil &&
+			td.elementType().vtype == VT_CHAR {
+			fname = "CharArrayString"
+		}
+
+		return packageCall(prog, "", fname, stringType, args[0]), false
+	}
+
+	return nil, true
+}
+
+// transform Arrays.binarySearch(array, [from, to,] key, [comparator])
+func transformBinarySearch(prog *GoProgram, args []GoExpr) (GoObject, bool) {
+	if len(args) < 2 || len(args) > 5 {
+		return nil, true
+	}
+
+	slice := args[0]
+
+	var offset, key, cmp GoExpr
+	if len(args) >= 4 {
+		slice = &GoSliceExpr{x: args[0], low: args[1], high: args[2]}
+		if !isZero(args[1]) {
+			offset = args[1]
+		}
+		args = args[3:]
+	} else {
+		args = args[1:]
+	}
+
+	key = args[0]
+	if len(args) == 2 {
+		cmp = args[1]
+	}
+
+	var elem *TypeData
+	if td := slice.VarType(); td != nil {
+		elem = td.elementType()
+	}
+
+	var search GoExpr
+	if cmp != nil {
+		search = packageCall(prog, "slices", "BinarySearchFunc", intType,
+			slice, key, newGoComparator(prog, cmp))
+	} else if isValueType(elem) {
+		search = packageCall(prog, "slices", "BinarySearch", intType,
+			slice, key)
+	} else if elem != nil && elem.vtype == VT_CLASS {
+		cd, ok := prog.findClass(elem.vclass).(*GoClassDefinition)
+		if !ok || cd.compareMethod() == nil {
+			return nil, true
+		}
+
+		search = packageCall(prog, "slices", "BinarySearchFunc", intType,
+			slice, key, &GoMethodFunc{class: cd, method: cd.compareMethod()})
+	} else {
+		return nil, true
+	}
+
+	return &GoBinarySearch{search: search, offset: offset}, false
+}
+
+var byteBufferType = &TypeData{vtype: VT_CLASS, vclass: "ByteBuffer"}
+
+// ByteBuffer methods which are translated directly to helper methods
+var byteBufferMethods = map[string]string{
+	"array":        "Array",
+	"capacity":     "Capacity",
+	"remaining":    "Remaining",
+	"hasRemaining": "HasRemaining",
+	"mark":         "Mark",
+	"reset":        "Reset",
+	"clear":        "Clear",
+	"flip":         "Flip",
+	"rewind":       "Rewind",
+	"compact":      "Compact",
+	"slice":        "Slice",
+	"duplicate":    "Duplicate",
+}
+
+// value types read and written by the ByteBuffer getXXX/putXXX methods
+var byteBufferValues = map[string]*TypeData{
+	"Char":   charType,
+	"Short":  shortType,
+	"Int":    intType,
+	"Long":   longType,
+	"Float":  floatType,
+	"Double": doubleType,
+}
+
+// return the encoding/binary value which replaces a java.nio.ByteOrder
+func byteOrder(prog *GoProgram, name string) GoExpr {
+	var order string
+	switch name {
+	case "ByteOrder.BIG_ENDIAN":
+		order = "BigEndian"
+	case "ByteOrder.LITTLE_ENDIAN":
+		order = "LittleEndian"
+	case "ByteOrder.nativeOrder":
+		order = "NativeEndian"
+	default:
+		return nil
+	}
+
+	prog.addImport("encoding/binary", "")
+
+	return &GoPkgName{pkg: "binary", name: order}
+}
+
+// return 'expr' converted to 'td' if it is a primitive of another type
+// (literals are untyped constants and are never converted)
+func convertValue(expr GoExpr, td *TypeData) GoExpr {
+	if _, ok := expr.(*GoLiteral); ok {
+		return expr
+	}
+
+	if etype := expr.VarType(); etype != nil && etype.isPrimitive() &&
+		etype.vtype != td.vtype {
+		return NewGoTypeConversion(expr, td)
+	}
+
+	return expr
+}
+
+// return a call to method 'name' on 'obj'
+func methodCall(obj GoExpr, name string, rtntype *TypeData,
+	args ...GoExpr) GoExpr {
+	return &GoMethodAccessExpr{expr: obj,
+		method: NewGoFakeMethod(nil, name, rtntype),
+		args:   &GoMethodArguments{args: args}}
+}
+
+// transform java.nio.ByteBuffer into a generated ByteBuffer type which
+// reads and writes values with encoding/binary
+func TransformByteBuffer(parent GoObject, prog *GoProgram, cls GoClass,
+	object GoObject) (GoObject, bool) {
+	var obj GoExpr
+	var mthd GoMethod
+	var args []GoExpr
+	switch macc := object.(type) {
+	case *FakeVar:
+		if expr := byteOrder(prog, macc.name); expr != nil {
+			return expr, false
+		}
+
+		return nil, true
+	case *GoMethodAccess:
+		if isStaticCall(macc, "ByteOrder", "nativeOrder") {
+			return byteOrder(prog, "ByteOrder.nativeOrder"), false
+		}
+
+		if isStaticCall(macc, "ByteBuffer", "allocate") ||
+			isStaticCall(macc, "ByteBuffer", "allocateDirect") {
+			if macc.args.Length() != 1 {
+				return nil, true
+			}
+
+			prog.addHelper("ByteBuffer", byteBufferHelper)
+
+			array := &GoArrayAlloc{typedata: byteType,
+				args: []GoExpr{macc.args.args[0]}}
+			return packageCall(prog, "", "NewByteBuffer", byteBufferType,
+				array), false
+		}
+
+		if !isStaticCall(macc, "ByteBuffer", "wrap") {
+			return nil, true
+		}
+
+		prog.addHelper("ByteBuffer", byteBufferHelper)
+
+		args := macc.args.args
+		switch len(args) {
+		case 1:
+			return packageCall(prog, "", "NewByteBuffer", byteBufferType,
+				args[0]), false
+		case 3:
+			// wrap(array, offset, length) covers the entire array
+			var bb GoExpr = packageCall(prog, "", "NewByteBuffer",
+				byteBufferType, args[0])
+			bb = methodCall(bb, "SetPosition", byteBufferType, args[1])
+			return methodCall(bb, "SetLimit", byteBufferType,
+				&GoBinaryExpr{x: args[1], op: token.ADD, y: args[2]}), false
+		}
+
+		log.Printf("//ERR// Cannot convert ByteBuffer.wrap() with %d args\n",
+			len(args))
+		return nil, true
+	case *GoMethodAccessVar:
+		obj, mthd, args = macc.govar, macc.method, macc.args.args
+	case *GoMethodAccessExpr:
+		if macc.method == nil || macc.call_value {
+			return nil, true
+		}
+
+		obj, mthd, args = macc.expr, macc.method, macc.args.args
+	default:
+		return nil, true
+	}
+
+	if td := obj.VarType(); td == nil || !td.IsClass("ByteBuffer") {
+		return nil, true
+	}
+
+	prog.addHelper("ByteBuffer", byteBufferHelper)
+
+	name := mthd.Name()
+	if goname, ok := byteBufferMethods[name]; ok && len(args) == 0 {
+		var rtntype *TypeData
+		switch name {
+		case "array":
+			rtntype = NewTypeDataPrimitive("byte", 1)
+		case "capacity", "remaining":
+			rtntype = intType
+		case "hasRemaining":
+			rtntype = boolType
+		default:
+			rtntype = byteBufferType
+		}
+
+		return methodCall(obj, goname, rtntype), false
+	}
+
+	switch {
+	case name == "position" || name == "limit":
+		goname := strings.ToUpper(name[:1]) + name[1:]
+		if len(args) == 0 {
+			return methodCall(obj, goname, intType), false
+		} else if len(args) == 1 {
+			return methodCall(obj, "Set"+goname, byteBufferType,
+				args[0]), false
+		}
+	case name == "order":
+		if len(args) == 0 {
+			return methodCall(obj, "Order", nil), false
+		} else if len(args) == 1 {
+			return methodCall(obj, "SetOrder", byteBufferType,
+				args[0]), false
+		}
+	case name == "get":
+		switch len(args) {
+		case 0:
+			return methodCall(obj, "Get", byteType), false
+		case 1:
+			if td := args[0].VarType(); td != nil && td.vtype == VT_ARRAY {
+				return methodCall(obj, "GetBytes", byteBufferType,
+					args[0]), false
+			}
+
+			return methodCall(obj, "GetAt", byteType, args[0]), false
+		case 3:
+			return methodCall(obj, "GetBytes", byteBufferType,
+				sliceRange(args[0], args[1], args[2])), false
+		}
+	case name == "put":
+		switch len(args) {
+		case 1:
+			if td := args[0].VarType(); td != nil && td.vtype == VT_ARRAY {
+				return methodCall(obj, "PutBytes", byteBufferType,
+					args[0]), false
+			} else if td != nil && td.IsClass("ByteBuffer") {
+				break
+			}
+
+			return methodCall(obj, "Put", byteBufferType,
+				convertValue(args[0], byteType)), false
+		case 2:
+			return methodCall(obj, "PutAt", byteBufferType, args[0],
+				convertValue(args[1], byteType)), false
+		case 3:
+			return methodCall(obj, "PutBytes", byteBufferType,
+				sliceRange(args[0], args[1], args[2])), false
+		}
+	case strings.HasPrefix(name, "get") && byteBufferValues[name[3:]] != nil:
+		goname := "G" + name[1:]
+		rtntype := byteBufferValues[name[3:]]
+		if len(args) == 0 {
+			return methodCall(obj, goname, rtntype), false
+		} else if len(args) == 1 {
+			return methodCall(obj, goname+"At", rtntype, args[0]), false
+		}
+	case strings.HasPrefix(name, "put") && byteBufferValues[name[3:]] != nil:
+		goname := "P" + name[1:]
+		vtype := byteBufferValues[name[3:]]
+		if len(args) == 1 {
+			return methodCall(obj, goname, byteBufferType,
+				convertValue(args[0], vtype)), false
+		} else if len(args) == 2 {
+			return methodCall(obj, goname+"At", byteBufferType, args[0],
+				convertValue(args[1], vtype)), false
+		}
+	}
+
+	log.Printf("//ERR// Cannot convert ByteBuffer.%v() with %d args\n", name,
+		len(args))
+	return nil, true
+}
+
+var patternType = &TypeData{vtype: VT_CLASS, vclass: "Pattern"}
+var matcherType = &TypeData{vtype: VT_CLASS, vclass: "Matcher"}
+
+// java.util.regex.Pattern flags and the equivalent RE2 flags
+var patternFlags = map[string]string{
+	"Pattern.CASE_INSENSITIVE": "i",
+	"Pattern.MULTILINE":        "m",
+	"Pattern.DOTALL":           "s",
+}
+
+// Matcher methods which are translated directly to helper methods, and
+// the types they return
+var matcherMethods = map[string]*TypeData{
+	"matches":    boolType,
+	"lookingAt":  boolType,
+	"find":       boolType,
+	"groupCount": intType,
+	"reset":      matcherType,
+}
+
+// return the value of a string literal, or false if 'expr' is not one
+func stringLiteral(expr GoExpr) (string, bool) {
+	lit, ok := expr.(*GoLiteral)
+	if !ok || lit.text[0] != '"' {
+		return "", false
+	}
+
+	str, err := strconv.Unquote(lit.text)
+	return str, err == nil
+}
+
+// report a literal pattern which uses syntax that RE2 does not support,
+// such as backreferences, lookaround and possessive quantifiers
+func checkPattern(cls GoClass, caller string, expr GoExpr) {
+	pat, ok := stringLiteral(expr)
+	if !ok {
+		return
+	}
+
+	if _, err := syntax.Parse(pat, syntax.Perl); err != nil {
+		var clsname string
+		if cls != nil && !cls.IsNil() {
+			clsname = cls.Name() + ": "
+		}
+
+		log.Printf("//ERR// %s%s() pattern %q is not supported by Go: %v\n",
+			clsname, caller, pat, unsupportedSyntax(err))
+	}
+}
+
+// name the Java regex feature which RE2 rejected, if it's one which Go
+// doesn't support
+func unsupportedSyntax(err error) string {
+	serr, ok := err.(*syntax.Error)
+	if !ok {
+		return err.Error()
+	}
+
+	switch serr.Code {
+	case syntax.ErrInvalidEscape:
+		if len(serr.Expr) == 2 && serr.Expr[1] >= '1' && serr.Expr[1] <= '9' {
+			return "backreference " + serr.Expr
+		}
+	case syntax.ErrInvalidPerlOp, syntax.ErrInvalidNamedCapture:
+		for _, op := range []string{"(?=", "(?!", "(?<=", "(?<!"} {
+			if strings.HasPrefix(serr.Expr, op) {
+				return "lookaround " + op
+			}
+		}
+	case syntax.ErrInvalidRepeatOp:
+		if strings.HasSuffix(serr.Expr, "+") {
+			return "possessive quantifier " + serr.Expr
+		}
+	}
+
+	return err.Error()
+}
+
+// return 'pat' wrapped in 'prefix' and 'suffix'
+func wrapPattern(pat GoExpr, prefix string, suffix string) GoExpr {
+	if str, ok := stringLiteral(pat); ok {
+		return NewGoLiteral(strconv.Quote(prefix + str + suffix))
+	}
+
+	var expr GoExpr = pat
+	if prefix != "" {
+		expr = &GoBinaryExpr{x: NewGoLiteral(strconv.Quote(prefix)),
+			op: token.ADD, y: expr}
+	}
+	if suffix != "" {
+		expr = &GoBinaryExpr{x: expr, op: token.ADD,
+			y: NewGoLiteral(strconv.Quote(suffix))}
+	}
+
+	return expr
+}
+
+// return the RE2 flags for 'Pattern.X | Pattern.Y'
+func patternFlagString(expr GoExpr) (string, bool) {
+	switch flag := expr.(type) {
+	case *FakeVar:
+		str, ok := patternFlags[flag.name]
+		return str, ok
+	case *GoBinaryExpr:
+		if flag.op == token.OR {
+			x, xok := patternFlagString(flag.x)
+			y, yok := patternFlagString(flag.y)
+			return x + y, xok && yok
+		}
+	}
+
+	return "", false
+}
+
+// return "regexp.MustCompile(pat)"
+func compilePattern(prog *GoProgram, pat GoExpr) GoExpr {
+	prog.addHelper("Pattern", patternHelper)
+
+	return packageCall(prog, "regexp", "MustCompile", patternType, pat)
+}
+
+// convert a Java replacement string into a Go template, so "$1x" becomes
+// "${1}x" and "\\$" becomes "$$"
+func convertReplacement(expr GoExpr) GoExpr {
+	repl, ok := stringLiteral(expr)
+	if !ok {
+		return expr
+	}
+
+	b := &strings.Builder{}
+	for i := 0; i < len(repl); i++ {
+		switch {
+		case repl[i] == '\\' && i+1 < len(repl):
+			i++
+			if repl[i] == '$' {
+				b.WriteString("$$")
+			} else {
+				b.WriteByte(repl[i])
+			}
+		case repl[i] == '$' && i+1 < len(repl) && repl[i+1] >= '0' &&
+			repl[i+1] <= '9':
+			j := i + 1
+			for j < len(repl) && repl[j] >= '0' && repl[j] <= '9' {
+				j++
+			}
+			b.WriteString("${" + repl[i+1:j] + "}")
+			i = j - 1
+		default:
+			b.WriteByte(repl[i])
+		}
+	}
+
+	return NewGoLiteral(strconv.Quote(b.String()))
+}
+
+// return "re.Split(s, n)" for Java's split() with a non-zero literal
+// limit, otherwise "SplitPattern(re, s, limit)" which drops trailing empty
+// strings when the limit is zero or missing, as Java does
+func splitCall(prog *GoProgram, re GoExpr, s GoExpr, args []GoExpr) GoExpr {
+	rtntype := NewTypeDataPrimitive("String", 1)
+
+	var limit GoExpr = NewGoLiteral("0")
+	if len(args) == 2 {
+		limit = args[1]
+		if lit, ok := limit.(*GoLiteral); ok && lit.text != "0" {
+			return methodCall(re, "Split", rtntype, s, limit)
+		}
+	}
+
+	prog.addHelper("Split", splitHelper)
+
+	return packageCall(prog, "", "SplitPattern", rtntype, re, s, limit)
+}
+
+// transform java.util.regex.Pattern and Matcher, along with the String
+// methods which take a regular expression, into the 'regexp' package
+func TransformRegexp(parent GoObject, prog *GoProgram, cls GoClass,
+	object GoObject) (GoObject, bool) {
+	var obj GoExpr
+	var mthd GoMethod
+	var args []GoExpr
+	switch macc := object.(type) {
+	case *GoMethodAccess:
+		return transformPatternStatic(prog, cls, macc)
+	case *GoMethodAccessVar:
+		obj, mthd, args = macc.govar, macc.method, macc.args.args
+	case *GoMethodAccessExpr:
+		if macc.method == nil || macc.call_value {
+			return nil, true
+		}
+
+		obj, mthd, args = macc.expr, macc.method, macc.args.args
+	default:
+		return nil, true
+	}
+
+	td := obj.VarType()
+	if td == nil {
+		return nil, true
+	}
+
+	name := mthd.Name()
+	switch {
+	case td.vtype == VT_STRING && td.array_dims == 0:
+		switch {
+		case name == "matches" && len(args) == 1:
+			checkPattern(cls, "String.matches", args[0])
+			return methodCall(compilePattern(prog,
+				wrapPattern(args[0], "^(?:", ")$")), "MatchString",
+				boolType, obj), false
+		case name == "replaceAll" && len(args) == 2:
+			checkPattern(cls, "String.replaceAll", args[0])
+			return methodCall(compilePattern(prog, args[0]),
+				"ReplaceAllString", stringType, obj,
+				convertReplacement(args[1])), false
+		case name == "split" && (len(args) == 1 || len(args) == 2):
+			checkPattern(cls, "String.split", args[0])
+			return splitCall(prog, compilePattern(prog, args[0]), obj,
+				args), false
+		}
+	case td.IsClass("Pattern"):
+		prog.addHelper("Pattern", patternHelper)
+
+		switch {
+		case name == "matcher" && len(args) == 1:
+			prog.addHelper("Matcher", matcherHelper)
+			return packageCall(prog, "", "NewMatcher", matcherType, obj,
+				args[0]), false
+		case name == "split" && (len(args) == 1 || len(args) == 2):
+			return splitCall(prog, obj, args[0], args), false
+		case (name == "pattern" || name == "toString") && len(args) == 0:
+			return methodCall(obj, "String", stringType), false
+		}
+
+		log.Printf("//ERR// Cannot convert Pattern.%v() with %d args\n",
+			name, len(args))
+	case td.IsClass("Matcher"):
+		prog.addHelper("Matcher", matcherHelper)
+
+		goname := strings.ToUpper(name[:1]) + name[1:]
+		if rtntype, ok := matcherMethods[name]; ok && len(args) == 0 {
+			return methodCall(obj, goname, rtntype), false
+		}
+
+		switch name {
+		case "group", "start", "end":
+			var rtntype *TypeData
+			if name == "group" {
+				rtntype = stringType
+			} else {
+				rtntype = intType
+			}
+
+			if len(args) == 0 {
+				return methodCall(obj, goname, rtntype,
+					NewGoLiteral("0")), false
+			} else if len(args) == 1 {
+				return methodCall(obj, goname, rtntype, args[0]), false
+			}
+		}
+
+		log.Printf("//ERR// Cannot convert Matcher.%v() with %d args\n",
+			name, len(args))
+	}
+
+	return nil, true
+}
+
+func transformPatternStatic(prog *GoProgram, cls GoClass,
+	macc *GoMethodAccess) (GoObject, bool) {
+	args := macc.args.args
+	switch {
+	case isStaticCall(macc, "Pattern", "compile"):
+		if len(args) < 1 || len(args) > 2 {
+			break
+		}
+
+		checkPattern(cls, "Pattern.compile", args[0])
+
+		pat := args[0]
+		if len(args) == 2 {
+			flags, ok := patternFlagString(args[1])
+			if !ok {
+				log.Printf("//ERR// Cannot convert Pattern.compile()"+
+					" flags %v\n", args[1])
+			} else if flags != "" {
+				pat = wrapPattern(pat, "(?"+flags+")", "")
+			}
+		}
+
+		return compilePattern(prog, pat), false
+	case isStaticCall(macc, "Pattern", "matches"):
+		if len(args) != 2 {
+			break
+		}
+
+		checkPattern(cls, "Pattern.matches", args[0])
+		return methodCall(compilePattern(prog,
+			wrapPattern(args[0], "^(?:", ")$")), "MatchString", boolType,
+			args[1]), false
+	case isStaticCall(macc, "Pattern", "quote"):
+		if len(args) != 1 {
+			break
+		}
+
+		return packageCall(prog, "regexp", "QuoteMeta", stringType,
+			args[0]), false
+	default:
+		return nil, true
+	}
+
+	log.Printf("//ERR// Cannot convert Pattern.%v() with %d args\n",
+		macc.method.Name(), len(args))
+	return nil, true
+}
+
+// return the Go type which replaces Java library class 'name' and the
+// package which defines it, or "" if the class is not replaced
+func (gp *GoProgram) libraryType(name string) (string, string) {
+	if goname := gp.bigNumberType(name); goname != "" {
+		return goname, "math/big"
+	} else if isLoggerClass(name) {
+		// don't replace a Logger class defined by the program
+		if _, ok := gp.findClass(name).(*GoClassDefinition); !ok {
+			return "slog.Logger", "log/slog"
+		}
+	}
+
+	return "", ""
+}
+
+// return the math/big type which replaces java.math class 'name', or ""
+func (gp *GoProgram) bigNumberType(name string) string {
+	switch name {
+	case "BigInteger":
+		return "big.Int"
+	case "BigDecimal":
+		if gp.config.decimalType() == decimalModelRat {
+			return "big.Rat"
+		}
+
+		return "big.Float"
+	}
+
+	return ""
+}
+
+var bigIntType = &TypeData{vtype: VT_CLASS, vclass: "big.Int"}
+
+// BigInteger and BigDecimal methods which return a new value computed from
+// the receiver and any arguments
+var bigNumberOps = map[string]string{
+	"add":      "Add",
+	"subtract": "Sub",
+	"multiply": "Mul",
+	"divide":   "Quo",
+	"negate":   "Neg",
+	"abs":      "Abs",
+}
+
+// BigInteger-only methods which return a new value computed from the
+// receiver and any arguments
+var bigIntOps = map[string]string{
+	"mod":        "Mod",
+	"remainder":  "Rem",
+	"modInverse": "ModInverse",
+	"and":        "And",
+	"or":         "Or",
+	"xor":        "Xor",
+	"andNot":     "AndNot",
+	"not":        "Not",
+}
+
+// Java's BigInteger and BigDecimal constants
+var bigNumberConstants = map[string]string{
+	"BigInteger.ZERO": "0",
+	"BigInteger.ONE":  "1",
+	"BigInteger.TWO":  "2",
+	"BigInteger.TEN":  "10",
+	"BigDecimal.ZERO": "0",
+	"BigDecimal.ONE":  "1",
+	"BigDecimal.TEN":  "10",
+}
+
+// first result of a call which also returns an accuracy, a success flag
+// or an error; if 'parsed' is not empty, a failure to parse that type
+// panics, otherwise the second result is ignored
+type GoFirstResult struct {
+	call   GoExpr
+	vtype  *TypeData
+	parsed string
+}
+
+func (gfr *GoFirstResult) Expr() ast.Expr {
+	v := ast.NewIdent("v")
+	if gfr.parsed == "" {
+		return callFuncLit(gfr.vtype.Expr(),
+			&ast.AssignStmt{Lhs: []ast.Expr{v, ast.NewIdent("_")},
+				Tok: token.DEFINE, Rhs: []ast.Expr{gfr.call.Expr()}},
+			&ast.ReturnStmt{Results: []ast.Expr{v}})
+	}
+
+	ok := ast.NewIdent("ok")
+	msg := &ast.BasicLit{Kind: token.STRING,
+		Value: strconv.Quote("Cannot parse " + gfr.parsed)}
+	fail := &ast.ExprStmt{X: &ast.CallExpr{Fun: ast.NewIdent("panic"),
+		Args: []ast.Expr{msg}}}
+
+	return callFuncLit(gfr.vtype.Expr(),
+		&ast.AssignStmt{Lhs: []ast.Expr{v, ok}, Tok: token.DEFINE,
+			Rhs: []ast.Expr{gfr.call.Expr()}},
+		&ast.IfStmt{Cond: &ast.UnaryExpr{Op: token.NOT, X: ok},
+			Body: &ast.BlockStmt{List: []ast.Stmt{fail}}},
+		&ast.ReturnStmt{Results: []ast.Expr{v}})
+}
+
+func (gfr *GoFirstResult) hasVariable(govar GoVar) bool {
+	return gfr.call.hasVariable(govar)
+}
+
+func (gfr *GoFirstResult) Init() ast.Stmt {
+	return nil
+}
+
+func (gfr *GoFirstResult) RunTransform(xform TransformFunc, prog *GoProgram,
+	cls GoClass, parent GoObject) (GoObject, bool) {
+	obj, is_nil := gfr.call.RunTransform(xform, prog, cls, gfr)
+	if !is_nil {
+		var err error
+		if gfr.call, err = convertToExpr(obj); err != nil {
+			panic(err)
+		}
+	}
+
+	return xform(parent, prog, cls, gfr)
+}
+
+func (gfr *GoFirstResult) String() string {
+	return fmt.Sprintf("GoFirstResult[%v|%v|%v]", gfr.call, gfr.vtype,
+		gfr.parsed)
+}
+
+func (gfr *GoFirstResult) VarType() *TypeData {
+	return gfr.vtype
+}
+
+// return "new(big.X)" for math/big type 'td'
+func newBigNumber(prog *GoProgram, td *TypeData) GoExpr {
+	return packageCall(prog, "", "new", td, &GoPkgName{pkg: "big",
+		name: strings.TrimPrefix(td.vclass, "big.")})
+}
+
+// return the math/big value for 'arg', which may be a string, an integer,
+// a floating point value or a *big.Int
+func newBigValue(prog *GoProgram, td *TypeData, clsname string,
+	arg GoExpr) GoExpr {
+	atype := arg.VarType()
+	switch {
+	case atype != nil && atype.vtype == VT_STRING && atype.array_dims == 0:
+		args := []GoExpr{arg}
+		if td.IsClass(bigIntType.vclass) {
+			args = append(args, NewGoLiteral("10"))
+		}
+
+		return &GoFirstResult{call: methodCall(newBigNumber(prog, td),
+			"SetString", nil, args...), vtype: td, parsed: clsname}
+	case atype != nil && atype.IsClass(bigIntType.vclass):
+		return methodCall(newBigNumber(prog, td), "SetInt", td, arg)
+	case isIntegerType(atype):
+		return methodCall(newBigNumber(prog, td), "SetInt64", td,
+			convertValue(arg, longType))
+	}
+
+	return methodCall(newBigNumber(prog, td), "SetFloat64", td,
+		convertValue(arg, doubleType))
+}
+
+// transform java.math.BigInteger and BigDecimal into math/big types,
+// allocating a new value for each result so operands are never modified
+func TransformBigNumbers(parent GoObject, prog *GoProgram, cls GoClass,
+	object GoObject) (GoObject, bool) {
+	var obj GoExpr
+	var mthd GoMethod
+	var args []GoExpr
+	switch macc := object.(type) {
+	case *FakeVar:
+		if val, ok := bigNumberConstants[macc.name]; ok {
+			td := &TypeData{vtype: VT_CLASS,
+				vclass: prog.bigNumberType(macc.name[:10])}
+			if td.IsClass(bigIntType.vclass) {
+				return packageCall(prog, "math/big", "NewInt", td,
+					NewGoLiteral(val)), false
+			}
+
+			return methodCall(newBigNumber(prog, td), "SetInt64", td,
+				NewGoLiteral(val)), false
+		}
+
+		return nil, true
+	case *GoClassAlloc:
+		clsname := macc.class.Name()
+		goname := prog.bigNumberType(clsname)
+		if goname == "" {
+			return nil, true
+		}
+
+		td := &TypeData{vtype: VT_CLASS, vclass: goname}
+		switch {
+		case len(macc.args) == 1:
+			return newBigValue(prog, td, clsname, macc.args[0]), false
+		case len(macc.args) == 2 && clsname == "BigInteger":
+			// new BigInteger(str, radix)
+			return &GoFirstResult{call: methodCall(newBigNumber(prog, td),
+				"SetString", nil, macc.args[0], macc.args[1]), vtype: td,
+				parsed: clsname}, false
+		}
+
+		log.Printf("//ERR// Cannot convert new %v() with %d args\n",
+			clsname, len(macc.args))
+		return nil, true
+	case *GoMethodAccess:
+		if isStaticCall(macc, "BigInteger", "valueOf") &&
+			macc.args.Length() == 1 {
+			return packageCall(prog, "math/big", "NewInt", bigIntType,
+				convertValue(macc.args.args[0], longType)), false
+		} else if isStaticCall(macc, "BigDecimal", "valueOf") &&
+			macc.args.Length() == 1 {
+			td := &TypeData{vtype: VT_CLASS,
+				vclass: prog.bigNumberType("BigDecimal")}
+			return newBigValue(prog, td, "BigDecimal",
+				macc.args.args[0]), false
+		}
+
+		return nil, true
+	case *GoMethodAccessVar:
+		obj, mthd, args = macc.govar, macc.method, macc.args.args
+	case *GoMethodAccessExpr:
+		if macc.method == nil || macc.call_value {
+			return nil, true
+		}
+
+		obj, mthd, args = macc.expr, macc.method, macc.args.args
+	default:
+		return nil, true
+	}
+
+	td := obj.VarType()
+	if td == nil || td.vtype != VT_CLASS ||
+		!strings.HasPrefix(td.vclass, "big.") {
+		return nil, true
+	}
+
+	is_int := td.IsClass(bigIntType.vclass)
+	is_rat := td.vclass == "big.Rat"
+
+	name := mthd.Name()
+	goname, ok := bigNumberOps[name]
+	if !ok && is_int {
+		goname, ok = bigIntOps[name]
+	}
+	if ok {
+		unary := name == "negate" || name == "abs" || name == "not"
+		if (unary && len(args) == 0) || (!unary && len(args) == 1) {
+			return methodCall(newBigNumber(prog, td), goname, td,
+				append([]GoExpr{obj}, args...)...), false
+		}
+	}
+
+	switch {
+	case name == "compareTo" && len(args) == 1:
+		return methodCall(obj, "Cmp", intType, args[0]), false
+	case name == "equals" && len(args) == 1:
+		return &GoBinaryExpr{x: methodCall(obj, "Cmp", intType, args[0]),
+			op: token.EQL, y: NewGoLiteral("0")}, false
+	case name == "signum" && len(args) == 0:
+		return methodCall(obj, "Sign", intType), false
+	case name == "toString" && len(args) == 0:
+		if is_int {
+			return methodCall(obj, "String", stringType), false
+		} else if is_rat {
+			return methodCall(obj, "RatString", stringType), false
+		}
+
+		return methodCall(obj, "Text", stringType, NewGoLiteral("'f'"),
+			NewGoLiteral("-1")), false
+	case name == "doubleValue" && len(args) == 0:
+		if is_int {
+			obj = methodCall(newBigNumber(prog,
+				&TypeData{vtype: VT_CLASS, vclass: "big.Float"}), "SetInt",
+				nil, obj)
+		}
+
+		return &GoFirstResult{call: methodCall(obj, "Float64", nil),
+			vtype: doubleType}, false
+	case (name == "intValue" || name == "longValue") && len(args) == 0:
+		var val GoExpr
+		if is_int {
+			val = methodCall(obj, "Int64", longType)
+		} else if is_rat {
+			quo := methodCall(newBigNumber(prog, bigIntType), "Quo",
+				bigIntType, methodCall(obj, "Num", bigIntType),
+				methodCall(obj, "Denom", bigIntType))
+			val = methodCall(quo, "Int64", longType)
+		} else {
+			val = &GoFirstResult{call: methodCall(obj, "Int64", nil),
+				vtype: longType}
+		}
+
+		if name == "intValue" {
+			return NewGoTypeConversion(val, intType), false
+		}
+
+		return val, false
+	case name == "toBigInteger" && len(args) == 0 && !is_int:
+		if is_rat {
+			return methodCall(newBigNumber(prog, bigIntType), "Quo",
+				bigIntType, methodCall(obj, "Num", bigIntType),
+				methodCall(obj, "Denom", bigIntType)), false
+		}
+
+		return &GoFirstResult{call: methodCall(obj, "Int", nil,
+			NewGoLiteral("nil")), vtype: bigIntType}, false
+	case !is_int:
+		break
+	case name == "toString" && len(args) == 1:
+		return methodCall(obj, "Text", stringType, args[0]), false
+	case name == "pow" && len(args) == 1:
+		exp := packageCall(prog, "math/big", "NewInt", bigIntType,
+			convertValue(args[0], longType))
+		return methodCall(newBigNumber(prog, td), "Exp", td, obj, exp,
+			NewGoLiteral("nil")), false
+	case name == "modPow" && len(args) == 2:
+		return methodCall(newBigNumber(prog, td), "Exp", td, obj, args[0],
+			args[1]), false
+	case name == "gcd" && len(args) == 1:
+		return methodCall(newBigNumber(prog, td), "GCD", td,
+			NewGoLiteral("nil"), NewGoLiteral("nil"), obj, args[0]), false
+	case (name == "shiftLeft" || name == "shiftRight") && len(args) == 1:
+		goname := "Lsh"
+		if name == "shiftRight" {
+			goname = "Rsh"
+		}
+
+		return methodCall(newBigNumber(prog, td), goname, td, obj,
+			packageCall(prog, "", "uint", nil, args[0])), false
+	case name == "testBit" && len(args) == 1:
+		return &GoBinaryExpr{x: methodCall(obj, "Bit", nil, args[0]),
+			op: token.EQL, y: NewGoLiteral("1")}, false
+	case name == "bitLength" && len(args) == 0:
+		return methodCall(obj, "BitLen", intType), false
+	}
+
+	log.Printf("//ERR// Cannot convert %v.%v() with %d args\n", td.vclass,
+		name, len(args))
+	return nil, true
+}
+
+var slogLoggerType = &TypeData{vtype: VT_CLASS, vclass: "slog.Logger"}
+
+// return true if 'name' is a log4j, java.util.logging or SLF4J logger
+func isLoggerClass(name string) bool {
+	switch name {
+	case "Logger", "java.util.logging.Logger", "org.apache.log4j.Logger",
+		"org.apache.logging.log4j.Logger", "org.slf4j.Logger":
+		return true
+	}
+
+	return false
+}
+
+// return true if 'name' is a class whose getLogger() creates a logger
+func isLoggerFactory(name string) bool {
+	return isLoggerClass(name) || name == "LogManager" ||
+		name == "LoggerFactory" || strings.HasSuffix(name, ".LogManager") ||
+		strings.HasSuffix(name, ".LoggerFactory")
+}
+
+// logging methods and the slog levels they use
+var loggerLevels = map[string]string{
+	"trace":   "Debug",
+	"debug":   "Debug",
+	"info":    "Info",
+	"warn":    "Warn",
+	"error":   "Error",
+	"fatal":   "Error",
+	"finest":  "Debug",
+	"finer":   "Debug",
+	"fine":    "Debug",
+	"config":  "Info",
+	"warning": "Warn",
+	"severe":  "Error",
+}
+
+// methods which check whether a level is enabled, and the slog levels
+var loggerEnabledLevels = map[string]string{
+	"isTraceEnabled": "Debug",
+	"isDebugEnabled": "Debug",
+	"isInfoEnabled":  "Info",
+	"isWarnEnabled":  "Warn",
+	"isErrorEnabled": "Error",
+}
+
+// return the slog level for a log4j or java.util.logging Level constant
+func loggerLevel(expr GoExpr) string {
+	if fv, ok := expr.(*FakeVar); ok && strings.HasPrefix(fv.name, "Level.") {
+		name := strings.ToLower(fv.name[6:])
+		if name == "warning" {
+			return "Warn"
+		}
+
+		return loggerLevels[name]
+	}
+
+	return ""
+}
+
+// return true if 'expr' is an exception
+func isThrowable(expr GoExpr) bool {
+	td := expr.VarType()
+	return td != nil && td.vtype == VT_CLASS &&
+		(strings.HasSuffix(td.vclass, "Exception") ||
+			strings.HasSuffix(td.vclass, "Error") ||
+			td.vclass == "Throwable")
+}
+
+// return a slog call at 'level' which logs 'msg' and an optional exception
+func loggerCall(prog *GoProgram, obj GoExpr, level string, msg GoExpr,
+	thrown GoExpr) GoExpr {
+	if td := msg.VarType(); td != nil && td != stringType {
+		// log4j messages may be any object
+		msg = packageCall(prog, "fmt", "Sprint", stringType, msg)
+	}
+
+	args := []GoExpr{msg}
+	if thrown != nil {
+		args = append(args, NewGoLiteral("\"err\""), thrown)
+	}
+
+	return methodCall(obj, level, nil, args...)
+}
+
+// return "obj.Enabled(context.Background(), slog.Level<level>)"
+func loggerEnabled(prog *GoProgram, obj GoExpr, level string) GoExpr {
+	ctx := packageCall(prog, "context", "Background", nil)
+	return methodCall(obj, "Enabled", boolType, ctx,
+		&GoPkgName{pkg: "slog", name: "Level" + level})
+}
+
+// transform log4j, java.util.logging and SLF4J loggers into a *slog.Logger
+// with a "component" attribute naming the class
+func TransformLogging(parent GoObject, prog *GoProgram, cls GoClass,
+	object GoObject) (GoObject, bool) {
+	var obj GoExpr
+	var mthd GoMethod
+	var args []GoExpr
+	switch macc := object.(type) {
+	case *GoMethodAccess:
+		if macc.obj != nil || macc.method == nil ||
+			macc.method.Name() != "getLogger" || macc.args.Length() != 1 {
+			return nil, true
+		}
+
+		mcls := macc.method.Class()
+		if mcls == nil || mcls.IsNil() || !isLoggerFactory(mcls.Name()) {
+			return nil, true
+		}
+
+		name := macc.args.args[0]
+		if lit, ok := name.(*GoClassLiteral); ok {
+			name = NewGoLiteral(strconv.Quote(lit.SimpleName()))
+		}
+
+		dflt := packageCall(prog, "log/slog", "Default", slogLoggerType)
+		return methodCall(dflt, "With", slogLoggerType,
+			NewGoLiteral("\"component\""), name), false
+	case *GoMethodAccessVar:
+		obj, mthd, args = macc.govar, macc.method, macc.args.args
+	case *GoMethodAccessExpr:
+		if macc.method == nil || macc.call_value {
+			return nil, true
+		}
+
+		obj, mthd, args = macc.expr, macc.method, macc.args.args
+	default:
+		return nil, true
+	}
+
+	if td := obj.VarType(); td == nil || !td.IsClass(slogLoggerType.vclass) {
+		return nil, true
+	}
+
+	name := mthd.Name()
+	if level, ok := loggerLevels[name]; ok {
+		switch {
+		case len(args) == 1:
+			return loggerCall(prog, obj, level, args[0], nil), false
+		case len(args) == 2 && isThrowable(args[1]):
+			return loggerCall(prog, obj, level, args[0], args[1]), false
+		}
+	}
+
+	switch {
+	case name == "log" && (len(args) == 2 || len(args) == 3):
+		level := loggerLevel(args[0])
+		if level != "" && len(args) == 2 {
+			return loggerCall(prog, obj, level, args[1], nil), false
+		} else if level != "" && isThrowable(args[2]) {
+			return loggerCall(prog, obj, level, args[1], args[2]), false
+		}
+	case (name == "isLoggable" || name == "isEnabledFor") && len(args) == 1:
+		if level := loggerLevel(args[0]); level != "" {
+			return loggerEnabled(prog, obj, level), false
+		}
+	case len(args) == 0:
+		if level, ok := loggerEnabledLevels[name]; ok {
+			return loggerEnabled(prog, obj, level), false
+		}
+	}
+
+	log.Printf("//ERR// Cannot convert logger %v() with %d args\n", name,
+		len(args))
+	return nil, true
+}
+
+// transform String character methods using the configured char model
+// ('rune' indexes strings as '[]rune(s)', 'byte' indexes them directly)
+func TransformStringChars(parent GoObject, prog *GoProgram, cls GoClass,
+	object GoObject) (GoObject, bool) {
+	switch obj := object.(type) {
+	case *GoMethodAccessVar:
+		if !isStringType(obj.govar.VarType()) {
+			return nil, true
+		}
+
+		return transformStringCharMethod(prog, obj.govar, obj.method,
+			obj.args)
+	case *GoMethodAccessExpr:
+		// methods called on fields or on the results of other calls
+		if obj.method == nil || obj.call_value || !isStringExpr(obj.expr) {
+			return nil, true
+		}
+
+		return transformStringCharMethod(prog, obj.expr, obj.method,
+			obj.args)
+	case *GoMethodAccess:
+		if obj.obj != nil || obj.method == nil {
+			return nil, true
+		}
+
+		return transformStringValueOf(obj)
+	}
+
+	return nil, true
+}
+
+// String methods which return another string
+var stringResults = map[string]bool{"concat": true, "intern": true,
+	"repeat": true, "replace": true, "replaceAll": true, "strip": true,
+	"substring": true, "toLowerCase": true, "toString": true,
+	"toUpperCase": true, "trim": true}
+
+// return true if 'expr' is a string, including calls to String methods
+// whose result types aren't known
+func isStringExpr(expr GoExpr) bool {
+	if isStringType(expr.VarType()) {
+		return true
+	}
+
+	switch e := expr.(type) {
+	case *GoMethodAccessVar:
+		return e.method != nil && stringResults[e.method.Name()] &&
+			isStringExpr(e.govar)
+	case *GoMethodAccessExpr:
+		return e.method != nil && !e.call_value &&
+			stringResults[e.method.Name()] && isStringExpr(e.expr)
+	}
+
+	return false
+}
+
+func transformStringCharMethod(prog *GoProgram, str GoExpr, mthd GoMethod,
+	margs *GoMethodArguments) (GoObject, bool) {
+	chararray := &TypeData{vtype: VT_ARRAY, type1: charType, array_dims: 1}
+
+	switch mthd.Name() {
+	case "charAt":
+		if len(margs.args) != 1 {
+			log.Printf("//ERR// Cannot convert String charAt() with %d args\n",
+				len(margs.args))
+			return nil, true
+		}
+
+		var obj GoExpr
+		if prog.config.isByteCharModel() {
+			obj = str
+		} else {
+			obj = NewGoTypeConversion(str, chararray)
+		}
+
+		return &GoArrayReference{obj: obj, index: margs.args[0]}, false
+	case "length":
+		if len(margs.args) != 0 {
+			log.Printf("//ERR// Cannot convert String length() with %d args\n",
+				len(margs.args))
+			return nil, true
+		}
+
+		args := &GoMethodArguments{args: []GoExpr{str}}
+		if prog.config.isByteCharModel() {
+			fm := NewGoFakeMethod(nil, "len", intType)
+			return &GoMethodAccess{method: fm, args: args}, false
+		}
+
+		utf8cls := getPackageClass(prog, "unicode/utf8")
+		fm := NewGoFakeMethod(utf8cls, "RuneCountInString", intType)
+		return &GoMethodAccess{method: fm, args: args}, false
+	case "toCharArray":
+		if len(margs.args) != 0 {
+			log.Printf("//ERR// Cannot convert String toCharArray() with"+
+				" %d args\n", len(margs.args))
+			return nil, true
+		}
+
+		return NewGoTypeConversion(str, chararray), false
+	}
+
+	return nil, true
+}
+
+func transformStringValueOf(macc *GoMethodAccess) (GoObject, bool) {
+	mcls := macc.method.Class()
+	if mcls == nil || mcls.IsNil() {
+		return nil, true
+	}
+
+	clsname := mcls.Name()
+	name := macc.method.Name()
+	if (clsname != "String" || name != "valueOf") &&
+		(clsname != "Character" || name != "toString") {
+		return nil, true
+	}
+
+	if macc.args.Length() != 1 {
+		return nil, true
+	}
+
+	arg := macc.args.args[0]
+
+	td := arg.VarType()
+	if td == nil {
+		return nil, true
+	} else if td == charType ||
+		(td.vtype == VT_ARRAY && td.array_dims == 1 && td.type1 == charType) {
+		return NewGoTypeConversion(arg, stringType), false
+	}
+
+	return nil, true
+}
+
+// transform String.format method call into fmt.Sprintf
+func TransformStringFormat(parent GoObject, prog *GoProgram, cls GoClass,
+	object GoObject) (GoObject, bool) {
+
+	var mref *GoMethodReference
+	var ok bool
+	if mref, ok = object.(*GoMethodReference); !ok {
+		return nil, true
+	}
+
+	if mref.name == "format" && !mref.class.IsNil() &&
+		mref.class.Name() == "String" {
+		fmtcls := getFmtClass(prog)
+
+		return NewGoMethodReference(fmtcls, "Sprintf", mref.args, false), false
+	}
+
+	return nil, true
+}
+
+// transform string addition into fmt.Sprintf
+func TransformStringAddition(parent GoObject, prog *GoProgram, cls GoClass,
+	object GoObject) (GoObject, bool) {
+
+	var bex *GoBinaryExpr
+	var ok bool
+	if bex, ok = object.(*GoBinaryExpr); !ok {
+		return nil, true
+	}
+
+	var x GoTypedObject
+	if x, ok = bex.x.(GoTypedObject); !ok {
+		return nil, true
+	}
+
+	if x.VarType() != stringType {
+		return nil, true
+	}
+
+	if isConstantExpr(bex) {
+		// constant strings can be added directly
+		return nil, true
 	}
 
 	return transformBinaryStringExpression(prog, bex)
@@ -414,6 +2585,15 @@ func joinStrings(s1 string, s2 string) string {
 	return s
 }
 
+// return the quoted fmt verb used to print 'expr' as Java would
+func formatVerb(expr GoExpr) string {
+	if expr.VarType() == charType {
+		return "\"%c\""
+	}
+
+	return "\"%v\""
+}
+
 func transformBinaryStringExpression(prog *GoProgram, bex *GoBinaryExpr) (GoObject, bool) {
 	if bex.op != token.ADD {
 		return nil, true
@@ -442,8 +2622,8 @@ func transformBinaryStringExpression(prog *GoProgram, bex *GoBinaryExpr) (GoObje
 					" *GoLiteral not %T\n", x.args.args[0])
 				return nil, true
 			} else {
-				x.args.args[0] = NewGoLiteral(joinStrings("\"%v\"",
-					fmtstr.text))
+				x.args.args[0] = NewGoLiteral(joinStrings(fmtstr.text,
+					formatVerb(bex.y)))
 			}
 			x.args.args = append(x.args.args, bex.y)
 			return x, false
@@ -452,7 +2632,7 @@ func transformBinaryStringExpression(prog *GoProgram, bex *GoBinaryExpr) (GoObje
 
 	fmtcls := getFmtClass(prog)
 
-	fmtstr := NewGoLiteral("\"%v%v\"")
+	fmtstr := NewGoLiteral(joinStrings(formatVerb(bex.x), formatVerb(bex.y)))
 	args := &GoMethodArguments{args: []GoExpr{fmtstr, bex.x, bex.y}}
 
 	mthd := fmtcls.FindMethod("Sprintf", args)
@@ -471,7 +2651,16 @@ var StandardRules = []TransformFunc{
 	TransformMainArgs,
 	TransformThisArg,
 	TransformListMethods,
+	TransformBigNumbers,
 	TransformToString,
+	TransformObjectMethods,
+	TransformSort,
+	TransformArrays,
+	TransformByteBuffer,
+	TransformStreams,
+	TransformRegexp,
+	TransformLogging,
+	TransformStringChars,
 	TransformStringAddition,
 	TransformStringFormat,
 }
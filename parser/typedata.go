@@ -139,7 +139,6 @@ func NewTypeDataObject(tdict TypeDictionary, typename string, dims int) *TypeDat
 
 var identBool = ast.NewIdent("bool")
 var identByte = ast.NewIdent("byte")
-var identChar = ast.NewIdent("char")
 var identInt16 = ast.NewIdent("int16")
 var identInt = ast.NewIdent("int")
 var identInt64 = ast.NewIdent("int64")
@@ -147,10 +146,30 @@ var identFloat32 = ast.NewIdent("float32")
 var identFloat64 = ast.NewIdent("float64")
 var identString = ast.NewIdent("string")
 
+// Java 'char' types are written with this placeholder, which
+// GoProgram.File() replaces with the configured 'rune' or 'byte'
+const charPlaceholder = "char"
+
 func (vdata *TypeData) Decl() ast.Expr {
 	return vdata.Expr()
 }
 
+// return the type of a single element of an array or list (or nil for
+// other types)
+func (vdata *TypeData) elementType() *TypeData {
+	if vdata.vtype == VT_CLASS {
+		// lists record their element type
+		return vdata.type1
+	} else if vdata.vtype != VT_ARRAY {
+		return nil
+	} else if vdata.array_dims <= 1 {
+		return vdata.type1
+	}
+
+	return &TypeData{vtype: VT_ARRAY, type1: vdata.type1,
+		array_dims: vdata.array_dims - 1}
+}
+
 func (vdata *TypeData) Equals(odata *TypeData) bool {
 	if vdata == nil || odata == nil {
 		return false
@@ -185,6 +204,191 @@ func (vdata *TypeData) Equals(odata *TypeData) bool {
 	return true
 }
 
+// return the Java spelling of a type, used to identify overloaded methods
+func javaTypeName(vdata *TypeData) string {
+	if vdata == nil {
+		return "Object"
+	}
+
+	switch vdata.vtype {
+	case VT_BOOL:
+		return "boolean"
+	case VT_BYTE:
+		return "byte"
+	case VT_CHAR:
+		return "char"
+	case VT_INT16:
+		return "short"
+	case VT_INT:
+		return "int"
+	case VT_INT64:
+		return "long"
+	case VT_FLOAT32:
+		return "float"
+	case VT_FLOAT64:
+		return "double"
+	case VT_STRING:
+		return "String"
+	case VT_ARRAY:
+		return javaTypeName(vdata.type1) +
+			strings.Repeat("[]", vdata.array_dims)
+	case VT_MAP:
+		return "Map"
+	case VT_INTERFACE, VT_CLASS:
+		return vdata.vclass
+	}
+
+	return "Object"
+}
+
+// return the part of an overloaded method name which describes a type,
+// e.g. "Int" for 'int' or "StringArray" for 'String[]'
+func typeSuffix(vdata *TypeData) string {
+	if vdata != nil && vdata.vtype == VT_ARRAY {
+		return typeSuffix(vdata.type1) +
+			strings.Repeat("Array", vdata.array_dims)
+	}
+
+	name := javaTypeName(vdata)
+	if idx := strings.LastIndexAny(name, "._"); idx >= 0 {
+		name = name[idx+1:]
+	}
+
+	if name == "" {
+		return ""
+	}
+
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// order numeric types so an argument can be widened to a parameter
+// of a higher rank
+func numericRank(vt VarType) int {
+	switch vt {
+	case VT_BYTE:
+		return 1
+	case VT_INT16, VT_CHAR:
+		return 2
+	case VT_INT:
+		return 3
+	case VT_INT64:
+		return 4
+	case VT_FLOAT32:
+		return 5
+	case VT_FLOAT64:
+		return 6
+	}
+
+	return 0
+}
+
+// conversions used to pass an argument to a method parameter
+type conversion int
+
+const (
+	// argument cannot be passed to the parameter
+	convNone conversion = iota
+	// argument and parameter have the same type
+	convIdentity
+	// primitive widening, or an object passed as an Object
+	convWidening
+	// object which may be a subclass of the parameter's class
+	convSubclass
+	// primitive boxed into an object or an object unboxed to a primitive
+	convBoxing
+	// argument type is unknown
+	convUnknown
+)
+
+// Java classes which wrap primitive values
+var boxedTypes = map[string]VarType{
+	"Boolean":   VT_BOOL,
+	"Byte":      VT_BYTE,
+	"Character": VT_CHAR,
+	"Short":     VT_INT16,
+	"Integer":   VT_INT,
+	"Long":      VT_INT64,
+	"Float":     VT_FLOAT32,
+	"Double":    VT_FLOAT64,
+}
+
+// return the conversion needed to pass an argument of type 'atype' to a
+// parameter of type 'ptype'
+func convertType(ptype *TypeData, atype *TypeData) conversion {
+	if ptype == nil || atype == nil {
+		return convUnknown
+	}
+
+	if ptype.Equals(atype) {
+		if ptype.isObject() && ptype.vclass != atype.vclass {
+			if ptype.vclass == "Object" {
+				return convWidening
+			}
+
+			return convSubclass
+		}
+
+		return convIdentity
+	}
+
+	if ptype.array_dims != 0 || atype.array_dims != 0 {
+		return convNone
+	}
+
+	if ptype.vtype == VT_GENERIC_OBJECT ||
+		(ptype.vtype == VT_CLASS && ptype.vclass == "Object") {
+		if atype.isPrimitive() && atype.vtype != VT_STRING {
+			return convBoxing
+		}
+
+		return convWidening
+	}
+
+	if ptype.isObject() {
+		if atype.isObject() {
+			return convSubclass
+		}
+
+		if vt, ok := boxedTypes[ptype.vclass]; ok && vt == atype.vtype {
+			return convBoxing
+		}
+
+		return convNone
+	}
+
+	if atype.isObject() {
+		if vt, ok := boxedTypes[atype.vclass]; ok &&
+			(vt == ptype.vtype || widensTo(vt, ptype.vtype)) {
+			return convBoxing
+		}
+
+		return convNone
+	}
+
+	if widensTo(atype.vtype, ptype.vtype) {
+		return convWidening
+	}
+
+	return convNone
+}
+
+// return true if a primitive of type 'from' can be widened to 'to'
+func widensTo(from VarType, to VarType) bool {
+	frank := numericRank(from)
+	trank := numericRank(to)
+
+	return frank > 0 && trank > 0 && frank < trank && to != VT_CHAR
+}
+
+// return true if both types are nil or both types are equal
+func sameType(a *TypeData, b *TypeData) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equals(b)
+}
+
 func (vdata *TypeData) Expr() ast.Expr {
 	if vdata.vtype != VT_ARRAY {
 		typename, is_nil := vdata.TypeName()
@@ -194,17 +398,26 @@ func (vdata *TypeData) Expr() ast.Expr {
 
 		expr := typename
 		for i := 0; i < vdata.array_dims; i++ {
-			expr = &ast.ArrayType{Elt: typename}
+			expr = &ast.ArrayType{Elt: expr}
 		}
 
 		return expr
 	}
 
+	var expr ast.Expr
 	if vdata.type1 != nil {
-		return &ast.ArrayType{Elt: vdata.type1.Expr()}
+		expr = vdata.type1.Expr()
+	} else {
+		expr = genericObject.Expr()
+	}
+
+	// multi-dimensional arrays are slices of slices
+	expr = &ast.ArrayType{Elt: expr}
+	for i := 1; i < vdata.array_dims; i++ {
+		expr = &ast.ArrayType{Elt: expr}
 	}
 
-	return &ast.ArrayType{Elt: genericObject.Expr()}
+	return expr
 }
 
 func (vdata *TypeData) IsClass(name string) bool {
@@ -215,6 +428,12 @@ func (vdata *TypeData) isObject() bool {
 	return vdata.vtype == VT_INTERFACE || vdata.vtype == VT_CLASS
 }
 
+// return true for types which can be converted with "type(value)"
+func (vdata *TypeData) isPrimitive() bool {
+	return vdata.vtype >= VT_BOOL && vdata.vtype <= VT_STRING &&
+		vdata.array_dims == 0
+}
+
 func (vdata *TypeData) Name() string {
 	switch vdata.vtype {
 	case VT_ARRAY:
@@ -310,7 +529,8 @@ func (vdata *TypeData) TypeName() (ast.Expr, bool) {
 	case VT_BYTE:
 		return identByte, false
 	case VT_CHAR:
-		return identChar, false
+		// a new identifier each time so File() can rename it in place
+		return ast.NewIdent(charPlaceholder), false
 	case VT_INT16:
 		return identInt16, false
 	case VT_INT:
@@ -324,7 +544,7 @@ func (vdata *TypeData) TypeName() (ast.Expr, bool) {
 	case VT_STRING:
 		return identString, false
 	case VT_GENERIC_OBJECT:
-		return &ast.InterfaceType{Methods: &ast.FieldList{}}, false
+		return ast.NewIdent("interface{}"), false
 	case VT_ARRAY:
 		return ast.NewIdent("<<array>>"), false
 	case VT_MAP: